@@ -13,6 +13,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/environments"
 )
 
@@ -38,7 +39,7 @@ func TestInjections(t *testing.T) {
 
 	var bootList []environments.BootService
 	env.MustResolve(&bootList)
-	Expect(len(bootList)).To(Equal(4))
+	Expect(len(bootList)).To(Equal(5))
 
 	_, ok := bootList[0].(*server.APIServer)
 	Expect(ok).To(Equal(true))
@@ -48,6 +49,8 @@ func TestInjections(t *testing.T) {
 	Expect(ok).To(Equal(true))
 	_, ok = bootList[3].(*workers.LeaderElectionManager)
 	Expect(ok).To(Equal(true))
+	_, ok = bootList[4].(services.CentralChangeNotifier)
+	Expect(ok).To(Equal(true))
 
 	var workerList []workers.Worker
 	env.MustResolve(&workerList)
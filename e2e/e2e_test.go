@@ -84,7 +84,7 @@ var _ = Describe("Central", func() {
 				Name:          centralName,
 				Region:        dpRegion,
 			}
-			resp, _, err := client.PublicAPI().CreateCentral(context.Background(), true, request)
+			resp, _, err := client.PublicAPI().CreateCentral(context.Background(), true, request, nil)
 			Expect(err).To(BeNil())
 			createdCentral = &resp
 			notes = []string{
@@ -253,7 +253,7 @@ var _ = Describe("Central", func() {
 			if createdCentral == nil {
 				Fail("central not created")
 			}
-			_, err = client.PublicAPI().DeleteCentralById(context.TODO(), createdCentral.Id, true)
+			_, err = client.PublicAPI().DeleteCentralById(context.TODO(), createdCentral.Id, true, nil)
 			Expect(err).To(Succeed())
 			Eventually(func() string {
 				return centralStatus(createdCentral.Id, client)
@@ -363,7 +363,7 @@ var _ = Describe("Central", func() {
 				Central:       centralSpec,
 				Scanner:       scannerSpec,
 			}
-			resp, _, err := adminAPI.CreateCentral(context.TODO(), true, request)
+			resp, _, err := adminAPI.CreateCentral(context.TODO(), true, request, nil)
 			Expect(err).To(BeNil())
 			createdCentral = &resp
 			notes = []string{
@@ -438,7 +438,7 @@ var _ = Describe("Central", func() {
 				},
 			}
 
-			_, _, err = adminAPI.UpdateCentralById(context.TODO(), centralID, updateReq)
+			_, _, err = adminAPI.UpdateCentralById(context.TODO(), centralID, updateReq, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Eventually(func() corev1.ResourceRequirements {
 				central := &v1alpha1.Central{}
@@ -464,7 +464,7 @@ var _ = Describe("Central", func() {
 			if createdCentral == nil {
 				Fail("central not created")
 			}
-			_, err = client.PublicAPI().DeleteCentralById(context.TODO(), createdCentral.Id, true)
+			_, err = client.PublicAPI().DeleteCentralById(context.TODO(), createdCentral.Id, true, nil)
 			Expect(err).To(Succeed())
 			Eventually(func() string {
 				return centralStatus(createdCentral.Id, client)
@@ -527,7 +527,7 @@ var _ = Describe("Central", func() {
 				Region:        dpRegion,
 			}
 
-			resp, _, err := client.PublicAPI().CreateCentral(context.TODO(), true, request)
+			resp, _, err := client.PublicAPI().CreateCentral(context.TODO(), true, request, nil)
 			Expect(err).To(BeNil())
 			createdCentral = &resp
 			notes = []string{
@@ -553,9 +553,9 @@ var _ = Describe("Central", func() {
 			if createdCentral == nil {
 				Fail("central not created")
 			}
-			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id)
+			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id, nil)
 			Expect(err).ToNot(HaveOccurred())
-			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id)
+			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id, nil)
 			Expect(err).To(HaveOccurred())
 			central, _, err := client.PublicAPI().GetCentralById(context.TODO(), createdCentral.Id)
 			Expect(err).To(HaveOccurred())
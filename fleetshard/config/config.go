@@ -12,19 +12,37 @@ import (
 
 // Config contains this application's runtime configuration.
 type Config struct {
-	FleetManagerEndpoint string        `env:"FLEET_MANAGER_ENDPOINT" envDefault:"http://127.0.0.1:8000"`
-	ClusterID            string        `env:"CLUSTER_ID"`
-	RuntimePollPeriod    time.Duration `env:"RUNTIME_POLL_PERIOD" envDefault:"5s"`
-	AuthType             string        `env:"AUTH_TYPE" envDefault:"RHSSO"`
-	RHSSOClientID        string        `env:"RHSSO_SERVICE_ACCOUNT_CLIENT_ID"`
-	RHSSOClientSecret    string        `env:"RHSSO_SERVICE_ACCOUNT_CLIENT_SECRET"`
-	RHSSORealm           string        `env:"RHSSO_REALM" envDefault:"redhat-external"`
-	RHSSOEndpoint        string        `env:"RHSSO_ENDPOINT" envDefault:"https://sso.redhat.com"`
-	OCMRefreshToken      string        `env:"OCM_TOKEN"`
-	StaticToken          string        `env:"STATIC_TOKEN"`
-	CreateAuthProvider   bool          `env:"CREATE_AUTH_PROVIDER" envDefault:"false"`
-	MetricsAddress       string        `env:"FLEETSHARD_METRICS_ADDRESS" envDefault:":8080"`
-	EgressProxyImage     string        `env:"EGRESS_PROXY_IMAGE"`
+	FleetManagerEndpoint     string        `env:"FLEET_MANAGER_ENDPOINT" envDefault:"http://127.0.0.1:8000"`
+	ClusterID                string        `env:"CLUSTER_ID"`
+	RuntimePollPeriod        time.Duration `env:"RUNTIME_POLL_PERIOD" envDefault:"5s"`
+	RuntimeWatchEnabled      bool          `env:"RUNTIME_WATCH_ENABLED" envDefault:"true"`
+	RuntimeWatchTimeout      time.Duration `env:"RUNTIME_WATCH_TIMEOUT" envDefault:"30s"`
+	RuntimeResyncPeriod      time.Duration `env:"RUNTIME_RESYNC_PERIOD" envDefault:"5m"`
+	RuntimeWorkers           int           `env:"RUNTIME_WORKERS" envDefault:"10"`
+	RuntimeRequeueBaseDelay  time.Duration `env:"RUNTIME_REQUEUE_BASE_DELAY" envDefault:"5s"`
+	RuntimeRequeueMaxDelay   time.Duration `env:"RUNTIME_REQUEUE_MAX_DELAY" envDefault:"10m"`
+	RuntimeRequeueQPS        float64       `env:"RUNTIME_REQUEUE_QPS" envDefault:"10"`
+	RuntimeRequeueBurst      int           `env:"RUNTIME_REQUEUE_BURST" envDefault:"100"`
+	RuntimeStatusFlushPeriod time.Duration `env:"RUNTIME_STATUS_FLUSH_PERIOD" envDefault:"1s"`
+	RuntimeStatusMaxBackoff  time.Duration `env:"RUNTIME_STATUS_MAX_BACKOFF" envDefault:"1m"`
+	RuntimeGCEnabled         bool          `env:"RUNTIME_GC_ENABLED" envDefault:"true"`
+	RuntimeGCPeriod          time.Duration `env:"RUNTIME_GC_PERIOD" envDefault:"10m"`
+	RuntimeGCGracePeriod     time.Duration `env:"RUNTIME_GC_GRACE_PERIOD" envDefault:"1h"`
+	RuntimeGCDryRun          bool          `env:"RUNTIME_GC_DRY_RUN" envDefault:"true"`
+	RuntimeDriftCheckPeriod  time.Duration `env:"RUNTIME_DRIFT_CHECK_PERIOD" envDefault:"30m"`
+	RuntimeDriftRevert       bool          `env:"RUNTIME_DRIFT_REVERT" envDefault:"false"`
+	RuntimeHealthCheckPeriod time.Duration `env:"RUNTIME_HEALTH_CHECK_PERIOD" envDefault:"5m"`
+	AuthType                 string        `env:"AUTH_TYPE" envDefault:"RHSSO"`
+	RHSSOClientID            string        `env:"RHSSO_SERVICE_ACCOUNT_CLIENT_ID"`
+	RHSSOClientSecret        string        `env:"RHSSO_SERVICE_ACCOUNT_CLIENT_SECRET"`
+	RHSSORealm               string        `env:"RHSSO_REALM" envDefault:"redhat-external"`
+	RHSSOEndpoint            string        `env:"RHSSO_ENDPOINT" envDefault:"https://sso.redhat.com"`
+	OCMRefreshToken          string        `env:"OCM_TOKEN"`
+	StaticToken              string        `env:"STATIC_TOKEN"`
+	CreateAuthProvider       bool          `env:"CREATE_AUTH_PROVIDER" envDefault:"false"`
+	MetricsAddress           string        `env:"FLEETSHARD_METRICS_ADDRESS" envDefault:":8080"`
+	EgressProxyImage         string        `env:"EGRESS_PROXY_IMAGE"`
+	EgressProxyClusterCIDRs  []string      `env:"EGRESS_PROXY_CLUSTER_CIDRS" envSeparator:","`
 
 	AWS       AWS
 	ManagedDB ManagedDB
@@ -37,12 +55,35 @@ type AWS struct {
 	RoleARN string `env:"AWS_ROLE_ARN"`
 }
 
+const (
+	// ManagedDBProviderAWS provisions managed databases as AWS Aurora clusters
+	ManagedDBProviderAWS = "aws"
+	// ManagedDBProviderLocal provisions managed databases as Postgres deployments in the data plane cluster or on an
+	// existing Postgres server. It is meant for development and CI environments.
+	ManagedDBProviderLocal = "local"
+)
+
 // ManagedDB for configuring managed DB specific parameters
 type ManagedDB struct {
 	Enabled             bool   `env:"MANAGED_DB_ENABLED" envDefault:"false"`
+	Provider            string `env:"MANAGED_DB_PROVIDER" envDefault:"aws"`
 	SecurityGroup       string `env:"MANAGED_DB_SECURITY_GROUP"`
 	SubnetGroup         string `env:"MANAGED_DB_SUBNET_GROUP"`
 	PerformanceInsights bool   `env:"MANAGED_DB_PERFORMANCE_INSIGHTS" envDefault:"false"`
+
+	Local LocalDB
+}
+
+// LocalDB for configuring the local managed DB provider. If Host is set, a database is created for each Central on
+// the existing Postgres server. Otherwise a Postgres deployment is created for each Central in Namespace.
+type LocalDB struct {
+	Namespace     string `env:"MANAGED_DB_LOCAL_NAMESPACE" envDefault:"rhacs-local-db"`
+	Image         string `env:"MANAGED_DB_LOCAL_IMAGE" envDefault:"docker.io/library/postgres:13"`
+	Host          string `env:"MANAGED_DB_LOCAL_HOST"`
+	Port          int    `env:"MANAGED_DB_LOCAL_PORT" envDefault:"5432"`
+	AdminUser     string `env:"MANAGED_DB_LOCAL_ADMIN_USER" envDefault:"postgres"`
+	AdminPassword string `env:"MANAGED_DB_LOCAL_ADMIN_PASSWORD"`
+	SSLMode       string `env:"MANAGED_DB_LOCAL_SSL_MODE" envDefault:"disable"`
 }
 
 // Telemetry defines parameters for pushing telemetry to a remote storage.
@@ -68,6 +109,9 @@ func GetConfig() (*Config, error) {
 	if c.AuthType == "" {
 		configErrors.AddError(errors.New("AUTH_TYPE unset in the environment"))
 	}
+	if c.RuntimeWorkers < 1 {
+		configErrors.AddError(errors.New("RUNTIME_WORKERS must be at least 1"))
+	}
 	validateManagedDBConfig(c, &configErrors)
 
 	cfgErr := configErrors.ToError()
@@ -81,6 +125,17 @@ func validateManagedDBConfig(c Config, configErrors *errorhelpers.ErrorList) {
 	if !c.ManagedDB.Enabled {
 		return
 	}
+	switch c.ManagedDB.Provider {
+	case ManagedDBProviderAWS:
+		validateAWSManagedDBConfig(c, configErrors)
+	case ManagedDBProviderLocal:
+	default:
+		configErrors.AddError(errors.Errorf("MANAGED_DB_PROVIDER %q is not supported, use one of %q, %q",
+			c.ManagedDB.Provider, ManagedDBProviderAWS, ManagedDBProviderLocal))
+	}
+}
+
+func validateAWSManagedDBConfig(c Config, configErrors *errorhelpers.ErrorList) {
 	if c.AWS.RoleARN == "" {
 		configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and AWS_ROLE_ARN unset in the environment"))
 	}
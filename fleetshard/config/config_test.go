@@ -15,6 +15,21 @@ func TestSingleton_Success(t *testing.T) {
 	assert.Equal(t, cfg.FleetManagerEndpoint, "http://127.0.0.1:8000")
 	assert.Equal(t, cfg.ClusterID, "some-value")
 	assert.Equal(t, cfg.RuntimePollPeriod, 5*time.Second)
+	assert.Equal(t, cfg.RuntimeWatchEnabled, true)
+	assert.Equal(t, cfg.RuntimeWatchTimeout, 30*time.Second)
+	assert.Equal(t, cfg.RuntimeResyncPeriod, 5*time.Minute)
+	assert.Equal(t, cfg.RuntimeWorkers, 10)
+	assert.Equal(t, cfg.RuntimeRequeueBaseDelay, 5*time.Second)
+	assert.Equal(t, cfg.RuntimeRequeueMaxDelay, 10*time.Minute)
+	assert.Equal(t, cfg.RuntimeStatusFlushPeriod, 1*time.Second)
+	assert.Equal(t, cfg.RuntimeStatusMaxBackoff, 1*time.Minute)
+	assert.Equal(t, cfg.RuntimeGCEnabled, true)
+	assert.Equal(t, cfg.RuntimeGCPeriod, 10*time.Minute)
+	assert.Equal(t, cfg.RuntimeGCGracePeriod, 1*time.Hour)
+	assert.Equal(t, cfg.RuntimeGCDryRun, true)
+	assert.Equal(t, cfg.RuntimeDriftCheckPeriod, 30*time.Minute)
+	assert.Equal(t, cfg.RuntimeDriftRevert, false)
+	assert.Equal(t, cfg.RuntimeHealthCheckPeriod, 5*time.Minute)
 	assert.Equal(t, cfg.AuthType, "RHSSO")
 	assert.Equal(t, cfg.RHSSORealm, "redhat-external")
 	assert.Equal(t, cfg.RHSSOEndpoint, "https://sso.redhat.com")
@@ -57,3 +72,32 @@ func TestSingleton_Failure_WhenManagedDBEnabledAndManagedDbSecurityGroupNotSet(t
 	assert.Error(t, err, "MANAGED_DB_ENABLED == true and MANAGED_DB_SECURITY_GROUP unset in the environment")
 	assert.Nil(t, cfg)
 }
+
+func TestSingleton_Failure_WhenRuntimeWorkersNotPositive(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("RUNTIME_WORKERS", "0")
+	cfg, err := GetConfig()
+	assert.Error(t, err, "RUNTIME_WORKERS must be at least 1")
+	assert.Nil(t, cfg)
+}
+
+func TestSingleton_Success_WhenLocalManagedDBEnabled(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_PROVIDER", "local")
+	cfg, err := GetConfig()
+	require.NoError(t, err)
+	assert.Equal(t, cfg.ManagedDB.Provider, ManagedDBProviderLocal)
+	assert.Equal(t, cfg.ManagedDB.Local.Namespace, "rhacs-local-db")
+	assert.Equal(t, cfg.ManagedDB.Local.Port, 5432)
+	assert.Empty(t, cfg.ManagedDB.Local.Host)
+}
+
+func TestSingleton_Failure_WhenManagedDBProviderUnsupported(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_PROVIDER", "azure")
+	cfg, err := GetConfig()
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+}
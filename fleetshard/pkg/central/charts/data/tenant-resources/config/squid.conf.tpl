@@ -3,6 +3,9 @@
 # This file configures Squid as an egress proxy that
 # (a) only accepts traffic from the local network
 # (b) does not allow outgoing traffic to the local network (but to all other destinations)
+# (c) never allows outgoing traffic to localhost, link-local addresses and the pod and service networks of the cluster
+# (d) allows outgoing traffic to the CIDR ranges in the egress allowlist of the tenant, even within the local network.
+#     If the allowlist also contains domains, only destinations matching both a domain and a CIDR range are allowed.
 
 acl localnet src 0.0.0.1-0.255.255.255	# RFC 1122 "this" network (LAN)
 acl localnet src 10.0.0.0/8		# RFC 1918 local private network (LAN)
@@ -22,13 +25,37 @@ acl to_localnet dst 192.168.0.0/16		# RFC 1918 local private network (LAN)
 acl to_localnet dst fc00::/7       	# RFC 4193 local private network range
 acl to_localnet dst fe80::/10      	# RFC 4291 link-local (directly plugged) machines
 
+acl to_forbidden dst 169.254.0.0/16 fe80::/10	# Link-local addresses, including cloud metadata services
+{{- with .Values.egressProxy.clusterCidrs }}
+acl to_forbidden dst {{ join " " . }}	# Pod and service networks of the cluster
+{{- end }}
+
 acl CONNECT method CONNECT
 
-# Forbid all access to localhost and local networks, either directly or via CONNECT
-http_access deny to_localnet
+# Forbid all access to localhost, link-local addresses and the cluster networks before the egress allowlist applies
 http_access deny to_localhost
-http_access deny CONNECT to_localnet
+http_access deny to_forbidden
 http_access deny CONNECT to_localhost
+http_access deny CONNECT to_forbidden
+{{- $allowlist := .Values.egressProxy.allowlist }}
+{{- if $allowlist.cidrs }}
+
+# Allow access from the local network to the destinations in the egress allowlist, either directly or via CONNECT.
+# Domains only allow destinations which are also within an allowlisted CIDR range.
+acl tenant_allowlist_cidrs dst {{ join " " $allowlist.cidrs }}
+{{- if $allowlist.domains }}
+acl tenant_allowlist_domains dstdomain {{ join " " $allowlist.domains }}
+http_access allow localnet tenant_allowlist_cidrs tenant_allowlist_domains
+http_access allow CONNECT localnet tenant_allowlist_cidrs tenant_allowlist_domains
+{{- else }}
+http_access allow localnet tenant_allowlist_cidrs
+http_access allow CONNECT localnet tenant_allowlist_cidrs
+{{- end }}
+{{- end }}
+
+# Forbid all other access to local networks, either directly or via CONNECT
+http_access deny to_localnet
+http_access deny CONNECT to_localnet
 
 # Only allow cachemgr access from localhost
 http_access allow localhost manager
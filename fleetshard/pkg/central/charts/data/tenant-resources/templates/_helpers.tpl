@@ -9,3 +9,7 @@
 {{- $annotations = merge (deepCopy .Values.annotations) $annotations -}}
 {{- $annotations | toYaml | nindent 0 }}
 {{- end -}}
+
+{{- define "squidConfig" -}}
+{{- tpl (.Files.Get "config/squid.conf.tpl") . -}}
+{{- end -}}
@@ -16,31 +16,45 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 )
 
 const (
 	dbAvailableStatus = "available"
 	dbDeletingStatus  = "deleting"
+	dbFailedStatus    = "failed"
 
 	dbUser           = "rhacs_master"
 	dbPrefix         = "rhacs-"
 	dbInstanceSuffix = "-db-instance"
 	dbClusterSuffix  = "-db-cluster"
+	dbRestoreSuffix  = "-restore"
 	awsRetrySeconds  = 30
 
+	// Snapshots taken by fleetshard-sync are named <dbPrefix><databaseID>-<suffix>-<timestamp>
+	dbFinalSnapshotSuffix      = "-final-"
+	dbPreRestoreSnapshotSuffix = "-pre-restore-"
+	dbSnapshotTimeFormat       = "20060102150405"
+
+	// dbRestoreIDTagKey tags a DB cluster with the ID of the restore which created it
+	dbRestoreIDTagKey = "rhacs-restore-id"
+
 	// DB cluster / instance configuration parameters
-	dbEngine                = "aurora-postgresql"
-	dbEngineVersion         = "13.7"
-	dbInstanceClass         = "db.serverless"
-	dbPostgresPort          = 5432
-	dbName                  = "postgres"
-	dbBackupRetentionPeriod = 30
+	dbEngine        = "aurora-postgresql"
+	dbInstanceClass = "db.serverless"
+	dbPostgresPort  = 5432
+	dbName          = "postgres"
+
+	// Defaults for the DB spec fields which fleet-manager does not set
+	defaultDBEngineVersion         = "13.7"
+	defaultDBBackupRetentionPeriod = 30
 
 	// The Aurora Serverless v2 DB instance configuration in ACUs (Aurora Capacity Units)
 	// 1 ACU = 1 vCPU + 2GB RAM
-	dbMinCapacityACU = 0.5
-	dbMaxCapacityACU = 16
+	defaultDBMinCapacityACU = 0.5
+	defaultDBMaxCapacityACU = 16
 )
 
 // RDS is an AWS RDS client tied to one Central instance. It provisions and deprovisions databases
@@ -54,11 +68,11 @@ type RDS struct {
 }
 
 // EnsureDBProvisioned is a blocking function that makes sure that an RDS database was provisioned for a Central
-func (r *RDS) EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string) (string, error) {
+func (r *RDS) EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error) {
 	clusterID := getClusterID(databaseID)
 	instanceID := getInstanceID(databaseID)
 
-	if err := r.ensureDBClusterCreated(clusterID, masterPassword); err != nil {
+	if err := r.ensureDBClusterCreated(clusterID, masterPassword, spec); err != nil {
 		return "", fmt.Errorf("ensuring DB cluster %s exists: %w", clusterID, err)
 	}
 
@@ -70,11 +84,19 @@ func (r *RDS) EnsureDBProvisioned(ctx context.Context, databaseID, masterPasswor
 }
 
 // EnsureDBDeprovisioned is a function that initiates the deprovisioning of the RDS database of a Central
-// Unlike EnsureDBProvisioned, this function does not block until the DB is deprovisioned
+// Unlike EnsureDBProvisioned, this function does not block until the DB is deprovisioned. A final snapshot of the DB
+// cluster is taken, which is kept after the deprovisioning.
+// The restore DB cluster of an unfinished restore is deleted without a snapshot.
 func (r *RDS) EnsureDBDeprovisioned(databaseID string) (bool, error) {
-	clusterID := getClusterID(databaseID)
-	instanceID := getInstanceID(databaseID)
+	if _, err := r.ensureDBClusterDeleted(getRestoreClusterID(databaseID), getRestoreInstanceID(databaseID), ""); err != nil {
+		return false, err
+	}
+	return r.ensureDBClusterDeleted(getClusterID(databaseID), getInstanceID(databaseID), getSnapshotID(databaseID, dbFinalSnapshotSuffix, time.Now()))
+}
 
+// ensureDBClusterDeleted initiates the deletion of a DB instance and its DB cluster. The DB cluster is snapshotted to
+// finalSnapshotID before its deletion, unless finalSnapshotID is empty. The DB instance does not need its own final snapshot, since it holds no data which is not part of the DB cluster.
+func (r *RDS) ensureDBClusterDeleted(clusterID, instanceID, finalSnapshotID string) (bool, error) {
 	instanceExists, err := r.instanceExists(instanceID)
 	if err != nil {
 		return false, fmt.Errorf("checking if DB instance exists: %w", err)
@@ -86,7 +108,6 @@ func (r *RDS) EnsureDBDeprovisioned(databaseID string) (bool, error) {
 		}
 		if status != dbDeletingStatus {
 			glog.Infof("Initiating deprovisioning of RDS database instance %s.", instanceID)
-			// TODO(ROX-13692): do not skip taking a final DB snapshot
 			_, err := r.rdsClient.DeleteDBInstance(newDeleteCentralDBInstanceInput(instanceID, true))
 			if err != nil {
 				return false, fmt.Errorf("deleting DB instance: %w", err)
@@ -104,9 +125,8 @@ func (r *RDS) EnsureDBDeprovisioned(databaseID string) (bool, error) {
 			return false, fmt.Errorf("getting DB cluster status: %w", err)
 		}
 		if status != dbDeletingStatus {
-			glog.Infof("Initiating deprovisioning of RDS database cluster %s.", clusterID)
-			// TODO(ROX-13692): do not skip taking a final DB snapshot
-			_, err := r.rdsClient.DeleteDBCluster(newDeleteCentralDBClusterInput(clusterID, true))
+			glog.Infof("Initiating deprovisioning of RDS database cluster %s with final snapshot %s.", clusterID, finalSnapshotID)
+			_, err := r.rdsClient.DeleteDBCluster(newDeleteCentralDBClusterInput(clusterID, finalSnapshotID))
 			if err != nil {
 				return false, fmt.Errorf("deleting DB cluster: %w", err)
 			}
@@ -116,17 +136,350 @@ func (r *RDS) EnsureDBDeprovisioned(databaseID string) (bool, error) {
 	return true, nil
 }
 
-func (r *RDS) ensureDBClusterCreated(clusterID, masterPassword string) error {
+// EnsureDBSnapshotCreated initiates the creation of a snapshot of the RDS database cluster of a Central unless the
+// snapshot exists already, and returns the state of the snapshot
+func (r *RDS) EnsureDBSnapshotCreated(ctx context.Context, databaseID, snapshotID string) (*cloudprovider.DBSnapshot, error) {
+	clusterID := getClusterID(databaseID)
+
+	snapshot, err := r.describeDBClusterSnapshot(ctx, snapshotID)
+	if err != nil && !errors.Is(err, cloudprovider.ErrDBSnapshotNotFound) {
+		return nil, err
+	}
+	if snapshot == nil {
+		glog.Infof("Initiating snapshot %s of RDS database cluster %s.", snapshotID, clusterID)
+		result, err := r.rdsClient.CreateDBClusterSnapshotWithContext(ctx, &rds.CreateDBClusterSnapshotInput{
+			DBClusterIdentifier:         aws.String(clusterID),
+			DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating DB cluster snapshot %s: %w", snapshotID, err)
+		}
+		snapshot = result.DBClusterSnapshot
+	}
+
+	dbSnapshot := convertDBClusterSnapshot(snapshot)
+	return &dbSnapshot, nil
+}
+
+// ListDBSnapshots returns the manual and automated snapshots of the RDS database cluster of a Central
+func (r *RDS) ListDBSnapshots(ctx context.Context, databaseID string) ([]cloudprovider.DBSnapshot, error) {
+	clusterID := getClusterID(databaseID)
+
+	snapshots := []cloudprovider.DBSnapshot{}
+	err := r.rdsClient.DescribeDBClusterSnapshotsPagesWithContext(ctx, &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}, func(page *rds.DescribeDBClusterSnapshotsOutput, _ bool) bool {
+		for _, snapshot := range page.DBClusterSnapshots {
+			snapshots = append(snapshots, convertDBClusterSnapshot(snapshot))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing snapshots of DB cluster %s: %w", clusterID, err)
+	}
+	return snapshots, nil
+}
+
+// EnsureDBRestored is a blocking function that makes sure that the RDS database of a Central was restored from a
+// snapshot of its own DB cluster. The snapshot is restored into a separate DB cluster, and the existing DB cluster is
+// only deleted with a snapshot once the restored DB cluster is available. The restored DB cluster then takes over the
+// identifiers of the deleted one. It is tagged with the restore ID, so that a restore is only performed once.
+func (r *RDS) EnsureDBRestored(ctx context.Context, databaseID, restoreID, snapshotID, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+	clusterID := getClusterID(databaseID)
+	instanceID := getInstanceID(databaseID)
+
+	restored, err := r.isClusterRestoredBy(clusterID, restoreID)
+	if err != nil {
+		return "", err
+	}
+	if !restored {
+		if err := r.ensureRestoreDBClusterAvailable(ctx, databaseID, restoreID, snapshotID, spec); err != nil {
+			return "", err
+		}
+
+		if err := r.ensureDBClusterReplaceable(ctx, databaseID); err != nil {
+			return "", err
+		}
+
+		if err := r.ensureRestoreDBClusterRenamed(ctx, databaseID); err != nil {
+			return "", err
+		}
+	}
+
+	if err := r.ensureRestoreDBInstanceRenamed(ctx, databaseID); err != nil {
+		return "", err
+	}
+
+	if err := r.ensureDBInstanceCreated(instanceID, clusterID); err != nil {
+		return "", fmt.Errorf("ensuring DB instance %s exists in cluster %s: %w", instanceID, clusterID, err)
+	}
+
+	connectionString, err := r.waitForInstanceToBeAvailable(ctx, instanceID, clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	// The restored DB cluster has the master password of the snapshotted DB cluster.
+	if err := r.ResetDBMasterPassword(ctx, databaseID, masterPassword); err != nil {
+		return "", err
+	}
+
+	return connectionString, nil
+}
+
+// ensureRestoreDBClusterAvailable makes sure that the snapshot was restored into the restore DB cluster of a Central,
+// and blocks until the restore DB cluster is available. A restore DB cluster left over by an earlier restore is
+// deleted first.
+func (r *RDS) ensureRestoreDBClusterAvailable(ctx context.Context, databaseID, restoreID, snapshotID string, spec private.ManagedCentralAllOfSpecCentralDb) error {
+	clusterID := getClusterID(databaseID)
+	restoreClusterID := getRestoreClusterID(databaseID)
+	restoreInstanceID := getRestoreInstanceID(databaseID)
+
+	restoreStarted, err := r.isClusterRestoredBy(restoreClusterID, restoreID)
+	if err != nil {
+		return err
+	}
+	if !restoreStarted {
+		snapshot, err := r.describeDBClusterSnapshot(ctx, snapshotID)
+		if err != nil {
+			return err
+		}
+		if snapshotClusterID := aws.StringValue(snapshot.DBClusterIdentifier); snapshotClusterID != clusterID {
+			return fmt.Errorf("%w: %s is a snapshot of DB cluster %s", cloudprovider.ErrDBSnapshotNotFound, snapshotID, snapshotClusterID)
+		}
+
+		if err := r.waitUntil(ctx, fmt.Sprintf("previous restore DB cluster %s to be deleted", restoreClusterID), func() (bool, error) {
+			if _, err := r.ensureDBClusterDeleted(restoreClusterID, restoreInstanceID, ""); err != nil {
+				return false, err
+			}
+			clusterExists, err := r.clusterExists(restoreClusterID)
+			return !clusterExists, err
+		}); err != nil {
+			return err
+		}
+
+		glog.Infof("Initiating restore %s of RDS database cluster %s from snapshot %s into %s.", restoreID, clusterID, snapshotID, restoreClusterID)
+		_, err = r.rdsClient.RestoreDBClusterFromSnapshotWithContext(ctx,
+			newRestoreCentralDBClusterInput(restoreClusterID, snapshotID, restoreID, r.dbSecurityGroup, r.dbSubnetGroup, withDBSpecDefaults(spec)))
+		if err != nil {
+			return fmt.Errorf("restoring DB cluster %s from snapshot %s: %w", restoreClusterID, snapshotID, err)
+		}
+	}
+
+	if err := r.ensureDBInstanceCreated(restoreInstanceID, restoreClusterID); err != nil {
+		return fmt.Errorf("ensuring DB instance %s exists in cluster %s: %w", restoreInstanceID, restoreClusterID, err)
+	}
+
+	if _, err := r.waitForInstanceToBeAvailable(ctx, restoreInstanceID, restoreClusterID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ensureRestoreDBClusterRenamed renames the available restore DB cluster of a Central to the identifier of the DB
+// cluster it replaces, and blocks until the rename is done.
+func (r *RDS) ensureRestoreDBClusterRenamed(ctx context.Context, databaseID string) error {
+	clusterID := getClusterID(databaseID)
+	restoreClusterID := getRestoreClusterID(databaseID)
+
+	return r.waitUntil(ctx, fmt.Sprintf("restore DB cluster %s to be renamed to %s", restoreClusterID, clusterID), func() (bool, error) {
+		clusterExists, err := r.clusterExists(clusterID)
+		if err != nil || clusterExists {
+			return clusterExists, err
+		}
+		restoreCluster, err := r.describeDBCluster(restoreClusterID)
+		if err != nil {
+			return false, err
+		}
+		if aws.StringValue(restoreCluster.Status) != dbAvailableStatus {
+			return false, nil
+		}
+		glog.Infof("Renaming RDS database cluster %s to %s.", restoreClusterID, clusterID)
+		_, err = r.rdsClient.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
+			DBClusterIdentifier:    aws.String(restoreClusterID),
+			NewDBClusterIdentifier: aws.String(clusterID),
+			ApplyImmediately:       aws.Bool(true),
+		})
+		if err != nil {
+			return false, fmt.Errorf("renaming DB cluster %s to %s: %w", restoreClusterID, clusterID, err)
+		}
+		return false, nil
+	})
+}
+
+// ensureRestoreDBInstanceRenamed renames the DB instance of a restored DB cluster to the identifier of the DB instance
+// it replaces, and blocks until the rename is done.
+func (r *RDS) ensureRestoreDBInstanceRenamed(ctx context.Context, databaseID string) error {
+	instanceID := getInstanceID(databaseID)
+	restoreInstanceID := getRestoreInstanceID(databaseID)
+
+	return r.waitUntil(ctx, fmt.Sprintf("restore DB instance %s to be renamed to %s", restoreInstanceID, instanceID), func() (bool, error) {
+		restoreInstanceExists, err := r.instanceExists(restoreInstanceID)
+		if err != nil || !restoreInstanceExists {
+			return !restoreInstanceExists, err
+		}
+		status, err := r.instanceStatus(restoreInstanceID)
+		if err != nil {
+			return false, err
+		}
+		if status != dbAvailableStatus {
+			return false, nil
+		}
+		glog.Infof("Renaming RDS database instance %s to %s.", restoreInstanceID, instanceID)
+		_, err = r.rdsClient.ModifyDBInstanceWithContext(ctx, &rds.ModifyDBInstanceInput{
+			DBInstanceIdentifier:    aws.String(restoreInstanceID),
+			NewDBInstanceIdentifier: aws.String(instanceID),
+			ApplyImmediately:        aws.Bool(true),
+		})
+		if err != nil {
+			return false, fmt.Errorf("renaming DB instance %s to %s: %w", restoreInstanceID, instanceID, err)
+		}
+		return false, nil
+	})
+}
+
+// ResetDBMasterPassword changes the master password of the RDS database cluster of a Central
+func (r *RDS) ResetDBMasterPassword(ctx context.Context, databaseID, masterPassword string) error {
+	clusterID := getClusterID(databaseID)
+
 	clusterExists, err := r.clusterExists(clusterID)
 	if err != nil {
 		return fmt.Errorf("checking if DB cluster exists: %w", err)
 	}
-	if clusterExists {
-		return nil
+	if !clusterExists {
+		return cloudprovider.ErrDBNotFound
+	}
+
+	glog.Infof("Resetting master password of RDS database cluster %s.", clusterID)
+	_, err = r.rdsClient.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		MasterUserPassword:  aws.String(masterPassword),
+		ApplyImmediately:    aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("setting master password of DB cluster %s: %w", clusterID, err)
+	}
+	return nil
+}
+
+// GetDBStatus returns the status of the RDS database instance of a Central
+func (r *RDS) GetDBStatus(_ context.Context, databaseID string) (*cloudprovider.DBStatus, error) {
+	instanceID := getInstanceID(databaseID)
+
+	instanceExists, err := r.instanceExists(instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("checking if DB instance exists: %w", err)
+	}
+	if !instanceExists {
+		return nil, cloudprovider.ErrDBNotFound
+	}
+	status, err := r.instanceStatus(instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("getting DB instance status: %w", err)
+	}
+	return &cloudprovider.DBStatus{Available: status == dbAvailableStatus, Status: status}, nil
+}
+
+// ensureDBClusterReplaceable makes sure that the DB cluster of a Central does not exist, so that it can be replaced by
+// the restore DB cluster. An existing DB cluster is deleted with a snapshot, and the function blocks until the DB
+// cluster is gone.
+func (r *RDS) ensureDBClusterReplaceable(ctx context.Context, databaseID string) error {
+	clusterID := getClusterID(databaseID)
+	for {
+		clusterExists, err := r.clusterExists(clusterID)
+		if err != nil {
+			return fmt.Errorf("checking if DB cluster exists: %w", err)
+		}
+		if !clusterExists {
+			return nil
+		}
+
+		if _, err := r.ensureDBClusterDeleted(clusterID, getInstanceID(databaseID), getSnapshotID(databaseID, dbPreRestoreSnapshotSuffix, time.Now())); err != nil {
+			return fmt.Errorf("deleting DB cluster %s before restore: %w", clusterID, err)
+		}
+
+		glog.Infof("Waiting for RDS database cluster %s to be deleted before restore", clusterID)
+		ticker := time.NewTicker(awsRetrySeconds * time.Second)
+		select {
+		case <-ticker.C:
+			continue
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for RDS cluster to be deleted: %w", ctx.Err())
+		}
+	}
+}
+
+// waitUntil blocks until done returns true, checking it every awsRetrySeconds.
+func (r *RDS) waitUntil(ctx context.Context, description string, done func() (bool, error)) error {
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		glog.Infof("Waiting for %s", description)
+		select {
+		case <-time.After(awsRetrySeconds * time.Second):
+			continue
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for %s: %w", description, ctx.Err())
+		}
+	}
+}
+
+func (r *RDS) isClusterRestoredBy(clusterID, restoreID string) (bool, error) {
+	dbCluster, err := r.describeDBCluster(clusterID)
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == rds.ErrCodeDBClusterNotFoundFault {
+			return false, nil
+		}
+		return false, err
+	}
+	if *dbCluster.Status == dbDeletingStatus {
+		return false, nil
+	}
+	for _, tag := range dbCluster.TagList {
+		if aws.StringValue(tag.Key) == dbRestoreIDTagKey && aws.StringValue(tag.Value) == restoreID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *RDS) describeDBClusterSnapshot(ctx context.Context, snapshotID string) (*rds.DBClusterSnapshot, error) {
+	result, err := r.rdsClient.DescribeDBClusterSnapshotsWithContext(ctx, &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == rds.ErrCodeDBClusterSnapshotNotFoundFault {
+			return nil, fmt.Errorf("%w: %s", cloudprovider.ErrDBSnapshotNotFound, snapshotID)
+		}
+		return nil, fmt.Errorf("retrieving DB cluster snapshot description: %w", err)
+	}
+
+	if len(result.DBClusterSnapshots) != 1 {
+		return nil, fmt.Errorf("%w: %s", cloudprovider.ErrDBSnapshotNotFound, snapshotID)
+	}
+
+	return result.DBClusterSnapshots[0], nil
+}
+
+func (r *RDS) ensureDBClusterCreated(clusterID, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) error {
+	dbCluster, err := r.describeDBCluster(clusterID)
+	if err != nil && !isDBClusterNotFound(err) {
+		return fmt.Errorf("checking if DB cluster exists: %w", err)
+	}
+	if err == nil {
+		return r.ensureDBClusterCapacity(dbCluster, withDBSpecDefaults(spec))
 	}
 
 	glog.Infof("Initiating provisioning of RDS database cluster %s.", clusterID)
-	_, err = r.rdsClient.CreateDBCluster(newCreateCentralDBClusterInput(clusterID, masterPassword, r.dbSecurityGroup, r.dbSubnetGroup))
+	_, err = r.rdsClient.CreateDBCluster(newCreateCentralDBClusterInput(clusterID, masterPassword, r.dbSecurityGroup, r.dbSubnetGroup, withDBSpecDefaults(spec)))
 	if err != nil {
 		return fmt.Errorf("creating DB cluster: %w", err)
 	}
@@ -134,6 +487,23 @@ func (r *RDS) ensureDBClusterCreated(clusterID, masterPassword string) error {
 	return nil
 }
 
+// ensureDBClusterCapacity scales an existing DB cluster to the capacity of the spec, e.g. after the plan of its Central
+// changed. A DB cluster can only be modified once it is available, so the capacity of a DB cluster which is not available
+// yet is updated on a later reconciliation.
+func (r *RDS) ensureDBClusterCapacity(dbCluster *rds.DBCluster, spec private.ManagedCentralAllOfSpecCentralDb) error {
+	clusterID := aws.StringValue(dbCluster.DBClusterIdentifier)
+	input := newModifyCentralDBClusterCapacityInput(dbCluster, spec)
+	if input == nil || aws.StringValue(dbCluster.Status) != dbAvailableStatus {
+		return nil
+	}
+
+	glog.Infof("Scaling RDS database cluster %s to a capacity of %v-%v ACUs.", clusterID, spec.MinCapacity, spec.MaxCapacity)
+	if _, err := r.rdsClient.ModifyDBCluster(input); err != nil {
+		return fmt.Errorf("modifying capacity of DB cluster %s: %w", clusterID, err)
+	}
+	return nil
+}
+
 func (r *RDS) ensureDBInstanceCreated(instanceID string, clusterID string) error {
 	instanceExists, err := r.instanceExists(instanceID)
 	if err != nil {
@@ -154,12 +524,8 @@ func (r *RDS) ensureDBInstanceCreated(instanceID string, clusterID string) error
 
 func (r *RDS) clusterExists(clusterID string) (bool, error) {
 	if _, err := r.describeDBCluster(clusterID); err != nil {
-		var aerr awserr.Error
-		if errors.As(err, &aerr) {
-			switch aerr.Code() {
-			case rds.ErrCodeDBClusterNotFoundFault:
-				return false, nil
-			}
+		if isDBClusterNotFound(err) {
+			return false, nil
 		}
 		return false, err
 	}
@@ -167,6 +533,11 @@ func (r *RDS) clusterExists(clusterID string) (bool, error) {
 	return true, nil
 }
 
+func isDBClusterNotFound(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == rds.ErrCodeDBClusterNotFoundFault
+}
+
 func (r *RDS) instanceExists(instanceID string) (bool, error) {
 	if _, err := r.describeDBInstance(instanceID); err != nil {
 		var aerr awserr.Error
@@ -286,24 +657,87 @@ func getInstanceID(databaseID string) string {
 	return dbPrefix + databaseID + dbInstanceSuffix
 }
 
-func newCreateCentralDBClusterInput(clusterID, dbPassword, securityGroup, subnetGroup string) *rds.CreateDBClusterInput {
+// getRestoreClusterID returns the identifier of the DB cluster a snapshot is restored into before it replaces the DB
+// cluster of a Central
+func getRestoreClusterID(databaseID string) string {
+	return getClusterID(databaseID) + dbRestoreSuffix
+}
+
+func getRestoreInstanceID(databaseID string) string {
+	return getInstanceID(databaseID) + dbRestoreSuffix
+}
+
+func getSnapshotID(databaseID, suffix string, now time.Time) string {
+	return dbPrefix + databaseID + suffix + now.UTC().Format(dbSnapshotTimeFormat)
+}
+
+func convertDBClusterSnapshot(snapshot *rds.DBClusterSnapshot) cloudprovider.DBSnapshot {
+	status := cloudprovider.DBSnapshotStatusCreating
+	switch aws.StringValue(snapshot.Status) {
+	case dbAvailableStatus:
+		status = cloudprovider.DBSnapshotStatusAvailable
+	case dbFailedStatus:
+		status = cloudprovider.DBSnapshotStatusFailed
+	}
+	return cloudprovider.DBSnapshot{
+		ID:        aws.StringValue(snapshot.DBClusterSnapshotIdentifier),
+		Status:    status,
+		CreatedAt: aws.TimeValue(snapshot.SnapshotCreateTime),
+	}
+}
+
+// withDBSpecDefaults returns the given spec with the unset fields set to their defaults
+func withDBSpecDefaults(spec private.ManagedCentralAllOfSpecCentralDb) private.ManagedCentralAllOfSpecCentralDb {
+	if spec.EngineVersion == "" {
+		spec.EngineVersion = defaultDBEngineVersion
+	}
+	if spec.MinCapacity == 0 {
+		spec.MinCapacity = defaultDBMinCapacityACU
+	}
+	if spec.MaxCapacity == 0 {
+		spec.MaxCapacity = defaultDBMaxCapacityACU
+	}
+	if spec.BackupRetentionDays == 0 {
+		spec.BackupRetentionDays = defaultDBBackupRetentionPeriod
+	}
+	return spec
+}
+
+func newCreateCentralDBClusterInput(clusterID, dbPassword, securityGroup, subnetGroup string, spec private.ManagedCentralAllOfSpecCentralDb) *rds.CreateDBClusterInput {
 	return &rds.CreateDBClusterInput{
 		DBClusterIdentifier: aws.String(clusterID),
 		Engine:              aws.String(dbEngine),
-		EngineVersion:       aws.String(dbEngineVersion),
+		EngineVersion:       aws.String(spec.EngineVersion),
 		MasterUsername:      aws.String(dbUser),
 		MasterUserPassword:  aws.String(dbPassword),
 		VpcSecurityGroupIds: aws.StringSlice([]string{securityGroup}),
 		DBSubnetGroupName:   aws.String(subnetGroup),
 		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfiguration{
-			MinCapacity: aws.Float64(dbMinCapacityACU),
-			MaxCapacity: aws.Float64(dbMaxCapacityACU),
+			MinCapacity: aws.Float64(spec.MinCapacity),
+			MaxCapacity: aws.Float64(spec.MaxCapacity),
 		},
-		BackupRetentionPeriod: aws.Int64(dbBackupRetentionPeriod),
+		BackupRetentionPeriod: aws.Int64(int64(spec.BackupRetentionDays)),
 		StorageEncrypted:      aws.Bool(true),
 	}
 }
 
+// newModifyCentralDBClusterCapacityInput returns the input to scale the DB cluster to the capacity of the spec, or nil
+// if the DB cluster already has the desired capacity.
+func newModifyCentralDBClusterCapacityInput(dbCluster *rds.DBCluster, spec private.ManagedCentralAllOfSpecCentralDb) *rds.ModifyDBClusterInput {
+	scaling := dbCluster.ServerlessV2ScalingConfiguration
+	if scaling != nil && aws.Float64Value(scaling.MinCapacity) == spec.MinCapacity && aws.Float64Value(scaling.MaxCapacity) == spec.MaxCapacity {
+		return nil
+	}
+	return &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: dbCluster.DBClusterIdentifier,
+		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(spec.MinCapacity),
+			MaxCapacity: aws.Float64(spec.MaxCapacity),
+		},
+		ApplyImmediately: aws.Bool(true),
+	}
+}
+
 func newCreateCentralDBInstanceInput(clusterID, instanceID string, performanceInsights bool) *rds.CreateDBInstanceInput {
 	return &rds.CreateDBInstanceInput{
 		DBInstanceClass:           aws.String(dbInstanceClass),
@@ -322,10 +756,35 @@ func newDeleteCentralDBInstanceInput(instanceID string, skipFinalSnapshot bool)
 	}
 }
 
-func newDeleteCentralDBClusterInput(clusterID string, skipFinalSnapshot bool) *rds.DeleteDBClusterInput {
-	return &rds.DeleteDBClusterInput{
+// newDeleteCentralDBClusterInput returns the input to delete a DB cluster. The final snapshot is skipped if
+// finalSnapshotID is empty.
+func newDeleteCentralDBClusterInput(clusterID, finalSnapshotID string) *rds.DeleteDBClusterInput {
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SkipFinalSnapshot:   aws.Bool(finalSnapshotID == ""),
+	}
+	if finalSnapshotID != "" {
+		input.FinalDBSnapshotIdentifier = aws.String(finalSnapshotID)
+	}
+	return input
+}
+
+func newRestoreCentralDBClusterInput(clusterID, snapshotID, restoreID, securityGroup, subnetGroup string, spec private.ManagedCentralAllOfSpecCentralDb) *rds.RestoreDBClusterFromSnapshotInput {
+	return &rds.RestoreDBClusterFromSnapshotInput{
 		DBClusterIdentifier: aws.String(clusterID),
-		SkipFinalSnapshot:   aws.Bool(skipFinalSnapshot),
+		SnapshotIdentifier:  aws.String(snapshotID),
+		Engine:              aws.String(dbEngine),
+		EngineVersion:       aws.String(spec.EngineVersion),
+		VpcSecurityGroupIds: aws.StringSlice([]string{securityGroup}),
+		DBSubnetGroupName:   aws.String(subnetGroup),
+		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(spec.MinCapacity),
+			MaxCapacity: aws.Float64(spec.MaxCapacity),
+		},
+		Tags: []*rds.Tag{{
+			Key:   aws.String(dbRestoreIDTagKey),
+			Value: aws.String(restoreID),
+		}},
 	}
 }
 
@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/google/uuid"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/rox/pkg/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -89,7 +91,7 @@ func TestRDSProvisioning(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, instanceExists)
 
-	_, err = rdsClient.EnsureDBProvisioned(ctx, dbID, dbMasterPassword)
+	_, err = rdsClient.EnsureDBProvisioned(ctx, dbID, dbMasterPassword, private.ManagedCentralAllOfSpecCentralDb{})
 	assert.NoError(t, err)
 
 	clusterExists, err = rdsClient.clusterExists(clusterID)
@@ -119,3 +121,122 @@ func TestRDSProvisioning(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, clusterDeleted)
 }
+
+func TestNewCreateCentralDBClusterInputUsesDBSpec(t *testing.T) {
+	tests := []struct {
+		name                string
+		spec                private.ManagedCentralAllOfSpecCentralDb
+		wantEngineVersion   string
+		wantMinCapacity     float64
+		wantMaxCapacity     float64
+		wantBackupRetention int64
+	}{
+		{
+			name:                "defaults for an empty spec",
+			wantEngineVersion:   defaultDBEngineVersion,
+			wantMinCapacity:     defaultDBMinCapacityACU,
+			wantMaxCapacity:     defaultDBMaxCapacityACU,
+			wantBackupRetention: defaultDBBackupRetentionPeriod,
+		},
+		{
+			name: "values of the spec",
+			spec: private.ManagedCentralAllOfSpecCentralDb{
+				EngineVersion:       "14.3",
+				MinCapacity:         1,
+				MaxCapacity:         8,
+				BackupRetentionDays: 7,
+			},
+			wantEngineVersion:   "14.3",
+			wantMinCapacity:     1,
+			wantMaxCapacity:     8,
+			wantBackupRetention: 7,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := newCreateCentralDBClusterInput("cluster", "password", "sg", "subnet", withDBSpecDefaults(tc.spec))
+			assert.Equal(t, tc.wantEngineVersion, *input.EngineVersion)
+			assert.Equal(t, tc.wantMinCapacity, *input.ServerlessV2ScalingConfiguration.MinCapacity)
+			assert.Equal(t, tc.wantMaxCapacity, *input.ServerlessV2ScalingConfiguration.MaxCapacity)
+			assert.Equal(t, tc.wantBackupRetention, *input.BackupRetentionPeriod)
+		})
+	}
+}
+
+func TestNewModifyCentralDBClusterCapacityInput(t *testing.T) {
+	spec := private.ManagedCentralAllOfSpecCentralDb{MinCapacity: 1, MaxCapacity: 8}
+	tests := []struct {
+		name       string
+		scaling    *rds.ServerlessV2ScalingConfigurationInfo
+		wantModify bool
+	}{
+		{
+			name:    "unchanged capacity",
+			scaling: &rds.ServerlessV2ScalingConfigurationInfo{MinCapacity: aws.Float64(1), MaxCapacity: aws.Float64(8)},
+		},
+		{
+			name:       "changed max capacity",
+			scaling:    &rds.ServerlessV2ScalingConfigurationInfo{MinCapacity: aws.Float64(1), MaxCapacity: aws.Float64(16)},
+			wantModify: true,
+		},
+		{
+			name:       "missing scaling configuration",
+			wantModify: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dbCluster := &rds.DBCluster{DBClusterIdentifier: aws.String("cluster"), ServerlessV2ScalingConfiguration: tc.scaling}
+			input := newModifyCentralDBClusterCapacityInput(dbCluster, spec)
+			if !tc.wantModify {
+				assert.Nil(t, input)
+				return
+			}
+			require.NotNil(t, input)
+			assert.Equal(t, "cluster", *input.DBClusterIdentifier)
+			assert.Equal(t, 1.0, *input.ServerlessV2ScalingConfiguration.MinCapacity)
+			assert.Equal(t, 8.0, *input.ServerlessV2ScalingConfiguration.MaxCapacity)
+			assert.True(t, *input.ApplyImmediately)
+		})
+	}
+}
+
+func TestNewDeleteCentralDBClusterInputTakesFinalSnapshot(t *testing.T) {
+	snapshotID := getSnapshotID("central", dbFinalSnapshotSuffix, time.Date(2022, 11, 15, 10, 30, 5, 0, time.UTC))
+	assert.Equal(t, "rhacs-central-final-20221115103005", snapshotID)
+
+	input := newDeleteCentralDBClusterInput("cluster", snapshotID)
+	assert.False(t, *input.SkipFinalSnapshot)
+	assert.Equal(t, snapshotID, *input.FinalDBSnapshotIdentifier)
+
+	input = newDeleteCentralDBClusterInput("cluster", "")
+	assert.True(t, *input.SkipFinalSnapshot)
+	assert.Nil(t, input.FinalDBSnapshotIdentifier)
+}
+
+func TestConvertDBClusterSnapshot(t *testing.T) {
+	createdAt := time.Date(2022, 11, 15, 10, 30, 5, 0, time.UTC)
+	for rdsStatus, wantStatus := range map[string]string{
+		"creating":  cloudprovider.DBSnapshotStatusCreating,
+		"copying":   cloudprovider.DBSnapshotStatusCreating,
+		"available": cloudprovider.DBSnapshotStatusAvailable,
+		"failed":    cloudprovider.DBSnapshotStatusFailed,
+	} {
+		snapshot := convertDBClusterSnapshot(&rds.DBClusterSnapshot{
+			DBClusterSnapshotIdentifier: aws.String("snapshot"),
+			Status:                      aws.String(rdsStatus),
+			SnapshotCreateTime:          aws.Time(createdAt),
+		})
+		assert.Equal(t, cloudprovider.DBSnapshot{ID: "snapshot", Status: wantStatus, CreatedAt: createdAt}, snapshot, rdsStatus)
+	}
+}
+
+func TestGetRestoreIDsAreValidRDSIdentifiers(t *testing.T) {
+	databaseID := "cdkgmd7ma7f0p9ec7v1g"
+	assert.Equal(t, "rhacs-cdkgmd7ma7f0p9ec7v1g-db-cluster-restore", getRestoreClusterID(databaseID))
+	assert.Equal(t, "rhacs-cdkgmd7ma7f0p9ec7v1g-db-instance-restore", getRestoreInstanceID(databaseID))
+	// RDS identifiers are limited to 63 characters
+	assert.LessOrEqual(t, len(getRestoreInstanceID(databaseID)), 63)
+}
@@ -3,16 +3,72 @@ package cloudprovider
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+)
+
+const (
+	// DBSnapshotStatusCreating - the snapshot is being created
+	DBSnapshotStatusCreating = "creating"
+	// DBSnapshotStatusAvailable - the snapshot was created and can be restored
+	DBSnapshotStatusAvailable = "available"
+	// DBSnapshotStatusFailed - the snapshot could not be created
+	DBSnapshotStatusFailed = "failed"
+)
+
+var (
+	// ErrNotSupported is returned by DB clients which do not support an operation
+	ErrNotSupported = errors.New("operation is not supported by the DB provider")
+	// ErrDBSnapshotNotFound is returned if a database is restored from a snapshot which does not exist or which is not a
+	// snapshot of the database
+	ErrDBSnapshotNotFound = errors.New("DB snapshot not found")
+	// ErrDBNotFound is returned if the status of a database which does not exist is requested
+	ErrDBNotFound = errors.New("DB not found")
 )
 
+// DBSnapshot is a snapshot of a database
+type DBSnapshot struct {
+	ID string
+	// Status is one of DBSnapshotStatusCreating, DBSnapshotStatusAvailable or DBSnapshotStatusFailed
+	Status    string
+	CreatedAt time.Time
+}
+
+// DBStatus is the status of a database
+type DBStatus struct {
+	// Available is true if the database accepts connections
+	Available bool
+	// Status is the provider specific status of the database
+	Status string
+}
+
 // DBClient defines an interface for clients that can provision and deprovision databases on cloud providers
 //
 //go:generate moq -out dbclient_moq.go . DBClient
 type DBClient interface {
 	// EnsureDBProvisioned is a blocking function that makes sure that a database with the given databaseID was provisioned,
-	// using the master password given as parameter
-	EnsureDBProvisioned(ctx context.Context, databaseID, passwordSecretName string) (string, error)
+	// using the master password given as parameter. The database is sized according to the given spec, unset fields of
+	// the spec fall back to provider specific defaults.
+	EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error)
 	// EnsureDBDeprovisioned is a non-blocking function that makes sure that a managed DB is deprovisioned (more
-	// specifically, that its deletion was initiated)
+	// specifically, that its deletion was initiated). A final snapshot of the database is taken if the provider
+	// supports snapshots.
 	EnsureDBDeprovisioned(databaseID string) (bool, error)
+	// EnsureDBSnapshotCreated is a non-blocking function that makes sure that the creation of a snapshot with the given
+	// snapshotID of a database was initiated, and returns the current state of the snapshot
+	EnsureDBSnapshotCreated(ctx context.Context, databaseID, snapshotID string) (*DBSnapshot, error)
+	// ListDBSnapshots returns the snapshots of a database, including the final snapshot of a deprovisioned database
+	ListDBSnapshots(ctx context.Context, databaseID string) ([]DBSnapshot, error)
+	// EnsureDBRestored is a blocking function that makes sure that a database was restored from the given snapshot by the
+	// restore with the given restoreID. Only snapshots of the database itself can be restored. An existing database is
+	// replaced by the restored one once the restored database is available. The restored database uses the given master
+	// password and spec.
+	EnsureDBRestored(ctx context.Context, databaseID, restoreID, snapshotID, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error)
+	// GetDBStatus returns the current status of a database. It returns ErrDBNotFound if the database does not exist.
+	GetDBStatus(ctx context.Context, databaseID string) (*DBStatus, error)
+	// ResetDBMasterPassword changes the master password of an existing database to the given one. It returns
+	// ErrDBNotFound if the database does not exist.
+	ResetDBMasterPassword(ctx context.Context, databaseID, masterPassword string) error
 }
@@ -5,6 +5,7 @@ package cloudprovider
 
 import (
 	"context"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"sync"
 )
 
@@ -21,9 +22,24 @@ var _ DBClient = &DBClientMock{}
 //			EnsureDBDeprovisionedFunc: func(databaseID string) (bool, error) {
 //				panic("mock out the EnsureDBDeprovisioned method")
 //			},
-//			EnsureDBProvisionedFunc: func(ctx context.Context, databaseID string, passwordSecretName string) (string, error) {
+//			EnsureDBProvisionedFunc: func(ctx context.Context, databaseID string, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error) {
 //				panic("mock out the EnsureDBProvisioned method")
 //			},
+//			EnsureDBRestoredFunc: func(ctx context.Context, databaseID string, restoreID string, snapshotID string, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+//				panic("mock out the EnsureDBRestored method")
+//			},
+//			EnsureDBSnapshotCreatedFunc: func(ctx context.Context, databaseID string, snapshotID string) (*DBSnapshot, error) {
+//				panic("mock out the EnsureDBSnapshotCreated method")
+//			},
+//			GetDBStatusFunc: func(ctx context.Context, databaseID string) (*DBStatus, error) {
+//				panic("mock out the GetDBStatus method")
+//			},
+//			ListDBSnapshotsFunc: func(ctx context.Context, databaseID string) ([]DBSnapshot, error) {
+//				panic("mock out the ListDBSnapshots method")
+//			},
+//			ResetDBMasterPasswordFunc: func(ctx context.Context, databaseID string, masterPassword string) error {
+//				panic("mock out the ResetDBMasterPassword method")
+//			},
 //		}
 //
 //		// use mockedDBClient in code that requires DBClient
@@ -35,7 +51,22 @@ type DBClientMock struct {
 	EnsureDBDeprovisionedFunc func(databaseID string) (bool, error)
 
 	// EnsureDBProvisionedFunc mocks the EnsureDBProvisioned method.
-	EnsureDBProvisionedFunc func(ctx context.Context, databaseID string, passwordSecretName string) (string, error)
+	EnsureDBProvisionedFunc func(ctx context.Context, databaseID string, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error)
+
+	// EnsureDBRestoredFunc mocks the EnsureDBRestored method.
+	EnsureDBRestoredFunc func(ctx context.Context, databaseID string, restoreID string, snapshotID string, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error)
+
+	// EnsureDBSnapshotCreatedFunc mocks the EnsureDBSnapshotCreated method.
+	EnsureDBSnapshotCreatedFunc func(ctx context.Context, databaseID string, snapshotID string) (*DBSnapshot, error)
+
+	// GetDBStatusFunc mocks the GetDBStatus method.
+	GetDBStatusFunc func(ctx context.Context, databaseID string) (*DBStatus, error)
+
+	// ListDBSnapshotsFunc mocks the ListDBSnapshots method.
+	ListDBSnapshotsFunc func(ctx context.Context, databaseID string) ([]DBSnapshot, error)
+
+	// ResetDBMasterPasswordFunc mocks the ResetDBMasterPassword method.
+	ResetDBMasterPasswordFunc func(ctx context.Context, databaseID string, masterPassword string) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -50,12 +81,66 @@ type DBClientMock struct {
 			Ctx context.Context
 			// DatabaseID is the databaseID argument value.
 			DatabaseID string
-			// PasswordSecretName is the passwordSecretName argument value.
-			PasswordSecretName string
+			// MasterPassword is the masterPassword argument value.
+			MasterPassword string
+			// Spec is the spec argument value.
+			Spec private.ManagedCentralAllOfSpecCentralDb
+		}
+		// EnsureDBRestored holds details about calls to the EnsureDBRestored method.
+		EnsureDBRestored []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+			// RestoreID is the restoreID argument value.
+			RestoreID string
+			// SnapshotID is the snapshotID argument value.
+			SnapshotID string
+			// MasterPassword is the masterPassword argument value.
+			MasterPassword string
+			// Spec is the spec argument value.
+			Spec private.ManagedCentralAllOfSpecCentralDb
+		}
+		// EnsureDBSnapshotCreated holds details about calls to the EnsureDBSnapshotCreated method.
+		EnsureDBSnapshotCreated []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+			// SnapshotID is the snapshotID argument value.
+			SnapshotID string
+		}
+		// GetDBStatus holds details about calls to the GetDBStatus method.
+		GetDBStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+		}
+		// ListDBSnapshots holds details about calls to the ListDBSnapshots method.
+		ListDBSnapshots []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+		}
+		// ResetDBMasterPassword holds details about calls to the ResetDBMasterPassword method.
+		ResetDBMasterPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+			// MasterPassword is the masterPassword argument value.
+			MasterPassword string
 		}
 	}
-	lockEnsureDBDeprovisioned sync.RWMutex
-	lockEnsureDBProvisioned   sync.RWMutex
+	lockEnsureDBDeprovisioned   sync.RWMutex
+	lockEnsureDBProvisioned     sync.RWMutex
+	lockEnsureDBRestored        sync.RWMutex
+	lockEnsureDBSnapshotCreated sync.RWMutex
+	lockGetDBStatus             sync.RWMutex
+	lockListDBSnapshots         sync.RWMutex
+	lockResetDBMasterPassword   sync.RWMutex
 }
 
 // EnsureDBDeprovisioned calls EnsureDBDeprovisionedFunc.
@@ -91,23 +176,25 @@ func (mock *DBClientMock) EnsureDBDeprovisionedCalls() []struct {
 }
 
 // EnsureDBProvisioned calls EnsureDBProvisionedFunc.
-func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID string, passwordSecretName string) (string, error) {
+func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID string, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error) {
 	if mock.EnsureDBProvisionedFunc == nil {
 		panic("DBClientMock.EnsureDBProvisionedFunc: method is nil but DBClient.EnsureDBProvisioned was just called")
 	}
 	callInfo := struct {
-		Ctx                context.Context
-		DatabaseID         string
-		PasswordSecretName string
+		Ctx            context.Context
+		DatabaseID     string
+		MasterPassword string
+		Spec           private.ManagedCentralAllOfSpecCentralDb
 	}{
-		Ctx:                ctx,
-		DatabaseID:         databaseID,
-		PasswordSecretName: passwordSecretName,
+		Ctx:            ctx,
+		DatabaseID:     databaseID,
+		MasterPassword: masterPassword,
+		Spec:           spec,
 	}
 	mock.lockEnsureDBProvisioned.Lock()
 	mock.calls.EnsureDBProvisioned = append(mock.calls.EnsureDBProvisioned, callInfo)
 	mock.lockEnsureDBProvisioned.Unlock()
-	return mock.EnsureDBProvisionedFunc(ctx, databaseID, passwordSecretName)
+	return mock.EnsureDBProvisionedFunc(ctx, databaseID, masterPassword, spec)
 }
 
 // EnsureDBProvisionedCalls gets all the calls that were made to EnsureDBProvisioned.
@@ -115,17 +202,223 @@ func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID st
 //
 //	len(mockedDBClient.EnsureDBProvisionedCalls())
 func (mock *DBClientMock) EnsureDBProvisionedCalls() []struct {
-	Ctx                context.Context
-	DatabaseID         string
-	PasswordSecretName string
+	Ctx            context.Context
+	DatabaseID     string
+	MasterPassword string
+	Spec           private.ManagedCentralAllOfSpecCentralDb
 } {
 	var calls []struct {
-		Ctx                context.Context
-		DatabaseID         string
-		PasswordSecretName string
+		Ctx            context.Context
+		DatabaseID     string
+		MasterPassword string
+		Spec           private.ManagedCentralAllOfSpecCentralDb
 	}
 	mock.lockEnsureDBProvisioned.RLock()
 	calls = mock.calls.EnsureDBProvisioned
 	mock.lockEnsureDBProvisioned.RUnlock()
 	return calls
 }
+
+// EnsureDBRestored calls EnsureDBRestoredFunc.
+func (mock *DBClientMock) EnsureDBRestored(ctx context.Context, databaseID string, restoreID string, snapshotID string, masterPassword string, spec private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+	if mock.EnsureDBRestoredFunc == nil {
+		panic("DBClientMock.EnsureDBRestoredFunc: method is nil but DBClient.EnsureDBRestored was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		DatabaseID     string
+		RestoreID      string
+		SnapshotID     string
+		MasterPassword string
+		Spec           private.ManagedCentralAllOfSpecCentralDb
+	}{
+		Ctx:            ctx,
+		DatabaseID:     databaseID,
+		RestoreID:      restoreID,
+		SnapshotID:     snapshotID,
+		MasterPassword: masterPassword,
+		Spec:           spec,
+	}
+	mock.lockEnsureDBRestored.Lock()
+	mock.calls.EnsureDBRestored = append(mock.calls.EnsureDBRestored, callInfo)
+	mock.lockEnsureDBRestored.Unlock()
+	return mock.EnsureDBRestoredFunc(ctx, databaseID, restoreID, snapshotID, masterPassword, spec)
+}
+
+// EnsureDBRestoredCalls gets all the calls that were made to EnsureDBRestored.
+// Check the length with:
+//
+//	len(mockedDBClient.EnsureDBRestoredCalls())
+func (mock *DBClientMock) EnsureDBRestoredCalls() []struct {
+	Ctx            context.Context
+	DatabaseID     string
+	RestoreID      string
+	SnapshotID     string
+	MasterPassword string
+	Spec           private.ManagedCentralAllOfSpecCentralDb
+} {
+	var calls []struct {
+		Ctx            context.Context
+		DatabaseID     string
+		RestoreID      string
+		SnapshotID     string
+		MasterPassword string
+		Spec           private.ManagedCentralAllOfSpecCentralDb
+	}
+	mock.lockEnsureDBRestored.RLock()
+	calls = mock.calls.EnsureDBRestored
+	mock.lockEnsureDBRestored.RUnlock()
+	return calls
+}
+
+// EnsureDBSnapshotCreated calls EnsureDBSnapshotCreatedFunc.
+func (mock *DBClientMock) EnsureDBSnapshotCreated(ctx context.Context, databaseID string, snapshotID string) (*DBSnapshot, error) {
+	if mock.EnsureDBSnapshotCreatedFunc == nil {
+		panic("DBClientMock.EnsureDBSnapshotCreatedFunc: method is nil but DBClient.EnsureDBSnapshotCreated was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DatabaseID string
+		SnapshotID string
+	}{
+		Ctx:        ctx,
+		DatabaseID: databaseID,
+		SnapshotID: snapshotID,
+	}
+	mock.lockEnsureDBSnapshotCreated.Lock()
+	mock.calls.EnsureDBSnapshotCreated = append(mock.calls.EnsureDBSnapshotCreated, callInfo)
+	mock.lockEnsureDBSnapshotCreated.Unlock()
+	return mock.EnsureDBSnapshotCreatedFunc(ctx, databaseID, snapshotID)
+}
+
+// EnsureDBSnapshotCreatedCalls gets all the calls that were made to EnsureDBSnapshotCreated.
+// Check the length with:
+//
+//	len(mockedDBClient.EnsureDBSnapshotCreatedCalls())
+func (mock *DBClientMock) EnsureDBSnapshotCreatedCalls() []struct {
+	Ctx        context.Context
+	DatabaseID string
+	SnapshotID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DatabaseID string
+		SnapshotID string
+	}
+	mock.lockEnsureDBSnapshotCreated.RLock()
+	calls = mock.calls.EnsureDBSnapshotCreated
+	mock.lockEnsureDBSnapshotCreated.RUnlock()
+	return calls
+}
+
+// GetDBStatus calls GetDBStatusFunc.
+func (mock *DBClientMock) GetDBStatus(ctx context.Context, databaseID string) (*DBStatus, error) {
+	if mock.GetDBStatusFunc == nil {
+		panic("DBClientMock.GetDBStatusFunc: method is nil but DBClient.GetDBStatus was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DatabaseID string
+	}{
+		Ctx:        ctx,
+		DatabaseID: databaseID,
+	}
+	mock.lockGetDBStatus.Lock()
+	mock.calls.GetDBStatus = append(mock.calls.GetDBStatus, callInfo)
+	mock.lockGetDBStatus.Unlock()
+	return mock.GetDBStatusFunc(ctx, databaseID)
+}
+
+// GetDBStatusCalls gets all the calls that were made to GetDBStatus.
+// Check the length with:
+//
+//	len(mockedDBClient.GetDBStatusCalls())
+func (mock *DBClientMock) GetDBStatusCalls() []struct {
+	Ctx        context.Context
+	DatabaseID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DatabaseID string
+	}
+	mock.lockGetDBStatus.RLock()
+	calls = mock.calls.GetDBStatus
+	mock.lockGetDBStatus.RUnlock()
+	return calls
+}
+
+// ListDBSnapshots calls ListDBSnapshotsFunc.
+func (mock *DBClientMock) ListDBSnapshots(ctx context.Context, databaseID string) ([]DBSnapshot, error) {
+	if mock.ListDBSnapshotsFunc == nil {
+		panic("DBClientMock.ListDBSnapshotsFunc: method is nil but DBClient.ListDBSnapshots was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DatabaseID string
+	}{
+		Ctx:        ctx,
+		DatabaseID: databaseID,
+	}
+	mock.lockListDBSnapshots.Lock()
+	mock.calls.ListDBSnapshots = append(mock.calls.ListDBSnapshots, callInfo)
+	mock.lockListDBSnapshots.Unlock()
+	return mock.ListDBSnapshotsFunc(ctx, databaseID)
+}
+
+// ListDBSnapshotsCalls gets all the calls that were made to ListDBSnapshots.
+// Check the length with:
+//
+//	len(mockedDBClient.ListDBSnapshotsCalls())
+func (mock *DBClientMock) ListDBSnapshotsCalls() []struct {
+	Ctx        context.Context
+	DatabaseID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DatabaseID string
+	}
+	mock.lockListDBSnapshots.RLock()
+	calls = mock.calls.ListDBSnapshots
+	mock.lockListDBSnapshots.RUnlock()
+	return calls
+}
+
+// ResetDBMasterPassword calls ResetDBMasterPasswordFunc.
+func (mock *DBClientMock) ResetDBMasterPassword(ctx context.Context, databaseID string, masterPassword string) error {
+	if mock.ResetDBMasterPasswordFunc == nil {
+		panic("DBClientMock.ResetDBMasterPasswordFunc: method is nil but DBClient.ResetDBMasterPassword was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		DatabaseID     string
+		MasterPassword string
+	}{
+		Ctx:            ctx,
+		DatabaseID:     databaseID,
+		MasterPassword: masterPassword,
+	}
+	mock.lockResetDBMasterPassword.Lock()
+	mock.calls.ResetDBMasterPassword = append(mock.calls.ResetDBMasterPassword, callInfo)
+	mock.lockResetDBMasterPassword.Unlock()
+	return mock.ResetDBMasterPasswordFunc(ctx, databaseID, masterPassword)
+}
+
+// ResetDBMasterPasswordCalls gets all the calls that were made to ResetDBMasterPassword.
+// Check the length with:
+//
+//	len(mockedDBClient.ResetDBMasterPasswordCalls())
+func (mock *DBClientMock) ResetDBMasterPasswordCalls() []struct {
+	Ctx            context.Context
+	DatabaseID     string
+	MasterPassword string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		DatabaseID     string
+		MasterPassword string
+	}
+	mock.lockResetDBMasterPassword.RLock()
+	calls = mock.calls.ResetDBMasterPassword
+	mock.lockResetDBMasterPassword.RUnlock()
+	return calls
+}
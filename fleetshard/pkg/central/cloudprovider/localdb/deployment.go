@@ -0,0 +1,262 @@
+// Package localdb provides implementations of cloudprovider.DBClient for development and CI environments, which
+// provision managed databases without a cloud provider.
+package localdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	dbUser        = "rhacs_master"
+	dbName        = "postgres"
+	dbPort        = 5432
+	dbPrefix      = "central-db-"
+	dbPasswordKey = "password"
+	dbDataPath    = "/var/lib/postgresql/data"
+
+	retryInterval = 5 * time.Second
+
+	dbAvailableStatus   = "available"
+	dbUnavailableStatus = "unavailable"
+)
+
+// Deployment provisions a Postgres deployment in the data plane cluster for each Central. The database data is not
+// persisted, and the DB spec of the Central is ignored. Snapshots are not supported.
+type Deployment struct {
+	client    ctrlClient.Client
+	namespace string
+	image     string
+}
+
+// NewDeployment initializes a new localdb.Deployment which creates Postgres deployments with the given image in the
+// given namespace
+func NewDeployment(client ctrlClient.Client, namespace, image string) *Deployment {
+	return &Deployment{
+		client:    client,
+		namespace: namespace,
+		image:     image,
+	}
+}
+
+// EnsureDBProvisioned is a blocking function that makes sure that a Postgres deployment was provisioned for a Central
+func (d *Deployment) EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+	name := getName(databaseID)
+
+	if err := d.ensureNamespaceExists(ctx); err != nil {
+		return "", err
+	}
+
+	secret := &corev1.Secret{ObjectMeta: d.objectMeta(name)}
+	if err := d.ensureCreated(ctx, secret, func() {
+		secret.StringData = map[string]string{dbPasswordKey: masterPassword}
+	}); err != nil {
+		return "", err
+	}
+
+	service := &corev1.Service{ObjectMeta: d.objectMeta(name)}
+	if err := d.ensureCreated(ctx, service, func() {
+		service.Spec = corev1.ServiceSpec{
+			Selector: service.Labels,
+			Ports: []corev1.ServicePort{{
+				Name:       "postgres",
+				Port:       dbPort,
+				TargetPort: intstr.FromInt(dbPort),
+			}},
+		}
+	}); err != nil {
+		return "", err
+	}
+
+	deployment := &appsv1.Deployment{ObjectMeta: d.objectMeta(name)}
+	if err := d.ensureCreated(ctx, deployment, func() {
+		deployment.Spec = d.deploymentSpec(name)
+	}); err != nil {
+		return "", err
+	}
+
+	if err := d.waitForDeploymentToBeReady(ctx, name); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("host=%s.%s.svc port=%d user=%s dbname=%s sslmode=disable", name, d.namespace, dbPort, dbUser, dbName), nil
+}
+
+// EnsureDBDeprovisioned is a non-blocking function that initiates the deletion of the Postgres deployment of a Central
+func (d *Deployment) EnsureDBDeprovisioned(databaseID string) (bool, error) {
+	ctx := context.Background()
+	name := getName(databaseID)
+
+	for _, obj := range []ctrlClient.Object{
+		&appsv1.Deployment{ObjectMeta: d.objectMeta(name)},
+		&corev1.Service{ObjectMeta: d.objectMeta(name)},
+		&corev1.Secret{ObjectMeta: d.objectMeta(name)},
+	} {
+		if err := d.client.Delete(ctx, obj); err != nil && !apiErrors.IsNotFound(err) {
+			return false, fmt.Errorf("deleting %T %s/%s: %w", obj, d.namespace, name, err)
+		}
+	}
+	glog.Infof("Initiated deprovisioning of local database %s/%s.", d.namespace, name)
+	return true, nil
+}
+
+// EnsureDBSnapshotCreated is not supported, local databases have no snapshots
+func (d *Deployment) EnsureDBSnapshotCreated(_ context.Context, _, _ string) (*cloudprovider.DBSnapshot, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+// ListDBSnapshots is not supported, local databases have no snapshots
+func (d *Deployment) ListDBSnapshots(_ context.Context, _ string) ([]cloudprovider.DBSnapshot, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+// EnsureDBRestored is not supported, local databases have no snapshots
+func (d *Deployment) EnsureDBRestored(_ context.Context, _, _, _, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+	return "", cloudprovider.ErrNotSupported
+}
+
+// GetDBStatus returns the status of the Postgres deployment of a Central
+func (d *Deployment) GetDBStatus(ctx context.Context, databaseID string) (*cloudprovider.DBStatus, error) {
+	name := getName(databaseID)
+	deployment := &appsv1.Deployment{}
+	if err := d.client.Get(ctx, ctrlClient.ObjectKey{Namespace: d.namespace, Name: name}, deployment); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, cloudprovider.ErrDBNotFound
+		}
+		return nil, fmt.Errorf("getting deployment %s/%s: %w", d.namespace, name, err)
+	}
+	if deployment.Status.ReadyReplicas > 0 {
+		return &cloudprovider.DBStatus{Available: true, Status: dbAvailableStatus}, nil
+	}
+	return &cloudprovider.DBStatus{Status: dbUnavailableStatus}, nil
+}
+
+// ResetDBMasterPassword is not supported, the password of a local database is only set when it is created. The local
+// database of a Central migrated to another cluster is not shared with the migration target.
+func (d *Deployment) ResetDBMasterPassword(_ context.Context, _, _ string) error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (d *Deployment) ensureNamespaceExists(ctx context.Context) error {
+	namespace := &corev1.Namespace{}
+	err := d.client.Get(ctx, ctrlClient.ObjectKey{Name: d.namespace}, namespace)
+	if err == nil {
+		return nil
+	}
+	if !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("getting namespace %s: %w", d.namespace, err)
+	}
+	namespace.Name = d.namespace
+	if err := d.client.Create(ctx, namespace); err != nil && !apiErrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating namespace %s: %w", d.namespace, err)
+	}
+	return nil
+}
+
+// ensureCreated creates the given object after initializing it with init, unless it exists already
+func (d *Deployment) ensureCreated(ctx context.Context, obj ctrlClient.Object, init func()) error {
+	err := d.client.Get(ctx, ctrlClient.ObjectKeyFromObject(obj), obj)
+	if err == nil {
+		return nil
+	}
+	if !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("getting %T %s/%s: %w", obj, obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	init()
+	glog.Infof("Creating %T %s/%s for local database.", obj, obj.GetNamespace(), obj.GetName())
+	if err := d.client.Create(ctx, obj); err != nil && !apiErrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating %T %s/%s: %w", obj, obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+func (d *Deployment) waitForDeploymentToBeReady(ctx context.Context, name string) error {
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+	for {
+		deployment := &appsv1.Deployment{}
+		if err := d.client.Get(ctx, ctrlClient.ObjectKey{Namespace: d.namespace, Name: name}, deployment); err != nil {
+			return fmt.Errorf("getting deployment %s/%s: %w", d.namespace, name, err)
+		}
+		if deployment.Status.ReadyReplicas > 0 {
+			return nil
+		}
+
+		glog.Infof("Local database %s/%s is not ready yet", d.namespace, name)
+		select {
+		case <-ticker.C:
+			continue
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for local database %s/%s to be ready: %w", d.namespace, name, ctx.Err())
+		}
+	}
+}
+
+func (d *Deployment) objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: d.namespace,
+		Labels:    map[string]string{"app": name},
+	}
+}
+
+func (d *Deployment) deploymentSpec(name string) appsv1.DeploymentSpec {
+	labels := map[string]string{"app": name}
+	return appsv1.DeploymentSpec{
+		Replicas: pointer.Int32(1),
+		Selector: &metav1.LabelSelector{MatchLabels: labels},
+		Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  "postgres",
+					Image: d.image,
+					Env: []corev1.EnvVar{
+						{Name: "POSTGRES_USER", Value: dbUser},
+						{Name: "POSTGRES_DB", Value: dbName},
+						{Name: "PGDATA", Value: dbDataPath + "/pgdata"},
+						{
+							Name: "POSTGRES_PASSWORD",
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: name},
+									Key:                  dbPasswordKey,
+								},
+							},
+						},
+					},
+					Ports: []corev1.ContainerPort{{Name: "postgres", ContainerPort: dbPort}},
+					ReadinessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							Exec: &corev1.ExecAction{Command: []string{"pg_isready", "-U", dbUser, "-d", dbName}},
+						},
+						PeriodSeconds: 5,
+					},
+					VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: dbDataPath}},
+				}},
+				Volumes: []corev1.Volume{{
+					Name:         "data",
+					VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				}},
+			},
+		},
+	}
+}
+
+func getName(databaseID string) string {
+	return dbPrefix + databaseID
+}
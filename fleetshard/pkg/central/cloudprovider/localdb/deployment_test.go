@@ -0,0 +1,66 @@
+package localdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testNamespace = "rhacs-local-db"
+	testDBID      = "cb45idheg5ip6dq1jo4g"
+)
+
+func TestDeploymentProvisioning(t *testing.T) {
+	client := testutils.NewFakeClientBuilder(t).Build()
+	d := NewDeployment(client, testNamespace, "postgres:13")
+	key := ctrlClient.ObjectKey{Namespace: testNamespace, Name: "central-db-" + testDBID}
+
+	// the deployment never becomes ready with the fake client
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := d.GetDBStatus(context.Background(), testDBID)
+	require.ErrorIs(t, err, cloudprovider.ErrDBNotFound)
+	_, err = d.EnsureDBProvisioned(ctx, testDBID, "secret-password", private.ManagedCentralAllOfSpecCentralDb{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	status, err := d.GetDBStatus(context.Background(), testDBID)
+	require.NoError(t, err)
+	assert.False(t, status.Available)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, client.Get(context.Background(), key, secret))
+	assert.Equal(t, "secret-password", secret.StringData[dbPasswordKey])
+	require.NoError(t, client.Get(context.Background(), key, &corev1.Service{}))
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, client.Get(context.Background(), key, deployment))
+	assert.Equal(t, "postgres:13", deployment.Spec.Template.Spec.Containers[0].Image)
+
+	deployment.Status.ReadyReplicas = 1
+	require.NoError(t, client.Status().Update(context.Background(), deployment))
+	connectionString, err := d.EnsureDBProvisioned(context.Background(), testDBID, "secret-password", private.ManagedCentralAllOfSpecCentralDb{})
+	require.NoError(t, err)
+	assert.Equal(t, "host=central-db-cb45idheg5ip6dq1jo4g.rhacs-local-db.svc port=5432 user=rhacs_master dbname=postgres sslmode=disable", connectionString)
+	status, err = d.GetDBStatus(context.Background(), testDBID)
+	require.NoError(t, err)
+	assert.True(t, status.Available)
+
+	deleted, err := d.EnsureDBDeprovisioned(testDBID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	err = client.Get(context.Background(), key, &appsv1.Deployment{})
+	assert.True(t, apiErrors.IsNotFound(err))
+
+	deleted, err = d.EnsureDBDeprovisioned(testDBID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+}
@@ -0,0 +1,159 @@
+package localdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/lib/pq"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+)
+
+const dbRolePrefix = "central_"
+
+// Server creates a database and a role owning it for each Central on an existing Postgres server. The DB spec of the
+// Central is ignored. Snapshots are not supported.
+type Server struct {
+	db      *sql.DB
+	host    string
+	port    int
+	sslMode string
+}
+
+// NewServer initializes a new localdb.Server which connects to the Postgres server with the admin credentials from
+// the given configuration
+func NewServer(cfg config.LocalDB) (*Server, error) {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.AdminUser, cfg.AdminPassword),
+		Host:     cfg.Host + ":" + strconv.Itoa(cfg.Port),
+		Path:     dbName,
+		RawQuery: url.Values{"sslmode": []string{cfg.SSLMode}}.Encode(),
+	}
+	db, err := sql.Open("postgres", dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("opening connection to Postgres server %s: %w", cfg.Host, err)
+	}
+	return &Server{
+		db:      db,
+		host:    cfg.Host,
+		port:    cfg.Port,
+		sslMode: cfg.SSLMode,
+	}, nil
+}
+
+// EnsureDBProvisioned makes sure that a database and a role owning it with the given password exist for a Central
+func (s *Server) EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+	name := getRoleName(databaseID)
+
+	roleExists, err := s.exists(ctx, "SELECT 1 FROM pg_roles WHERE rolname = $1", name)
+	if err != nil {
+		return "", fmt.Errorf("checking if role %s exists: %w", name, err)
+	}
+	roleStmt := "ALTER ROLE %s WITH LOGIN PASSWORD %s"
+	if !roleExists {
+		glog.Infof("Creating role %s on local database server %s.", name, s.host)
+		roleStmt = "CREATE ROLE %s WITH LOGIN PASSWORD %s"
+	}
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf(roleStmt, pq.QuoteIdentifier(name), pq.QuoteLiteral(masterPassword))); err != nil {
+		return "", fmt.Errorf("ensuring role %s: %w", name, err)
+	}
+
+	dbExists, err := s.exists(ctx, "SELECT 1 FROM pg_database WHERE datname = $1", name)
+	if err != nil {
+		return "", fmt.Errorf("checking if database %s exists: %w", name, err)
+	}
+	if !dbExists {
+		glog.Infof("Creating database %s on local database server %s.", name, s.host)
+		if _, err := s.db.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s OWNER %s", pq.QuoteIdentifier(name), pq.QuoteIdentifier(name))); err != nil {
+			return "", fmt.Errorf("creating database %s: %w", name, err)
+		}
+	}
+
+	return fmt.Sprintf("host=%s port=%d user=%s dbname=%s sslmode=%s", s.host, s.port, name, name, s.sslMode), nil
+}
+
+// EnsureDBDeprovisioned drops the database and the role of a Central
+func (s *Server) EnsureDBDeprovisioned(databaseID string) (bool, error) {
+	ctx := context.Background()
+	name := getRoleName(databaseID)
+
+	if _, err := s.db.ExecContext(ctx, "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1", name); err != nil {
+		return false, fmt.Errorf("terminating connections to database %s: %w", name, err)
+	}
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s", pq.QuoteIdentifier(name))); err != nil {
+		return false, fmt.Errorf("dropping database %s: %w", name, err)
+	}
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("DROP ROLE IF EXISTS %s", pq.QuoteIdentifier(name))); err != nil {
+		return false, fmt.Errorf("dropping role %s: %w", name, err)
+	}
+	glog.Infof("Dropped database %s on local database server %s.", name, s.host)
+	return true, nil
+}
+
+// EnsureDBSnapshotCreated is not supported, local databases have no snapshots
+func (s *Server) EnsureDBSnapshotCreated(_ context.Context, _, _ string) (*cloudprovider.DBSnapshot, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+// ListDBSnapshots is not supported, local databases have no snapshots
+func (s *Server) ListDBSnapshots(_ context.Context, _ string) ([]cloudprovider.DBSnapshot, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+// EnsureDBRestored is not supported, local databases have no snapshots
+func (s *Server) EnsureDBRestored(_ context.Context, _, _, _, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+	return "", cloudprovider.ErrNotSupported
+}
+
+// GetDBStatus returns the status of the database of a Central on the local database server
+func (s *Server) GetDBStatus(ctx context.Context, databaseID string) (*cloudprovider.DBStatus, error) {
+	name := getRoleName(databaseID)
+
+	dbExists, err := s.exists(ctx, "SELECT 1 FROM pg_database WHERE datname = $1", name)
+	if err != nil {
+		return nil, fmt.Errorf("checking if database %s exists: %w", name, err)
+	}
+	if !dbExists {
+		return nil, cloudprovider.ErrDBNotFound
+	}
+	return &cloudprovider.DBStatus{Available: true, Status: dbAvailableStatus}, nil
+}
+
+// ResetDBMasterPassword changes the password of the role owning the database of a Central
+func (s *Server) ResetDBMasterPassword(ctx context.Context, databaseID, masterPassword string) error {
+	name := getRoleName(databaseID)
+
+	roleExists, err := s.exists(ctx, "SELECT 1 FROM pg_roles WHERE rolname = $1", name)
+	if err != nil {
+		return fmt.Errorf("checking if role %s exists: %w", name, err)
+	}
+	if !roleExists {
+		return cloudprovider.ErrDBNotFound
+	}
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("ALTER ROLE %s WITH PASSWORD %s", pq.QuoteIdentifier(name), pq.QuoteLiteral(masterPassword))); err != nil {
+		return fmt.Errorf("setting password of role %s: %w", name, err)
+	}
+	return nil
+}
+
+func (s *Server) exists(ctx context.Context, query string, name string) (bool, error) {
+	rows, err := s.db.QueryContext(ctx, query, name)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		return true, nil
+	}
+	return false, rows.Err()
+}
+
+func getRoleName(databaseID string) string {
+	return dbRolePrefix + databaseID
+}
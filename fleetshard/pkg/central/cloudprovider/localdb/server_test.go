@@ -0,0 +1,79 @@
+package localdb
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *Server {
+	cfg := config.LocalDB{}
+	require.NoError(t, env.Parse(&cfg))
+	require.NotEmpty(t, cfg.Host, "MANAGED_DB_LOCAL_HOST must be set")
+
+	server, err := NewServer(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = server.db.Close() })
+	return server
+}
+
+func pingAsCentral(ctx context.Context, connectionString, password string) error {
+	db, err := sql.Open("postgres", connectionString+" password="+password)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.PingContext(ctx)
+}
+
+func TestServerProvisioning(t *testing.T) {
+	if os.Getenv("RUN_LOCAL_DB_TESTS") != "true" {
+		t.Skip("Skip local DB server tests. Set RUN_LOCAL_DB_TESTS=true env variable to enable local DB server tests.")
+	}
+
+	server := newTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, err := server.GetDBStatus(ctx, testDBID)
+	require.ErrorIs(t, err, cloudprovider.ErrDBNotFound)
+	require.ErrorIs(t, server.ResetDBMasterPassword(ctx, testDBID, "other-password"), cloudprovider.ErrDBNotFound)
+
+	connectionString, err := server.EnsureDBProvisioned(ctx, testDBID, "secret-password", private.ManagedCentralAllOfSpecCentralDb{})
+	require.NoError(t, err)
+	defer func() {
+		_, _ = server.EnsureDBDeprovisioned(testDBID)
+	}()
+	assert.Contains(t, connectionString, "user=central_"+testDBID)
+	require.NoError(t, pingAsCentral(ctx, connectionString, "secret-password"))
+
+	// provisioning is idempotent
+	_, err = server.EnsureDBProvisioned(ctx, testDBID, "secret-password", private.ManagedCentralAllOfSpecCentralDb{})
+	require.NoError(t, err)
+
+	status, err := server.GetDBStatus(ctx, testDBID)
+	require.NoError(t, err)
+	assert.True(t, status.Available)
+
+	require.NoError(t, server.ResetDBMasterPassword(ctx, testDBID, "new-password"))
+	require.NoError(t, pingAsCentral(ctx, connectionString, "new-password"))
+	require.Error(t, pingAsCentral(ctx, connectionString, "secret-password"))
+
+	_, err = server.EnsureDBSnapshotCreated(ctx, testDBID, "snapshot")
+	require.ErrorIs(t, err, cloudprovider.ErrNotSupported)
+
+	deleted, err := server.EnsureDBDeprovisioned(testDBID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	_, err = server.GetDBStatus(ctx, testDBID)
+	require.ErrorIs(t, err, cloudprovider.ErrDBNotFound)
+}
@@ -0,0 +1,258 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	openshiftRouteV1 "github.com/openshift/api/route/v1"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/charts"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pauseTenantReconcileAnnotation can be set to "true" on a tenant namespace to stop fleetshard-sync from reconciling
+// the Central of the tenant, e.g. while debugging it on the cluster. Drift is neither detected nor reverted then.
+const pauseTenantReconcileAnnotation = "rhacs.redhat.com/pause-reconcile"
+
+const (
+	driftDetectedReason = "DriftDetected"
+	driftRevertedReason = "DriftReverted"
+)
+
+// isTenantReconciliationPaused returns true if reconciliation was paused with the pause annotation on the namespace of
+// the Central.
+func (r *CentralReconciler) isTenantReconciliationPaused(ctx context.Context, namespace string) (bool, error) {
+	ns, err := r.getNamespace(namespace)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "checking if reconciliation of namespace %s is paused", namespace)
+	}
+	return ns.GetAnnotations()[pauseTenantReconcileAnnotation] == "true", nil
+}
+
+// isDriftCheckDue returns true if the cluster state of the Central has not been compared to its desired state for the
+// drift check period.
+func (r *CentralReconciler) isDriftCheckDue() bool {
+	return r.driftCheckPeriod > 0 && time.Since(r.lastDriftCheck) >= r.driftCheckPeriod
+}
+
+// drift describes the objects of a Central which drifted from their desired state.
+type drift struct {
+	// objects are descriptions of the drifted objects.
+	objects []string
+	// routes are the drifted routes which exist on the cluster. They are deleted to revert the drift, since routes are
+	// only created but never updated.
+	routes []*openshiftRouteV1.Route
+}
+
+// detectDrift compares the Central CR, the objects of the tenant resources chart and the routes on the cluster with
+// their desired state.
+func (r *CentralReconciler) detectDrift(ctx context.Context, remoteCentral private.ManagedCentral, central *v1alpha1.Central) (*drift, error) {
+	result := &drift{}
+
+	centralDrifted, err := r.isCentralCRDrifted(ctx, central)
+	if err != nil {
+		return nil, err
+	}
+	if centralDrifted {
+		result.objects = append(result.objects, "Central/"+central.GetName())
+	}
+
+	chartDrift, err := r.detectChartResourcesDrift(ctx, remoteCentral)
+	if err != nil {
+		return nil, err
+	}
+	result.objects = append(result.objects, chartDrift...)
+
+	if r.useRoutes {
+		if err := r.detectRoutesDrift(ctx, remoteCentral, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *CentralReconciler) isCentralCRDrifted(ctx context.Context, central *v1alpha1.Central) (bool, error) {
+	existingCentral := v1alpha1.Central{}
+	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: central.GetNamespace(), Name: central.GetName()}, &existingCentral)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "retrieving central %s/%s", central.GetNamespace(), central.GetName())
+	}
+
+	desiredSpec := central.Spec.DeepCopy()
+	existingSpec := existingCentral.Spec.DeepCopy()
+	if r.managedDBEnabled {
+		// The connection string of the managed DB is only known after provisioning it, so it is not checked for drift.
+		desiredSpec.Central.DB = nil
+		if existingSpec.Central != nil {
+			existingSpec.Central.DB = nil
+		}
+	}
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desiredSpec)
+	if err != nil {
+		return false, errors.Wrap(err, "converting desired central spec")
+	}
+	existing, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existingSpec)
+	if err != nil {
+		return false, errors.Wrap(err, "converting existing central spec")
+	}
+	return !isObjectSubset(desired, existing), nil
+}
+
+func (r *CentralReconciler) detectChartResourcesDrift(ctx context.Context, remoteCentral private.ManagedCentral) ([]string, error) {
+	vals, err := r.chartValues(remoteCentral)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining values for resources chart: %w", err)
+	}
+	objs, err := charts.RenderToObjects(helmReleaseName, remoteCentral.Metadata.Namespace, r.resourcesChart, vals)
+	if err != nil {
+		return nil, fmt.Errorf("rendering resources chart: %w", err)
+	}
+
+	var drifted []string
+	for _, obj := range objs {
+		key := ctrlClient.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		if key.Namespace == "" {
+			key.Namespace = remoteCentral.Metadata.Namespace
+		}
+		var out unstructured.Unstructured
+		out.SetGroupVersionKind(obj.GroupVersionKind())
+		err := r.client.Get(ctx, key, &out)
+		if err != nil && !apiErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("retrieving object %s/%s of type %v: %w", key.Namespace, key.Name, obj.GroupVersionKind(), err)
+		}
+		if apiErrors.IsNotFound(err) || !isObjectSubset(obj.Object, out.Object) {
+			drifted = append(drifted, obj.GetKind()+"/"+obj.GetName())
+		}
+	}
+	return drifted, nil
+}
+
+func (r *CentralReconciler) detectRoutesDrift(ctx context.Context, remoteCentral private.ManagedCentral, result *drift) error {
+	namespace := remoteCentral.Metadata.Namespace
+	reencryptRoute, err := r.routeService.FindReencryptRoute(ctx, namespace)
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("retrieving reencrypt route for namespace %q: %w", namespace, err)
+	}
+	if apiErrors.IsNotFound(err) {
+		result.objects = append(result.objects, "missing reencrypt Route")
+	} else if isRouteDrifted(reencryptRoute, remoteCentral.Spec.UiEndpoint.Host, openshiftRouteV1.TLSTerminationReencrypt) ||
+		reencryptRoute.Spec.TLS.Key != remoteCentral.Spec.UiEndpoint.Tls.Key ||
+		reencryptRoute.Spec.TLS.Certificate != remoteCentral.Spec.UiEndpoint.Tls.Cert {
+		result.objects = append(result.objects, "Route/"+reencryptRoute.GetName())
+		result.routes = append(result.routes, reencryptRoute)
+	}
+
+	passthroughRoute, err := r.routeService.FindPassthroughRoute(ctx, namespace)
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("retrieving passthrough route for namespace %q: %w", namespace, err)
+	}
+	if apiErrors.IsNotFound(err) {
+		result.objects = append(result.objects, "missing passthrough Route")
+	} else if isRouteDrifted(passthroughRoute, remoteCentral.Spec.DataEndpoint.Host, openshiftRouteV1.TLSTerminationPassthrough) {
+		result.objects = append(result.objects, "Route/"+passthroughRoute.GetName())
+		result.routes = append(result.routes, passthroughRoute)
+	}
+	return nil
+}
+
+func isRouteDrifted(route *openshiftRouteV1.Route, host string, termination openshiftRouteV1.TLSTerminationType) bool {
+	return route.Spec.Host != host ||
+		route.Spec.To.Kind != "Service" || route.Spec.To.Name != "central" ||
+		route.Spec.TLS == nil || route.Spec.TLS.Termination != termination
+}
+
+// ensureDriftedRoutesDeleted deletes drifted routes, so that they are created again with their desired state.
+func (r *CentralReconciler) ensureDriftedRoutesDeleted(ctx context.Context, routes []*openshiftRouteV1.Route) error {
+	for _, route := range routes {
+		if err := r.client.Delete(ctx, route); err != nil && !apiErrors.IsNotFound(err) {
+			return errors.Wrapf(err, "deleting drifted route %s/%s", route.GetNamespace(), route.GetName())
+		}
+	}
+	return nil
+}
+
+// isObjectSubset returns true if all fields of the desired object are set to the same values in the live object.
+// Fields which are only set in the live object, e.g. defaults and the status, are ignored. Empty maps and lists match
+// unset fields, since they are omitted when the object is stored.
+func isObjectSubset(desired, live interface{}) bool {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		if len(desiredValue) == 0 && live == nil {
+			return true
+		}
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range desiredValue {
+			if !isObjectSubset(value, liveValue[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		if len(desiredValue) == 0 && live == nil {
+			return true
+		}
+		liveValue, ok := live.([]interface{})
+		if !ok || len(desiredValue) != len(liveValue) {
+			return false
+		}
+		for i := range desiredValue {
+			if !isObjectSubset(desiredValue[i], liveValue[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return true
+	}
+	desiredNumber, desiredIsNumber := toFloat64(desired)
+	liveNumber, liveIsNumber := toFloat64(live)
+	if desiredIsNumber && liveIsNumber {
+		return desiredNumber == liveNumber
+	}
+	return reflect.DeepEqual(desired, live)
+}
+
+// toFloat64 converts the numbers of decoded YAML and JSON objects, which are either integers or floats.
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// driftedCondition describes the result of a drift check. The status is True while the Central is drifted, and False
+// once the drift was reverted or no drift was detected.
+func driftedCondition(status, reason string, d *drift) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{
+		Type:   centralConstants.CentralHealthConditionDrifted.String(),
+		Status: status,
+		Reason: reason,
+	}
+	if len(d.objects) > 0 {
+		condition.Message = "Drifted objects: " + strings.Join(d.objects, ", ")
+	}
+	return condition
+}
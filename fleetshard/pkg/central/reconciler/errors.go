@@ -9,11 +9,14 @@ var (
 	ErrCentralNotChanged = errors.New("central not changed")
 	// ErrDeletionInProgress returned when central resources are currently deleting
 	ErrDeletionInProgress = errors.New("deletion in progress")
+	// ErrReconciliationPaused returned when reconciliation of a central was paused with an annotation on its namespace
+	ErrReconciliationPaused = errors.New("reconciliation paused")
 )
 
 // IsSkippable indicates that the reconciliation was skipped and the status should NOT be reported.
 func IsSkippable(err error) bool {
 	return errors.Is(err, ErrBusy) ||
 		errors.Is(err, ErrCentralNotChanged) ||
-		errors.Is(err, ErrDeletionInProgress)
+		errors.Is(err, ErrDeletionInProgress) ||
+		errors.Is(err, ErrReconciliationPaused)
 }
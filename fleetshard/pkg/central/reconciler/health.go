@@ -0,0 +1,238 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	conditionStatusTrue    = "True"
+	conditionStatusFalse   = "False"
+	conditionStatusUnknown = "Unknown"
+
+	deploymentNotFoundReason     = "DeploymentNotFound"
+	replicasUnavailableReason    = "ReplicasUnavailable"
+	crashLoopBackOffReason       = "CrashLoopBackOff"
+	claimNotBoundReason          = "PersistentVolumeClaimNotBound"
+	managedDBNotFoundReason      = "ManagedDBNotFound"
+	managedDBNotAvailableReason  = "ManagedDBNotAvailable"
+	managedDBStatusUnknownReason = "ManagedDBStatusUnknown"
+)
+
+// healthDeployments are the deployments of a Central whose readiness is reported as health conditions.
+var healthDeployments = []struct {
+	name          string
+	conditionType centralConstants.CentralHealthConditionType
+}{
+	{name: "central", conditionType: centralConstants.CentralHealthConditionCentralReady},
+	{name: "scanner", conditionType: centralConstants.CentralHealthConditionScannerReady},
+	{name: "scanner-db", conditionType: centralConstants.CentralHealthConditionScannerDBReady},
+	{name: "egress-proxy", conditionType: centralConstants.CentralHealthConditionEgressProxyReady},
+}
+
+// isHealthCheckDue returns true if the health of the Central has not been checked for the health check period.
+func (r *CentralReconciler) isHealthCheckDue() bool {
+	return r.healthCheckPeriod > 0 && time.Since(r.lastHealthCheck) >= r.healthCheckPeriod
+}
+
+// reconcileHealth checks the health of a Central which did not change. The health is only reported if it changed since
+// it was last reported, otherwise ErrCentralNotChanged is returned.
+func (r *CentralReconciler) reconcileHealth(ctx context.Context, remoteCentral private.ManagedCentral) (*private.DataPlaneCentralStatus, error) {
+	conditions, err := r.healthConditions(ctx, remoteCentral)
+	if err != nil {
+		return nil, err
+	}
+	r.lastHealthCheck = time.Now()
+	if reflect.DeepEqual(conditions, r.lastHealthConditions) {
+		return nil, ErrCentralNotChanged
+	}
+	r.lastHealthConditions = conditions
+
+	status := readyStatus()
+	status.Conditions = append(status.Conditions, conditions...)
+	return status, nil
+}
+
+// addHealthConditions adds the health conditions of the Central to the status reported to fleet-manager.
+func (r *CentralReconciler) addHealthConditions(ctx context.Context, remoteCentral private.ManagedCentral, status *private.DataPlaneCentralStatus) error {
+	conditions, err := r.healthConditions(ctx, remoteCentral)
+	if err != nil {
+		return err
+	}
+	r.lastHealthCheck = time.Now()
+	r.lastHealthConditions = conditions
+
+	status.Conditions = append(status.Conditions, conditions...)
+	return nil
+}
+
+// healthConditions gathers the readiness of the deployments, the container restarts, the state of the persistent
+// volume claims and the state of the managed DB of a Central, together with the result of the last drift check.
+func (r *CentralReconciler) healthConditions(ctx context.Context, remoteCentral private.ManagedCentral) ([]private.DataPlaneClusterUpdateStatusRequestConditions, error) {
+	namespace := remoteCentral.Metadata.Namespace
+	var conditions []private.DataPlaneClusterUpdateStatusRequestConditions
+
+	for _, d := range healthDeployments {
+		condition, err := r.deploymentHealthCondition(ctx, namespace, d.name, d.conditionType)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	podsCondition, err := r.podsHealthCondition(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, podsCondition)
+
+	storageCondition, err := r.storageHealthCondition(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, storageCondition)
+
+	if r.managedDBEnabled {
+		conditions = append(conditions, r.managedDBHealthCondition(ctx, remoteCentral.Id))
+	}
+	if r.driftCondition != nil {
+		conditions = append(conditions, *r.driftCondition)
+	}
+	return conditions, nil
+}
+
+func (r *CentralReconciler) deploymentHealthCondition(ctx context.Context, namespace, name string, conditionType centralConstants.CentralHealthConditionType) (private.DataPlaneClusterUpdateStatusRequestConditions, error) {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionType.String()}
+
+	deployment := &appsv1.Deployment{}
+	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: name}, deployment)
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			return condition, errors.Wrapf(err, "retrieving deployment %s/%s", namespace, name)
+		}
+		condition.Status = conditionStatusFalse
+		condition.Reason = deploymentNotFoundReason
+		condition.Message = fmt.Sprintf("deployment %s not found", name)
+		return condition, nil
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	condition.Message = fmt.Sprintf("%d/%d replicas available", deployment.Status.AvailableReplicas, desired)
+	if deployment.Status.AvailableReplicas < desired {
+		condition.Status = conditionStatusFalse
+		condition.Reason = replicasUnavailableReason
+		return condition, nil
+	}
+	condition.Status = conditionStatusTrue
+	return condition, nil
+}
+
+func (r *CentralReconciler) podsHealthCondition(ctx context.Context, namespace string) (private.DataPlaneClusterUpdateStatusRequestConditions, error) {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{
+		Type:   centralConstants.CentralHealthConditionPodsHealthy.String(),
+		Status: conditionStatusTrue,
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, ctrlClient.InNamespace(namespace)); err != nil {
+		return condition, errors.Wrapf(err, "listing pods in namespace %s", namespace)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].GetName() < pods.Items[j].GetName() })
+
+	var crashLooping, restarts []string
+	for _, pod := range pods.Items {
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.State.Waiting != nil && container.State.Waiting.Reason == crashLoopBackOffReason {
+				crashLooping = append(crashLooping, pod.GetName()+"/"+container.Name)
+			}
+			if container.RestartCount > 0 {
+				restarts = append(restarts, fmt.Sprintf("%s/%s restarted %d times", pod.GetName(), container.Name, container.RestartCount))
+			}
+		}
+	}
+
+	if len(restarts) > 0 {
+		condition.Message = strings.Join(restarts, ", ")
+	}
+	if len(crashLooping) > 0 {
+		condition.Status = conditionStatusFalse
+		condition.Reason = crashLoopBackOffReason
+		condition.Message = fmt.Sprintf("containers in CrashLoopBackOff: %s; %s", strings.Join(crashLooping, ", "), condition.Message)
+	}
+	return condition, nil
+}
+
+func (r *CentralReconciler) storageHealthCondition(ctx context.Context, namespace string) (private.DataPlaneClusterUpdateStatusRequestConditions, error) {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{
+		Type:   centralConstants.CentralHealthConditionStorageReady.String(),
+		Status: conditionStatusTrue,
+	}
+
+	claims := &corev1.PersistentVolumeClaimList{}
+	if err := r.client.List(ctx, claims, ctrlClient.InNamespace(namespace)); err != nil {
+		return condition, errors.Wrapf(err, "listing persistent volume claims in namespace %s", namespace)
+	}
+	sort.Slice(claims.Items, func(i, j int) bool { return claims.Items[i].GetName() < claims.Items[j].GetName() })
+
+	var unbound []string
+	for _, claim := range claims.Items {
+		if claim.Status.Phase != corev1.ClaimBound {
+			phase := claim.Status.Phase
+			if phase == "" {
+				phase = corev1.ClaimPending
+			}
+			unbound = append(unbound, fmt.Sprintf("%s is %s", claim.GetName(), phase))
+		}
+	}
+
+	if len(unbound) > 0 {
+		condition.Status = conditionStatusFalse
+		condition.Reason = claimNotBoundReason
+		condition.Message = strings.Join(unbound, ", ")
+	}
+	return condition, nil
+}
+
+func (r *CentralReconciler) managedDBHealthCondition(ctx context.Context, centralID string) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{
+		Type: centralConstants.CentralHealthConditionManagedDBReady.String(),
+	}
+
+	dbStatus, err := r.managedDBProvisioningClient.GetDBStatus(ctx, centralID)
+	switch {
+	case errors.Is(err, cloudprovider.ErrDBNotFound):
+		condition.Status = conditionStatusFalse
+		condition.Reason = managedDBNotFoundReason
+		condition.Message = "managed DB not found"
+	case err != nil:
+		glog.Errorf("Getting status of managed DB of central %s: %v", centralID, err)
+		condition.Status = conditionStatusUnknown
+		condition.Reason = managedDBStatusUnknownReason
+		condition.Message = "the status of the managed DB could not be retrieved"
+	case !dbStatus.Available:
+		condition.Status = conditionStatusFalse
+		condition.Reason = managedDBNotAvailableReason
+		condition.Message = fmt.Sprintf("managed DB is %s", dbStatus.Status)
+	default:
+		condition.Status = conditionStatusTrue
+		condition.Message = fmt.Sprintf("managed DB is %s", dbStatus.Status)
+	}
+	return condition
+}
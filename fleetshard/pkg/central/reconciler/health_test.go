@@ -0,0 +1,206 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func healthTestDeployment(name string, replicas, available int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: centralNamespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(replicas)},
+		Status:     appsv1.DeploymentStatus{AvailableReplicas: available},
+	}
+}
+
+func TestHealthConditions(t *testing.T) {
+	healthyObjects := []client.Object{
+		healthTestDeployment("central", 1, 1),
+		healthTestDeployment("scanner", 2, 2),
+		healthTestDeployment("scanner-db", 1, 1),
+		healthTestDeployment("egress-proxy", 2, 2),
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "stackrox-db", Namespace: centralNamespace},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		objects  []client.Object
+		dbStatus func(context.Context, string) (*cloudprovider.DBStatus, error)
+		want     map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions
+	}{
+		{
+			name:     "healthy central",
+			objects:  healthyObjects,
+			dbStatus: availableDBStatus,
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionCentralReady:   {Status: "True", Message: "1/1 replicas available"},
+				centralConstants.CentralHealthConditionScannerReady:   {Status: "True", Message: "2/2 replicas available"},
+				centralConstants.CentralHealthConditionPodsHealthy:    {Status: "True"},
+				centralConstants.CentralHealthConditionStorageReady:   {Status: "True"},
+				centralConstants.CentralHealthConditionManagedDBReady: {Status: "True", Message: "managed DB is available"},
+			},
+		},
+		{
+			name: "unavailable and missing deployments",
+			objects: []client.Object{
+				healthTestDeployment("central", 1, 0),
+				healthTestDeployment("scanner", 3, 1),
+			},
+			dbStatus: availableDBStatus,
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionCentralReady:     {Status: "False", Reason: replicasUnavailableReason, Message: "0/1 replicas available"},
+				centralConstants.CentralHealthConditionScannerReady:     {Status: "False", Reason: replicasUnavailableReason, Message: "1/3 replicas available"},
+				centralConstants.CentralHealthConditionScannerDBReady:   {Status: "False", Reason: deploymentNotFoundReason, Message: "deployment scanner-db not found"},
+				centralConstants.CentralHealthConditionEgressProxyReady: {Status: "False", Reason: deploymentNotFoundReason, Message: "deployment egress-proxy not found"},
+			},
+		},
+		{
+			name: "crash looping container",
+			objects: append([]client.Object{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "central-abc", Namespace: centralNamespace},
+					Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+						Name:         "central",
+						RestartCount: 5,
+						State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: crashLoopBackOffReason}},
+					}}},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "scanner-abc", Namespace: centralNamespace},
+					Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+						Name:         "scanner",
+						RestartCount: 1,
+						State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+					}}},
+				},
+			}, healthyObjects...),
+			dbStatus: availableDBStatus,
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionPodsHealthy: {
+					Status:  "False",
+					Reason:  crashLoopBackOffReason,
+					Message: "containers in CrashLoopBackOff: central-abc/central; central-abc/central restarted 5 times, scanner-abc/scanner restarted 1 times",
+				},
+			},
+		},
+		{
+			name: "unbound persistent volume claim",
+			objects: []client.Object{
+				&corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "stackrox-db", Namespace: centralNamespace},
+				},
+			},
+			dbStatus: availableDBStatus,
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionStorageReady: {Status: "False", Reason: claimNotBoundReason, Message: "stackrox-db is Pending"},
+			},
+		},
+		{
+			name:    "managed DB not available",
+			objects: healthyObjects,
+			dbStatus: func(_ context.Context, _ string) (*cloudprovider.DBStatus, error) {
+				return &cloudprovider.DBStatus{Available: false, Status: "backing-up"}, nil
+			},
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionManagedDBReady: {Status: "False", Reason: managedDBNotAvailableReason, Message: "managed DB is backing-up"},
+			},
+		},
+		{
+			name:    "managed DB not found",
+			objects: healthyObjects,
+			dbStatus: func(_ context.Context, _ string) (*cloudprovider.DBStatus, error) {
+				return nil, errors.Wrap(cloudprovider.ErrDBNotFound, "describing DB")
+			},
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionManagedDBReady: {Status: "False", Reason: managedDBNotFoundReason, Message: "managed DB not found"},
+			},
+		},
+		{
+			name:    "managed DB status unknown",
+			objects: healthyObjects,
+			dbStatus: func(_ context.Context, _ string) (*cloudprovider.DBStatus, error) {
+				return nil, errors.New("throttled")
+			},
+			want: map[centralConstants.CentralHealthConditionType]private.DataPlaneClusterUpdateStatusRequestConditions{
+				centralConstants.CentralHealthConditionManagedDBReady: {
+					Status:  "Unknown",
+					Reason:  managedDBStatusUnknownReason,
+					Message: "the status of the managed DB could not be retrieved",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t, tc.objects...).Build()
+			r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, &cloudprovider.DBClientMock{GetDBStatusFunc: tc.dbStatus},
+				CentralReconcilerOptions{ManagedDBEnabled: true})
+
+			conditions, err := r.healthConditions(context.TODO(), simpleManagedCentral)
+			require.NoError(t, err)
+			require.Len(t, conditions, 7)
+
+			for conditionType, want := range tc.want {
+				condition, ok := conditionForType(conditions, conditionType.String())
+				require.True(t, ok, "condition %s not found in conditions", conditionType)
+				assert.Equal(t, want.Status, condition.Status, conditionType)
+				assert.Equal(t, want.Reason, condition.Reason, conditionType)
+				assert.Equal(t, want.Message, condition.Message, conditionType)
+			}
+		})
+	}
+}
+
+func TestReconcileHealth(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{
+		HealthCheckPeriod: time.Hour,
+	})
+	managedCentral := simpleManagedCentral
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+
+	status, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	_, ok := conditionForType(status.Conditions, centralConstants.CentralHealthConditionCentralReady.String())
+	require.True(t, ok, "health must be reported with the ready status")
+	_, ok = conditionForType(status.Conditions, centralConstants.CentralHealthConditionManagedDBReady.String())
+	assert.False(t, ok, "managed DB health must not be reported without managed DB")
+
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged, "health must not be checked before the health check period passed")
+
+	r.lastHealthCheck = time.Time{}
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged, "unchanged health must not be reported")
+
+	require.NoError(t, fakeClient.Create(context.TODO(), healthTestDeployment("scanner", 3, 1)))
+
+	r.lastHealthCheck = time.Time{}
+	status, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, ok := conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, ok)
+	assert.Equal(t, "True", readyCondition.Status)
+	scannerCondition, ok := conditionForType(status.Conditions, centralConstants.CentralHealthConditionScannerReady.String())
+	require.True(t, ok)
+	assert.Equal(t, "False", scannerCondition.Status)
+	assert.Equal(t, "1/3 replicas available", scannerCondition.Message)
+}
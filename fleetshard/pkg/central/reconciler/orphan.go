@@ -0,0 +1,58 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListTenantNamespaces returns all namespaces which were created for a Central tenant by createTenantNamespace.
+func ListTenantNamespaces(ctx context.Context, client ctrlClient.Client) ([]corev1.Namespace, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := client.List(ctx, namespaces, ctrlClient.HasLabels{tenantIDLabelKey}); err != nil {
+		return nil, errors.Wrap(err, "listing tenant namespaces")
+	}
+	return namespaces.Items, nil
+}
+
+// TenantID returns the ID of the Central tenant a namespace was created for.
+func TenantID(namespace corev1.Namespace) string {
+	return namespace.GetLabels()[tenantIDLabelKey]
+}
+
+// EnsureOrphanedCentralDeleted deletes a Central which is not managed by fleet-manager for this cluster anymore, e.g.
+// after its database record was deleted by an admin, together with its routes, chart resources and tenant namespace.
+// The managed DB is only deprovisioned if keepDB is false, i.e. once fleet-manager confirmed that the Central is gone.
+// It returns true once all resources are deleted.
+func (r *CentralReconciler) EnsureOrphanedCentralDeleted(ctx context.Context, namespace corev1.Namespace, keepDB bool) (bool, error) {
+	centrals := &v1alpha1.CentralList{}
+	if err := r.client.List(ctx, centrals, ctrlClient.InNamespace(namespace.GetName())); err != nil {
+		return false, errors.Wrapf(err, "listing centrals in namespace %s", namespace.GetName())
+	}
+	// The name of the Central is unknown if its CR was deleted already. The namespace name is used as a placeholder
+	// then, since deleting a missing CR succeeds.
+	name := namespace.GetName()
+	if len(centrals.Items) > 0 {
+		name = centrals.Items[0].GetName()
+	}
+
+	remoteCentral := private.ManagedCentral{
+		Id: TenantID(namespace),
+		Metadata: private.ManagedCentralAllOfMetadata{
+			Name:      name,
+			Namespace: namespace.GetName(),
+		},
+	}
+	central := &v1alpha1.Central{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace.GetName(),
+		},
+	}
+	return r.ensureCentralDeleted(ctx, remoteCentral, central, keepDB)
+}
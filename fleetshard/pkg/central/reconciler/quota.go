@@ -0,0 +1,176 @@
+package reconciler
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
+	"helm.sh/helm/v3/pkg/chartutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// tenantResourceQuotaHeadroom is the factor applied to the resources of a tenant for its ResourceQuota. It leaves
+	// room for the additional pods which are created during rolling updates.
+	tenantResourceQuotaHeadroom = 2
+	// defaultScannerMaxReplicas is the number of Scanner replicas the operator scales up to if autoscaling is enabled
+	// without a maximum.
+	defaultScannerMaxReplicas = 5
+	// defaultScannerReplicas is the number of Scanner replicas the operator deploys if autoscaling is disabled
+	// without a number of replicas.
+	defaultScannerReplicas = 3
+)
+
+// tenantWorkload is a container of a tenant with the number of replicas it runs with at most.
+type tenantWorkload struct {
+	resources corev1.ResourceRequirements
+	replicas  int64
+}
+
+// tenantWorkloads returns the workloads deployed into the namespace of a tenant with their resources.
+func (r *CentralReconciler) tenantWorkloads(remoteCentral private.ManagedCentral) ([]tenantWorkload, error) {
+	centralResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&remoteCentral.Spec.Central.Resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting Central resources")
+	}
+	scannerAnalyzerResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&remoteCentral.Spec.Scanner.Analyzer.Resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting Scanner Analyzer resources")
+	}
+	scannerDbResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&remoteCentral.Spec.Scanner.Db.Resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting Scanner DB resources")
+	}
+	workloads := []tenantWorkload{
+		{resources: centralResources, replicas: 1},
+		{resources: scannerAnalyzerResources, replicas: scannerMaxReplicas(remoteCentral.Spec.Scanner.Analyzer.Scaling)},
+		{resources: scannerDbResources, replicas: 1},
+	}
+
+	egressProxy, err := r.egressProxyWorkload()
+	if err != nil {
+		return nil, err
+	}
+	if egressProxy != nil {
+		workloads = append(workloads, *egressProxy)
+	}
+	return workloads, nil
+}
+
+func scannerMaxReplicas(scaling private.ManagedCentralAllOfSpecScannerAnalyzerScaling) int64 {
+	if scaling.AutoScaling == string(v1alpha1.ScannerAutoScalingDisabled) {
+		if scaling.Replicas > 0 {
+			return int64(scaling.Replicas)
+		}
+		return defaultScannerReplicas
+	}
+	if scaling.MaxReplicas > 0 {
+		return int64(scaling.MaxReplicas)
+	}
+	return defaultScannerMaxReplicas
+}
+
+// egressProxyWorkload returns the egress proxy with the resources and replicas set by the resources chart, or nil if
+// the chart does not deploy it. The replicas are read from the chart defaults, since the egress proxy is only scaled
+// down while suspending a Central.
+func (r *CentralReconciler) egressProxyWorkload() (*tenantWorkload, error) {
+	values, err := chartutil.Values(r.resourcesChart.Values).Table("egressProxy")
+	if err != nil {
+		return nil, nil
+	}
+	replicas, ok := toFloat64(values["replicas"])
+	if !ok {
+		return nil, errors.Errorf("invalid egress proxy replicas %v in resources chart", values["replicas"])
+	}
+
+	resources := private.ResourceRequirements{}
+	for key, target := range map[string]*map[string]string{"requests": &resources.Requests, "limits": &resources.Limits} {
+		table, err := values.Table("resources." + key)
+		if err != nil {
+			continue
+		}
+		*target = make(map[string]string, len(table))
+		for name, qty := range table {
+			(*target)[name] = fmt.Sprint(qty)
+		}
+	}
+	egressProxyResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting egress proxy resources")
+	}
+	return &tenantWorkload{resources: egressProxyResources, replicas: int64(replicas)}, nil
+}
+
+// resourceQuotaValues returns the values of the ResourceQuota and the LimitRange of a tenant. The quota only limits
+// the resources which are declared by all workloads of the tenant, since pods without them would be rejected otherwise.
+// The LimitRange provides defaults for other containers, e.g. init containers, within the bounds of the workloads.
+func resourceQuotaValues(workloads []tenantWorkload) chartutil.Values {
+	requests := func(w tenantWorkload) corev1.ResourceList { return w.resources.Requests }
+	limits := func(w tenantWorkload) corev1.ResourceList { return w.resources.Limits }
+	hard := map[string]interface{}{}
+	defaultRequests := map[string]interface{}{}
+	defaultLimits := map[string]interface{}{}
+
+	for _, name := range commonResourceNames(workloads, requests) {
+		total, smallest, _ := aggregateResource(workloads, name, requests)
+		hard["requests."+name.String()] = total.String()
+		defaultRequests[name.String()] = smallest.String()
+	}
+	for _, name := range commonResourceNames(workloads, limits) {
+		total, _, largest := aggregateResource(workloads, name, limits)
+		hard["limits."+name.String()] = total.String()
+		defaultLimits[name.String()] = largest.String()
+	}
+
+	return chartutil.Values{
+		"resourceQuota": chartutil.Values{
+			"enabled": len(hard) > 0,
+			"hard":    hard,
+		},
+		"limitRange": chartutil.Values{
+			"enabled":        len(hard) > 0,
+			"default":        defaultLimits,
+			"defaultRequest": defaultRequests,
+		},
+	}
+}
+
+// commonResourceNames returns the names of the resources which are declared by all workloads.
+func commonResourceNames(workloads []tenantWorkload, list func(tenantWorkload) corev1.ResourceList) []corev1.ResourceName {
+	if len(workloads) == 0 {
+		return nil
+	}
+	var names []corev1.ResourceName
+	for name := range list(workloads[0]) {
+		declared := true
+		for _, workload := range workloads[1:] {
+			if _, ok := list(workload)[name]; !ok {
+				declared = false
+				break
+			}
+		}
+		if declared {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// aggregateResource returns the total amount of a resource for all replicas of the workloads including the headroom,
+// and the smallest and largest amount of a single workload.
+func aggregateResource(workloads []tenantWorkload, name corev1.ResourceName, list func(tenantWorkload) corev1.ResourceList) (total, smallest, largest resource.Quantity) {
+	for i, workload := range workloads {
+		qty := list(workload)[name]
+		total.Add(*resource.NewMilliQuantity(qty.MilliValue()*workload.replicas*tenantResourceQuotaHeadroom, qty.Format))
+		if i == 0 || qty.Cmp(smallest) < 0 {
+			smallest = qty.DeepCopy()
+		}
+		if i == 0 || qty.Cmp(largest) > 0 {
+			largest = qty.DeepCopy()
+		}
+	}
+	return total, smallest, largest
+}
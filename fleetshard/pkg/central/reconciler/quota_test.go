@@ -0,0 +1,99 @@
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceQuotaValues(t *testing.T) {
+	resourcesSpec := private.ManagedCentralAllOfSpec{
+		Central: private.ManagedCentralAllOfSpecCentral{
+			Resources: private.ResourceRequirements{
+				Requests: map[string]string{"cpu": "1", "memory": "2Gi"},
+				Limits:   map[string]string{"memory": "4Gi"},
+			},
+		},
+		Scanner: private.ManagedCentralAllOfSpecScanner{
+			Analyzer: private.ManagedCentralAllOfSpecScannerAnalyzer{
+				Scaling: private.ManagedCentralAllOfSpecScannerAnalyzerScaling{AutoScaling: "Enabled", MinReplicas: 1, MaxReplicas: 3},
+				Resources: private.ResourceRequirements{
+					Requests: map[string]string{"cpu": "500m", "memory": "1Gi"},
+					Limits:   map[string]string{"memory": "2Gi"},
+				},
+			},
+			Db: private.ManagedCentralAllOfSpecScannerDb{
+				Resources: private.ResourceRequirements{
+					Requests: map[string]string{"cpu": "200m", "memory": "512Mi"},
+					Limits:   map[string]string{"memory": "1Gi"},
+				},
+			},
+		},
+	}
+	autoScalingDisabledSpec := resourcesSpec
+	autoScalingDisabledSpec.Scanner.Analyzer.Scaling = private.ManagedCentralAllOfSpecScannerAnalyzerScaling{AutoScaling: "Disabled", Replicas: 1}
+
+	tests := []struct {
+		name               string
+		spec               private.ManagedCentralAllOfSpec
+		wantEnabled        bool
+		wantHard           map[string]interface{}
+		wantDefault        map[string]interface{}
+		wantDefaultRequest map[string]interface{}
+	}{
+		{
+			name:        "no quota without resources",
+			spec:        private.ManagedCentralAllOfSpec{},
+			wantEnabled: false,
+		},
+		{
+			name:        "quota for resources declared by all workloads",
+			spec:        resourcesSpec,
+			wantEnabled: true,
+			wantHard: map[string]interface{}{
+				"requests.cpu":    "5800m",
+				"requests.memory": "11776Mi",
+				"limits.memory":   "23Gi",
+			},
+			wantDefault:        map[string]interface{}{"memory": "4Gi"},
+			wantDefaultRequest: map[string]interface{}{"cpu": "100m", "memory": "128Mi"},
+		},
+		{
+			name:        "scanner replicas without autoscaling",
+			spec:        autoScalingDisabledSpec,
+			wantEnabled: true,
+			wantHard: map[string]interface{}{
+				"requests.cpu":    "3800m",
+				"requests.memory": "7680Mi",
+				"limits.memory":   "15Gi",
+			},
+			wantDefault:        map[string]interface{}{"memory": "4Gi"},
+			wantDefaultRequest: map[string]interface{}{"cpu": "100m", "memory": "128Mi"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &CentralReconciler{resourcesChart: resourcesChart}
+			workloads, err := r.tenantWorkloads(private.ManagedCentral{Spec: tc.spec})
+			require.NoError(t, err)
+
+			vals := resourceQuotaValues(workloads)
+
+			quota, err := vals.Table("resourceQuota")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantEnabled, quota["enabled"])
+			limitRange, err := vals.Table("limitRange")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantEnabled, limitRange["enabled"])
+			if !tc.wantEnabled {
+				return
+			}
+			assert.Equal(t, tc.wantHard, quota["hard"])
+			assert.Equal(t, tc.wantDefault, limitRange["default"])
+			assert.Equal(t, tc.wantDefaultRequest, limitRange["defaultRequest"])
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
 	openshiftRouteV1 "github.com/openshift/api/route/v1"
@@ -17,8 +18,8 @@ import (
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/util"
 	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
-	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
 	"github.com/stackrox/rox/pkg/random"
 	"helm.sh/helm/v3/pkg/chart"
@@ -42,8 +43,19 @@ const (
 
 	managedServicesAnnotation = "platform.stackrox.io/managed-services"
 	tenantIDLabelKey          = "rhacs.redhat.com/tenant"
+	// versionSelectorLabelKey selects the Central operator version which reconciles a Central CR.
+	versionSelectorLabelKey = "rhacs.redhat.com/version-selector"
 
 	centralDbSecretName = "central-db-password" // pragma: allowlist secret
+	// dbPasswordResetAnnotation is set on the DB secret of a Central migrated to this cluster once the master password
+	// of the managed DB has been reset to the password of the secret.
+	dbPasswordResetAnnotation = "rhacs.redhat.com/db-password-reset" // pragma: allowlist secret
+	// migrationSourceAnnotation is set on the Central CR while the Central is scaled down for its migration away from
+	// this cluster, so that it is restored if the migration fails.
+	migrationSourceAnnotation = "rhacs.redhat.com/migration-source"
+
+	migrationSourceAnnotationValue = "source"
+	migrationTargetAnnotationValue = "target"
 )
 
 // CentralReconcilerOptions are the static options for creating a reconciler.
@@ -51,24 +63,46 @@ type CentralReconcilerOptions struct {
 	UseRoutes         bool
 	WantsAuthProvider bool
 	EgressProxyImage  string
-	ManagedDBEnabled  bool
-	Telemetry         config.Telemetry
+	// EgressProxyClusterCIDRs overrides the pod and service networks of the cluster, to which the egress proxy never
+	// connects.
+	EgressProxyClusterCIDRs []string
+	ManagedDBEnabled        bool
+	Telemetry               config.Telemetry
+	// DriftCheckPeriod is the period after which the cluster state of a Central which did not change is compared to
+	// its desired state. Drift detection is disabled if it is zero.
+	DriftCheckPeriod time.Duration
+	// RevertDrift enables reverting the cluster state of a Central to its desired state when drift is detected.
+	RevertDrift bool
+	// HealthCheckPeriod is the period after which the health of a ready Central which did not change is checked and
+	// reported if it changed. Periodic health checks are disabled if it is zero.
+	HealthCheckPeriod time.Duration
 }
 
 // CentralReconciler is a reconciler tied to a one Central instance. It installs, updates and deletes Central instances
 // in its Reconcile function.
 type CentralReconciler struct {
-	client            ctrlClient.Client
-	central           private.ManagedCentral
-	status            *int32
-	lastCentralHash   [16]byte
-	useRoutes         bool
-	wantsAuthProvider bool
-	hasAuthProvider   bool
-	Resources         bool
-	routeService      *k8s.RouteService
-	egressProxyImage  string
-	telemetry         config.Telemetry
+	client                  ctrlClient.Client
+	central                 private.ManagedCentral
+	status                  *int32
+	lastCentralHash         [16]byte
+	useRoutes               bool
+	wantsAuthProvider       bool
+	hasAuthProvider         bool
+	Resources               bool
+	routeService            *k8s.RouteService
+	egressProxyImage        string
+	egressProxyClusterCIDRs []string
+	telemetry               config.Telemetry
+
+	driftCheckPeriod time.Duration
+	revertDrift      bool
+	lastDriftCheck   time.Time
+	// driftCondition is the result of the last drift check. It is nil until the drift was checked.
+	driftCondition *private.DataPlaneClusterUpdateStatusRequestConditions
+
+	healthCheckPeriod    time.Duration
+	lastHealthCheck      time.Time
+	lastHealthConditions []private.DataPlaneClusterUpdateStatusRequestConditions
 
 	managedDBEnabled            bool
 	managedDBProvisioningClient cloudprovider.DBClient
@@ -94,10 +128,27 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 
 	remoteCentralName := remoteCentral.Metadata.Name
 	remoteCentralNamespace := remoteCentral.Metadata.Namespace
-	if !changed && r.wantsAuthProvider == r.hasAuthProvider && isRemoteCentralReady(remoteCentral) {
+	unchanged := !changed && r.wantsAuthProvider == r.hasAuthProvider && (isRemoteCentralReady(remoteCentral) || isRemoteCentralFinallySuspended(remoteCentral)) && !hasRequestedDBOperation(remoteCentral)
+	// Drift is only checked for ready Centrals. Suspended Centrals are scaled down on purpose.
+	checkDrift := unchanged && isRemoteCentralReady(remoteCentral) && r.isDriftCheckDue()
+	checkHealth := unchanged && isRemoteCentralReady(remoteCentral) && r.isHealthCheckDue()
+	if unchanged && !checkDrift && !checkHealth {
 		return nil, ErrCentralNotChanged
 	}
 
+	paused, err := r.isTenantReconciliationPaused(ctx, remoteCentralNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if paused {
+		glog.Infof("Reconciliation of central %s/%s is paused", remoteCentralNamespace, remoteCentralName)
+		return nil, ErrReconciliationPaused
+	}
+
+	if unchanged && !checkDrift {
+		return r.reconcileHealth(ctx, remoteCentral)
+	}
+
 	monitoringExposeEndpointEnabled := v1alpha1.ExposeEndpointEnabled
 	telemetryEnabled := r.telemetry.StorageKey != ""
 
@@ -190,8 +241,45 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		central.Spec.Central.AdminPasswordGenerationDisabled = pointer.BoolPtr(true)
 	}
 
+	if isRemoteCentralSuspended(remoteCentral) {
+		central.GetAnnotations()[pauseReconcileAnnotation] = "true"
+	}
+	if isRemoteCentralMigrationSource(remoteCentral) {
+		central.GetAnnotations()[migrationSourceAnnotation] = "true"
+	}
+	if operatorVersion := remoteCentral.Spec.Versions.CentralOperator; operatorVersion != "" {
+		central.GetLabels()[versionSelectorLabelKey] = operatorVersion
+	}
+
+	if checkDrift {
+		drift, err := r.detectDrift(ctx, remoteCentral, central)
+		if err != nil {
+			return nil, errors.Wrapf(err, "detecting drift of central %s/%s", remoteCentralNamespace, remoteCentralName)
+		}
+		r.lastDriftCheck = time.Now()
+		// The drift condition is reported with the health conditions, which are only reported again if they changed.
+		if len(drift.objects) == 0 {
+			condition := driftedCondition(conditionStatusFalse, "", drift)
+			r.driftCondition = &condition
+			return r.reconcileHealth(ctx, remoteCentral)
+		}
+		glog.Warningf("Central %s/%s drifted from its desired state: %v", remoteCentralNamespace, remoteCentralName, drift.objects)
+		if !r.revertDrift {
+			condition := driftedCondition(conditionStatusTrue, driftDetectedReason, drift)
+			r.driftCondition = &condition
+			return r.reconcileHealth(ctx, remoteCentral)
+		}
+		glog.Infof("Reverting drift of central %s/%s", remoteCentralNamespace, remoteCentralName)
+		if err := r.ensureDriftedRoutesDeleted(ctx, drift.routes); err != nil {
+			return nil, err
+		}
+		condition := driftedCondition(conditionStatusFalse, driftRevertedReason, drift)
+		r.driftCondition = &condition
+	}
+
 	if remoteCentral.Metadata.DeletionTimestamp != "" {
-		deleted, err := r.ensureCentralDeleted(ctx, remoteCentral, central)
+		// The managed DB is shared by the source and target cluster of a migrating Central and must be kept.
+		deleted, err := r.ensureCentralDeleted(ctx, remoteCentral, central, isRemoteCentralMigrating(remoteCentral))
 		if err != nil {
 			return nil, errors.Wrapf(err, "delete central %s/%s", remoteCentralNamespace, remoteCentralName)
 		}
@@ -209,6 +297,7 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		return nil, errors.Wrapf(err, "unable to install chart resource for central %s/%s", central.GetNamespace(), central.GetName())
 	}
 
+	var dbStatus private.DataPlaneCentralStatusDb
 	if r.managedDBEnabled {
 		if err := r.ensureCentralDBSecretExists(ctx, remoteCentralNamespace); err != nil {
 			return nil, fmt.Errorf("ensuring that DB secret exists: %w", err)
@@ -219,9 +308,16 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 			return nil, fmt.Errorf("getting DB password from secret: %w", err)
 		}
 
-		dbConnectionString, err := r.managedDBProvisioningClient.EnsureDBProvisioned(ctx, remoteCentral.Id, dbMasterPassword)
+		if isRemoteCentralMigrationTarget(remoteCentral) {
+			if err := r.ensureMigratedDBPasswordReset(ctx, remoteCentral, dbMasterPassword); err != nil {
+				return nil, err
+			}
+		}
+
+		var dbConnectionString string
+		dbConnectionString, dbStatus, err = r.ensureManagedDB(ctx, remoteCentral, dbMasterPassword)
 		if err != nil {
-			return nil, fmt.Errorf("provisioning RDS DB: %w", err)
+			return nil, err
 		}
 
 		central.Spec.Central.DB = &v1alpha1.CentralDBSpec{
@@ -263,12 +359,38 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 			return nil, err
 		}
 		existingCentral.Spec = *central.Spec.DeepCopy()
+		if operatorVersion := remoteCentral.Spec.Versions.CentralOperator; operatorVersion != "" {
+			metav1.SetMetaDataLabel(&existingCentral.ObjectMeta, versionSelectorLabelKey, operatorVersion)
+		}
+		if isRemoteCentralSuspended(remoteCentral) {
+			metav1.SetMetaDataAnnotation(&existingCentral.ObjectMeta, pauseReconcileAnnotation, "true")
+		} else {
+			delete(existingCentral.Annotations, pauseReconcileAnnotation)
+		}
+		if isRemoteCentralMigrationSource(remoteCentral) {
+			metav1.SetMetaDataAnnotation(&existingCentral.ObjectMeta, migrationSourceAnnotation, "true")
+		} else if existingCentral.GetAnnotations()[migrationSourceAnnotation] == "true" {
+			// The annotation is only removed once the Central has been restored, so that this is retried on failure.
+			if err := r.ensureMigrationSourceRestored(ctx, remoteCentral); err != nil {
+				return nil, err
+			}
+			delete(existingCentral.Annotations, migrationSourceAnnotation)
+		}
 
 		if err := r.client.Update(ctx, &existingCentral); err != nil {
 			return nil, errors.Wrapf(err, "updating central %s/%s", central.GetNamespace(), central.GetName())
 		}
 	}
 
+	if isRemoteCentralSuspended(remoteCentral) {
+		return r.reconcileSuspendedCentral(ctx, remoteCentral, dbStatus)
+	}
+	if isRemoteCentralResuming(remoteCentral) {
+		if err := r.ensureDeploymentsResumed(ctx, remoteCentral); err != nil {
+			return nil, err
+		}
+	}
+
 	centralTLSSecretFound := true // pragma: allowlist secret
 	if r.useRoutes {
 		if err := r.ensureRoutesExist(ctx, remoteCentral); err != nil {
@@ -289,7 +411,13 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		if isRemoteCentralProvisioning(remoteCentral) && !changed { // no changes detected, wait until central become ready
 			return nil, ErrCentralNotChanged
 		}
-		return installingStatus(), nil
+		status := installingStatus()
+		status.Db = dbStatus
+		status.Versions = deployedCentralVersions(&existingCentral)
+		if err := r.addHealthConditions(ctx, remoteCentral, status); err != nil {
+			return nil, err
+		}
+		return status, nil
 	}
 
 	// Skip auth provider initialisation if:
@@ -305,6 +433,11 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 	}
 
 	status := readyStatus()
+	status.Db = dbStatus
+	status.Versions = deployedCentralVersions(&existingCentral)
+	if err := r.addHealthConditions(ctx, remoteCentral, status); err != nil {
+		return nil, err
+	}
 	// Do not report routes statuses if:
 	// 1. Routes are not used on the cluster
 	// 2. Central request is in status "Ready" - assuming that routes are already reported and saved
@@ -317,13 +450,34 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 
 	// Setting the last central hash must always be executed as the last step.
 	// defer can't be used for this call because it is also executed after the reconcile failed.
-	if err := r.setLastCentralHash(remoteCentral); err != nil {
-		return nil, errors.Wrapf(err, "setting central reconcilation cache")
+	// The Central is reconciled again until the operator deployed the desired versions, so that they are reported.
+	if areDesiredVersionsDeployed(remoteCentral, status.Versions) {
+		if err := r.setLastCentralHash(remoteCentral); err != nil {
+			return nil, errors.Wrapf(err, "setting central reconcilation cache")
+		}
 	}
+	// Applying the desired state reverted any drift of the Central CR and the chart resources.
+	r.lastDriftCheck = time.Now()
 
 	return status, nil
 }
 
+// deployedCentralVersions returns the versions of a Central deployed by the Central operator. The versions are empty
+// until the operator reconciled the Central.
+func deployedCentralVersions(central *v1alpha1.Central) private.DataPlaneCentralStatusVersions {
+	versions := private.DataPlaneCentralStatusVersions{Central: central.Status.ProductVersion}
+	if central.Status.DeployedRelease != nil {
+		versions.CentralOperator = central.Status.DeployedRelease.Version
+	}
+	return versions
+}
+
+func areDesiredVersionsDeployed(remoteCentral private.ManagedCentral, versions private.DataPlaneCentralStatusVersions) bool {
+	desired := remoteCentral.Spec.Versions
+	return (desired.Central == "" || desired.Central == versions.Central) &&
+		(desired.CentralOperator == "" || desired.CentralOperator == versions.CentralOperator)
+}
+
 func isRemoteCentralProvisioning(remoteCentral private.ManagedCentral) bool {
 	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusProvisioning.String()
 }
@@ -332,6 +486,102 @@ func isRemoteCentralReady(remoteCentral private.ManagedCentral) bool {
 	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusReady.String()
 }
 
+func isRemoteCentralMigrating(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.Metadata.Annotations.MasMigration != ""
+}
+
+func isRemoteCentralMigrationSource(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.Metadata.Annotations.MasMigration == migrationSourceAnnotationValue
+}
+
+func isRemoteCentralMigrationTarget(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.Metadata.Annotations.MasMigration == migrationTargetAnnotationValue
+}
+
+func hasRequestedDBOperation(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.Spec.Central.Db.BackupId != "" || remoteCentral.Spec.Central.Db.RestoreId != ""
+}
+
+// ensureManagedDB makes sure that the managed DB of a Central is provisioned, or restored from a snapshot if a restore
+// was requested, and that a requested snapshot of the managed DB is taken. It returns the connection string of the
+// managed DB and the status of the requested DB operations.
+func (r *CentralReconciler) ensureManagedDB(ctx context.Context, remoteCentral private.ManagedCentral, dbMasterPassword string) (string, private.DataPlaneCentralStatusDb, error) {
+	var status private.DataPlaneCentralStatusDb
+	spec := remoteCentral.Spec.Central.Db
+
+	var dbConnectionString string
+	if spec.RestoreId != "" {
+		connectionString, err := r.managedDBProvisioningClient.EnsureDBRestored(ctx, remoteCentral.Id, spec.RestoreId, spec.RestoreSnapshotId, dbMasterPassword, spec)
+		switch {
+		case err == nil:
+			glog.Infof("Restored managed DB of central %s from snapshot %s", remoteCentral.Id, spec.RestoreSnapshotId)
+			dbConnectionString = connectionString
+			status.Restore = dbOperationStatus(spec.RestoreId, centralConstants.CentralDBOperationStatusCompleted, "")
+		case errors.Is(err, cloudprovider.ErrDBSnapshotNotFound) || errors.Is(err, cloudprovider.ErrNotSupported):
+			glog.Errorf("Restoring managed DB of central %s failed: %v", remoteCentral.Id, err)
+			status.Restore = dbOperationStatus(spec.RestoreId, centralConstants.CentralDBOperationStatusFailed, err.Error())
+		default:
+			return "", status, fmt.Errorf("restoring managed DB: %w", err)
+		}
+	}
+
+	if dbConnectionString == "" {
+		connectionString, err := r.managedDBProvisioningClient.EnsureDBProvisioned(ctx, remoteCentral.Id, dbMasterPassword, spec)
+		if err != nil {
+			return "", status, fmt.Errorf("provisioning managed DB: %w", err)
+		}
+		dbConnectionString = connectionString
+	}
+
+	if spec.BackupId != "" {
+		snapshot, err := r.managedDBProvisioningClient.EnsureDBSnapshotCreated(ctx, remoteCentral.Id, spec.BackupId)
+		switch {
+		case err == nil:
+			status.Backup = dbOperationStatus(spec.BackupId, snapshotOperationStatus(snapshot.Status), "")
+		case errors.Is(err, cloudprovider.ErrNotSupported):
+			status.Backup = dbOperationStatus(spec.BackupId, centralConstants.CentralDBOperationStatusFailed, err.Error())
+		default:
+			return "", status, fmt.Errorf("taking snapshot %s of managed DB: %w", spec.BackupId, err)
+		}
+	}
+
+	if hasRequestedDBOperation(remoteCentral) {
+		snapshots, err := r.managedDBProvisioningClient.ListDBSnapshots(ctx, remoteCentral.Id)
+		if err != nil && !errors.Is(err, cloudprovider.ErrNotSupported) {
+			// The snapshots are reported again with the next status of a requested DB operation.
+			glog.Errorf("Listing snapshots of managed DB of central %s: %v", remoteCentral.Id, err)
+		}
+		for _, snapshot := range snapshots {
+			status.Snapshots = append(status.Snapshots, private.DataPlaneCentralStatusDbSnapshots{
+				Id:        snapshot.ID,
+				Status:    snapshot.Status,
+				CreatedAt: snapshot.CreatedAt,
+			})
+		}
+	}
+
+	return dbConnectionString, status, nil
+}
+
+func dbOperationStatus(id string, status centralConstants.CentralDBOperationStatus, message string) private.DataPlaneCentralStatusDbBackup {
+	return private.DataPlaneCentralStatusDbBackup{
+		Id:      id,
+		Status:  status.String(),
+		Message: message,
+	}
+}
+
+func snapshotOperationStatus(snapshotStatus string) centralConstants.CentralDBOperationStatus {
+	switch snapshotStatus {
+	case cloudprovider.DBSnapshotStatusAvailable:
+		return centralConstants.CentralDBOperationStatusCompleted
+	case cloudprovider.DBSnapshotStatusFailed:
+		return centralConstants.CentralDBOperationStatusFailed
+	default:
+		return centralConstants.CentralDBOperationStatusInProgress
+	}
+}
+
 func (r *CentralReconciler) getRoutesStatuses(ctx context.Context, namespace string) ([]private.DataPlaneCentralStatusRoutes, error) {
 	reencryptIngress, err := r.routeService.FindReencryptIngress(ctx, namespace)
 	if err != nil {
@@ -354,7 +604,7 @@ func getRouteStatus(ingress *openshiftRouteV1.RouteIngress) private.DataPlaneCen
 	}
 }
 
-func (r *CentralReconciler) ensureCentralDeleted(ctx context.Context, remoteCentral private.ManagedCentral, central *v1alpha1.Central) (bool, error) {
+func (r *CentralReconciler) ensureCentralDeleted(ctx context.Context, remoteCentral private.ManagedCentral, central *v1alpha1.Central, keepDB bool) (bool, error) {
 	globalDeleted := true
 	if r.useRoutes {
 		reencryptRouteDeleted, err := r.ensureReencryptRouteDeleted(ctx, central.GetNamespace())
@@ -376,11 +626,15 @@ func (r *CentralReconciler) ensureCentralDeleted(ctx context.Context, remoteCent
 	globalDeleted = globalDeleted && centralDeleted
 
 	if r.managedDBEnabled {
-		dbDeleted, err := r.managedDBProvisioningClient.EnsureDBDeprovisioned(remoteCentral.Id)
-		if err != nil {
-			return false, fmt.Errorf("deprovisioning DB: %v", err)
+		if keepDB {
+			glog.Infof("Keeping managed DB of central %s/%s", central.GetNamespace(), central.GetName())
+		} else {
+			dbDeleted, err := r.managedDBProvisioningClient.EnsureDBDeprovisioned(remoteCentral.Id)
+			if err != nil {
+				return false, fmt.Errorf("deprovisioning DB: %v", err)
+			}
+			globalDeleted = globalDeleted && dbDeleted
 		}
-		globalDeleted = globalDeleted && dbDeleted
 
 		secretDeleted, err := r.ensureCentralDBSecretDeleted(ctx, central.GetNamespace())
 		if err != nil {
@@ -524,6 +778,56 @@ func (r *CentralReconciler) ensureCentralDBSecretExists(ctx context.Context, rem
 	return nil
 }
 
+// ensureMigratedDBPasswordReset makes sure that the managed DB of a Central migrated to this cluster accepts the
+// password of the DB secret of this cluster. The managed DB was provisioned with the password of the migration source
+// cluster, which scaled its Central down before this cluster deploys it. The password is only reset once.
+func (r *CentralReconciler) ensureMigratedDBPasswordReset(ctx context.Context, remoteCentral private.ManagedCentral, dbMasterPassword string) error {
+	namespace := remoteCentral.Metadata.Namespace
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: centralDbSecretName}, secret); err != nil {
+		return fmt.Errorf("getting Central DB secret: %w", err)
+	}
+	if secret.GetAnnotations()[dbPasswordResetAnnotation] == "true" {
+		return nil
+	}
+
+	err := r.managedDBProvisioningClient.ResetDBMasterPassword(ctx, remoteCentral.Id, dbMasterPassword)
+	switch {
+	case errors.Is(err, cloudprovider.ErrDBNotFound) || errors.Is(err, cloudprovider.ErrNotSupported):
+		// The managed DB is provisioned with the password of this cluster.
+		glog.Infof("Managed DB of migrated central %s is not shared with the migration source: %v", remoteCentral.Id, err)
+	case err != nil:
+		return fmt.Errorf("resetting password of managed DB of migrated central %s: %w", remoteCentral.Id, err)
+	}
+
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, dbPasswordResetAnnotation, "true")
+	if err := r.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("updating Central DB secret %s/%s: %w", namespace, centralDbSecretName, err)
+	}
+	return nil
+}
+
+// ensureMigrationSourceRestored takes the managed DB back after the migration of a Central away from this cluster
+// failed, and scales the Central up again. The migration target may have reset the master password of the managed DB
+// to the password of its own DB secret.
+func (r *CentralReconciler) ensureMigrationSourceRestored(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	if r.managedDBEnabled {
+		dbMasterPassword, err := r.getDBPassword(ctx, remoteCentral.Metadata.Namespace)
+		if err != nil {
+			return fmt.Errorf("getting DB password from secret: %w", err)
+		}
+		err = r.managedDBProvisioningClient.ResetDBMasterPassword(ctx, remoteCentral.Id, dbMasterPassword)
+		switch {
+		case errors.Is(err, cloudprovider.ErrDBNotFound) || errors.Is(err, cloudprovider.ErrNotSupported):
+			glog.Infof("Managed DB of central %s is not shared with the migration target: %v", remoteCentral.Id, err)
+		case err != nil:
+			return fmt.Errorf("restoring password of managed DB of central %s: %w", remoteCentral.Id, err)
+		}
+	}
+	glog.Infof("Restoring central %s after its migration away from this cluster failed", remoteCentral.Id)
+	return r.ensureDeploymentsResumed(ctx, remoteCentral)
+}
+
 func (r *CentralReconciler) ensureCentralDBSecretDeleted(ctx context.Context, remoteCentralNamespace string) (bool, error) {
 	secret := &corev1.Secret{}
 	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: remoteCentralNamespace, Name: centralDbSecretName}, secret)
@@ -732,6 +1036,8 @@ func (r *CentralReconciler) ensureRouteDeleted(ctx context.Context, routeSupplie
 }
 
 func (r *CentralReconciler) chartValues(remoteCentral private.ManagedCentral) (chartutil.Values, error) {
+	// Nested tables must be of type map[string]interface{}, since Helm only merges those with other overrides and the
+	// chart defaults.
 	vals := chartutil.Values{
 		"labels": map[string]interface{}{
 			k8s.ManagedByLabelKey: k8s.ManagedByFleetshardValue,
@@ -739,12 +1045,44 @@ func (r *CentralReconciler) chartValues(remoteCentral private.ManagedCentral) (c
 	}
 	if r.egressProxyImage != "" {
 		override := chartutil.Values{
-			"egressProxy": chartutil.Values{
+			"egressProxy": map[string]interface{}{
 				"image": r.egressProxyImage,
 			},
 		}
 		vals = chartutil.CoalesceTables(vals, override)
 	}
+	if len(r.egressProxyClusterCIDRs) > 0 {
+		override := chartutil.Values{
+			"egressProxy": map[string]interface{}{
+				"clusterCidrs": r.egressProxyClusterCIDRs,
+			},
+		}
+		vals = chartutil.CoalesceTables(vals, override)
+	}
+	if isRemoteCentralSuspended(remoteCentral) {
+		override := chartutil.Values{
+			"egressProxy": map[string]interface{}{
+				"replicas": 0,
+			},
+		}
+		vals = chartutil.CoalesceTables(vals, override)
+	}
+	if allowlist := remoteCentral.Spec.EgressAllowlist; len(allowlist.Domains) > 0 || len(allowlist.Cidrs) > 0 {
+		override := chartutil.Values{
+			"egressProxy": map[string]interface{}{
+				"allowlist": map[string]interface{}{
+					"domains": allowlist.Domains,
+					"cidrs":   allowlist.Cidrs,
+				},
+			},
+		}
+		vals = chartutil.CoalesceTables(vals, override)
+	}
+	workloads, err := r.tenantWorkloads(remoteCentral)
+	if err != nil {
+		return nil, err
+	}
+	vals = chartutil.CoalesceTables(vals, resourceQuotaValues(workloads))
 
 	return vals, nil
 }
@@ -757,14 +1095,20 @@ var (
 func NewCentralReconciler(k8sClient ctrlClient.Client, central private.ManagedCentral,
 	managedDBProvisioningClient cloudprovider.DBClient, opts CentralReconcilerOptions) *CentralReconciler {
 	return &CentralReconciler{
-		client:            k8sClient,
-		central:           central,
-		status:            pointer.Int32(FreeStatus),
-		useRoutes:         opts.UseRoutes,
-		wantsAuthProvider: opts.WantsAuthProvider,
-		routeService:      k8s.NewRouteService(k8sClient),
-		egressProxyImage:  opts.EgressProxyImage,
-		telemetry:         opts.Telemetry,
+		client:                  k8sClient,
+		central:                 central,
+		status:                  pointer.Int32(FreeStatus),
+		useRoutes:               opts.UseRoutes,
+		wantsAuthProvider:       opts.WantsAuthProvider,
+		routeService:            k8s.NewRouteService(k8sClient),
+		egressProxyImage:        opts.EgressProxyImage,
+		egressProxyClusterCIDRs: opts.EgressProxyClusterCIDRs,
+		telemetry:               opts.Telemetry,
+
+		driftCheckPeriod: opts.DriftCheckPeriod,
+		revertDrift:      opts.RevertDrift,
+
+		healthCheckPeriod: opts.HealthCheckPeriod,
 
 		managedDBEnabled:            opts.ManagedDBEnabled,
 		managedDBProvisioningClient: managedDBProvisioningClient,
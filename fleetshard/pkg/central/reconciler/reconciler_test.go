@@ -5,6 +5,7 @@ import (
 	"embed"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,6 +65,10 @@ var (
 	testdata embed.FS
 )
 
+func availableDBStatus(_ context.Context, _ string) (*cloudprovider.DBStatus, error) {
+	return &cloudprovider.DBStatus{Available: true, Status: "available"}, nil
+}
+
 func conditionForType(conditions []private.DataPlaneClusterUpdateStatusRequestConditions, conditionType string) (*private.DataPlaneClusterUpdateStatusRequestConditions, bool) {
 	for _, c := range conditions {
 		if c.Type == conditionType {
@@ -106,7 +111,8 @@ func TestReconcileCreateWithManagedDB(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 
 	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
-	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (string, error) {
+	managedDBProvisioningClient.GetDBStatusFunc = availableDBStatus
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
 		return "connectionString", nil
 	}
 	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
@@ -138,6 +144,79 @@ func TestReconcileCreateWithManagedDB(t *testing.T) {
 	assert.NotEmpty(t, password)
 }
 
+func TestReconcileManagedDBBackupAndRestore(t *testing.T) {
+	createdAt := time.Date(2022, 11, 15, 10, 30, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		restoreErr      error
+		wantRestore     private.DataPlaneCentralStatusDbBackup
+		wantProvisioned int
+	}{
+		{
+			name:        "restore completes",
+			wantRestore: private.DataPlaneCentralStatusDbBackup{Id: "restore", Status: "completed"},
+		},
+		{
+			name:       "restore from a missing snapshot fails",
+			restoreErr: cloudprovider.ErrDBSnapshotNotFound,
+			wantRestore: private.DataPlaneCentralStatusDbBackup{
+				Id:      "restore",
+				Status:  "failed",
+				Message: cloudprovider.ErrDBSnapshotNotFound.Error(),
+			},
+			wantProvisioned: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t).Build()
+			managedDBProvisioningClient := &cloudprovider.DBClientMock{
+				EnsureDBProvisionedFunc: func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+					return "connectionString", nil
+				},
+				EnsureDBRestoredFunc: func(_ context.Context, _, _, _, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+					return "connectionString", tc.restoreErr
+				},
+				EnsureDBSnapshotCreatedFunc: func(_ context.Context, _, snapshotID string) (*cloudprovider.DBSnapshot, error) {
+					return &cloudprovider.DBSnapshot{ID: snapshotID, Status: cloudprovider.DBSnapshotStatusCreating, CreatedAt: createdAt}, nil
+				},
+				ListDBSnapshotsFunc: func(_ context.Context, _ string) ([]cloudprovider.DBSnapshot, error) {
+					return []cloudprovider.DBSnapshot{{ID: "backup", Status: cloudprovider.DBSnapshotStatusCreating, CreatedAt: createdAt}}, nil
+				},
+				GetDBStatusFunc: availableDBStatus,
+			}
+			r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
+				CentralReconcilerOptions{
+					UseRoutes:        true,
+					ManagedDBEnabled: true})
+
+			managedCentral := simpleManagedCentral
+			managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+			managedCentral.Spec.Central.Db = private.ManagedCentralAllOfSpecCentralDb{
+				BackupId:          "backup",
+				RestoreId:         "restore",
+				RestoreSnapshotId: "snapshot",
+			}
+
+			status, err := r.Reconcile(context.TODO(), managedCentral)
+			require.NoError(t, err)
+			assert.Len(t, managedDBProvisioningClient.EnsureDBProvisionedCalls(), tc.wantProvisioned)
+			assert.Equal(t, private.DataPlaneCentralStatusDb{
+				Backup:    private.DataPlaneCentralStatusDbBackup{Id: "backup", Status: "in_progress"},
+				Restore:   tc.wantRestore,
+				Snapshots: []private.DataPlaneCentralStatusDbSnapshots{{Id: "backup", Status: "creating", CreatedAt: createdAt}},
+			}, status.Db)
+
+			// centrals with requested DB operations are reconciled even if they did not change
+			_, err = r.Reconcile(context.TODO(), managedCentral)
+			require.NoError(t, err)
+			assert.Len(t, managedDBProvisioningClient.EnsureDBSnapshotCreatedCalls(), 2)
+		})
+	}
+}
+
 func TestReconcileCreateWithManagedDBNoCredentials(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 
@@ -245,6 +324,45 @@ func TestReconcileLastHashSetOnSuccess(t *testing.T) {
 	assert.Equal(t, "4", central.Annotations[revisionAnnotationKey])
 }
 
+func TestReconcileAppliesAndReportsVersions(t *testing.T) {
+	existingCentral := &v1alpha1.Central{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        centralName,
+			Namespace:   centralNamespace,
+			Annotations: map[string]string{revisionAnnotationKey: "3"},
+		},
+		Status: v1alpha1.CentralStatus{
+			ProductVersion:  "3.73.0",
+			DeployedRelease: &v1alpha1.StackRoxRelease{Version: "3.73.0"},
+		},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, existingCentral, centralDeploymentObject()).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{})
+
+	managedCentral := simpleManagedCentral
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+	managedCentral.Spec.Versions = private.ManagedCentralVersions{Central: "3.74.0", CentralOperator: "3.74.0"}
+
+	status, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	assert.Equal(t, private.DataPlaneCentralStatusVersions{Central: "3.73.0", CentralOperator: "3.73.0"}, status.Versions)
+	assert.Equal(t, [16]byte{}, r.lastCentralHash, "the central must be reconciled until the desired versions are deployed")
+
+	central := &v1alpha1.Central{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+	assert.Equal(t, "3.74.0", central.Labels[versionSelectorLabelKey])
+
+	central.Status.ProductVersion = "3.74.0"
+	central.Status.DeployedRelease.Version = "3.74.0"
+	require.NoError(t, fakeClient.Update(context.TODO(), central))
+
+	status, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	assert.Equal(t, private.DataPlaneCentralStatusVersions{Central: "3.74.0", CentralOperator: "3.74.0"}, status.Versions)
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged)
+}
+
 func TestIgnoreCacheForCentralNotReady(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t, &v1alpha1.Central{
 		ObjectMeta: metav1.ObjectMeta{
@@ -307,7 +425,8 @@ func TestReconcileDeleteWithManagedDB(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 
 	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
-	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (string, error) {
+	managedDBProvisioningClient.GetDBStatusFunc = availableDBStatus
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
 		return "host=localhost port=5432 user=rhacs dbname=postgres sslmode=require", nil
 	}
 	managedDBProvisioningClient.EnsureDBDeprovisionedFunc = func(_ string) (bool, error) {
@@ -326,7 +445,7 @@ func TestReconcileDeleteWithManagedDB(t *testing.T) {
 	deletedCentral.Metadata.DeletionTimestamp = "2006-01-02T15:04:05Z07:00"
 
 	// trigger deletion
-	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (string, error) {
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
 		return "", nil
 	}
 	statusTrigger, err := r.Reconcile(context.TODO(), deletedCentral)
@@ -359,6 +478,220 @@ func TestReconcileDeleteWithManagedDB(t *testing.T) {
 	assert.True(t, k8sErrors.IsNotFound(err))
 }
 
+func TestEnsureOrphanedCentralDeleted(t *testing.T) {
+	tests := []struct {
+		name              string
+		keepDB            bool
+		wantDeprovisioned bool
+	}{
+		{
+			name:              "managed DB of deleted central is deprovisioned",
+			keepDB:            false,
+			wantDeprovisioned: true,
+		},
+		{
+			name:              "managed DB of existing central is kept",
+			keepDB:            true,
+			wantDeprovisioned: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+			managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+			managedDBProvisioningClient.GetDBStatusFunc = availableDBStatus
+			managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+				return "host=localhost port=5432 user=rhacs dbname=postgres sslmode=require", nil
+			}
+			managedDBProvisioningClient.EnsureDBDeprovisionedFunc = func(_ string) (bool, error) {
+				return true, nil
+			}
+			r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
+				CentralReconcilerOptions{
+					UseRoutes:        true,
+					ManagedDBEnabled: true})
+
+			_, err := r.Reconcile(context.TODO(), simpleManagedCentral)
+			require.NoError(t, err)
+
+			namespaces, err := ListTenantNamespaces(context.TODO(), fakeClient)
+			require.NoError(t, err)
+			require.Len(t, namespaces, 1)
+			assert.Equal(t, centralNamespace, namespaces[0].GetName())
+			assert.Equal(t, centralID, TenantID(namespaces[0]))
+
+			orphanReconciler := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
+				CentralReconcilerOptions{
+					UseRoutes:        true,
+					ManagedDBEnabled: true})
+			_, err = orphanReconciler.EnsureOrphanedCentralDeleted(context.TODO(), namespaces[0], tc.keepDB)
+			require.NoError(t, err)
+
+			if tc.wantDeprovisioned {
+				require.Len(t, managedDBProvisioningClient.EnsureDBDeprovisionedCalls(), 1)
+				assert.Equal(t, centralID, managedDBProvisioningClient.EnsureDBDeprovisionedCalls()[0].DatabaseID)
+			} else {
+				assert.Empty(t, managedDBProvisioningClient.EnsureDBDeprovisionedCalls())
+			}
+
+			central := &v1alpha1.Central{}
+			err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+			assert.True(t, k8sErrors.IsNotFound(err))
+
+			route := &openshiftRouteV1.Route{}
+			err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralReencryptRouteName, Namespace: centralNamespace}, route)
+			assert.True(t, k8sErrors.IsNotFound(err))
+
+			namespaces, err = ListTenantNamespaces(context.TODO(), fakeClient)
+			require.NoError(t, err)
+			assert.Empty(t, namespaces)
+		})
+	}
+}
+
+func TestReconcileDeleteMigratingCentralKeepsManagedDB(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.GetDBStatusFunc = availableDBStatus
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+		return "host=localhost port=5432 user=rhacs dbname=postgres sslmode=require", nil
+	}
+	managedDBProvisioningClient.EnsureDBDeprovisionedFunc = func(_ string) (bool, error) {
+		return true, nil
+	}
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
+		CentralReconcilerOptions{
+			UseRoutes:        true,
+			ManagedDBEnabled: true})
+
+	_, err := r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.NoError(t, err)
+
+	deletedCentral := simpleManagedCentral
+	deletedCentral.Metadata.DeletionTimestamp = "2006-01-02T15:04:05Z07:00"
+	deletedCentral.Metadata.Annotations.MasMigration = "source"
+
+	_, err = r.Reconcile(context.TODO(), deletedCentral)
+	require.Error(t, err, ErrDeletionInProgress)
+
+	statusDeletion, err := r.Reconcile(context.TODO(), deletedCentral)
+	require.NoError(t, err)
+	readyCondition, ok := conditionForType(statusDeletion.Conditions, conditionTypeReady)
+	require.True(t, ok, "Ready condition not found in conditions", statusDeletion.Conditions)
+	assert.Equal(t, "Deleted", readyCondition.Reason)
+
+	assert.Empty(t, managedDBProvisioningClient.EnsureDBDeprovisionedCalls())
+
+	central := &v1alpha1.Central{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
+func TestReconcileMigrationTargetResetsManagedDBPassword(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.GetDBStatusFunc = availableDBStatus
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+		return "host=localhost port=5432 user=rhacs dbname=postgres sslmode=require", nil
+	}
+	managedDBProvisioningClient.ResetDBMasterPasswordFunc = func(_ context.Context, _, _ string) error {
+		return nil
+	}
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
+		CentralReconcilerOptions{
+			UseRoutes:        true,
+			ManagedDBEnabled: true})
+
+	migratedCentral := simpleManagedCentral
+	migratedCentral.Metadata.Annotations.MasMigration = "target"
+
+	_, err := r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	require.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 1)
+
+	secret := &v1.Secret{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralDbSecretName, Namespace: centralNamespace}, secret))
+	assert.Equal(t, string(secret.Data["password"]), managedDBProvisioningClient.ResetDBMasterPasswordCalls()[0].MasterPassword)
+	assert.Equal(t, "true", secret.GetAnnotations()[dbPasswordResetAnnotation])
+
+	migratedCentral.Spec.Central.Resources.Limits = map[string]string{"memory": "4Gi"}
+	_, err = r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	assert.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 1, "the password must only be reset once")
+}
+
+func TestReconcileMigrationSourceScalesDownAndRestores(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.GetDBStatusFunc = availableDBStatus
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string, _ private.ManagedCentralAllOfSpecCentralDb) (string, error) {
+		return "host=localhost port=5432 user=rhacs dbname=postgres sslmode=require", nil
+	}
+	managedDBProvisioningClient.ResetDBMasterPasswordFunc = func(_ context.Context, _, _ string) error {
+		return nil
+	}
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient,
+		CentralReconcilerOptions{
+			UseRoutes:        true,
+			ManagedDBEnabled: true})
+	managedCentral := simpleManagedCentral
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+
+	_, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+
+	managedCentral.Metadata.Annotations.MasMigration = "source"
+	status, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, ok := conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, ok)
+	assert.Equal(t, "Suspended", readyCondition.Reason, "the source must be scaled down before the target deploys the central")
+	assert.Empty(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls())
+
+	central := &v1alpha1.Central{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+	assert.Equal(t, "true", central.GetAnnotations()[pauseReconcileAnnotation])
+	assert.Equal(t, "true", central.GetAnnotations()[migrationSourceAnnotation])
+	for _, name := range []string{"central", "egress-proxy"} {
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: centralNamespace}, deployment))
+		assert.Equal(t, int32(0), *deployment.Spec.Replicas, "deployment %s is not scaled down", name)
+	}
+
+	// The migration failed and the central stays on the source cluster.
+	managedCentral.Metadata.Annotations.MasMigration = ""
+	status, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, ok = conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, ok)
+	assert.Equal(t, "True", readyCondition.Status)
+
+	secret := &v1.Secret{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralDbSecretName, Namespace: centralNamespace}, secret))
+	require.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 1)
+	assert.Equal(t, string(secret.Data["password"]), managedDBProvisioningClient.ResetDBMasterPasswordCalls()[0].MasterPassword,
+		"the source must restore its password, which the migration target may have reset")
+
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+	assert.NotContains(t, central.GetAnnotations(), pauseReconcileAnnotation)
+	assert.NotContains(t, central.GetAnnotations(), migrationSourceAnnotation)
+	for name, replicas := range map[string]int32{"central": 1, "egress-proxy": 2} {
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: centralNamespace}, deployment))
+		assert.Equal(t, replicas, *deployment.Spec.Replicas, "deployment %s is not scaled up", name)
+	}
+
+	managedCentral.Spec.Central.Resources.Limits = map[string]string{"memory": "4Gi"}
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	assert.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 1, "the password must only be restored once")
+}
+
 func TestCentralChanged(t *testing.T) {
 
 	tests := []struct {
@@ -574,6 +907,322 @@ func TestEgressProxyCustomImage(t *testing.T) {
 	assert.Equal(t, "registry.redhat.io/openshift4/ose-egress-http-proxy:version-for-test", containers[0].Image)
 }
 
+func TestEgressProxyAllowlist(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{})
+	managedCentral := simpleManagedCentral
+
+	getConfig := func() (string, string) {
+		configMap := &v1.ConfigMap{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: "egress-proxy-config", Namespace: centralNamespace}, configMap))
+		dep := &appsv1.Deployment{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: "egress-proxy", Namespace: centralNamespace}, dep))
+		return configMap.Data["squid.conf"], dep.Spec.Template.GetAnnotations()["config-hash"]
+	}
+
+	_, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	squidConf, configHash := getConfig()
+	assert.NotContains(t, squidConf, "tenant_allowlist")
+	assert.NotEmpty(t, configHash)
+
+	managedCentral.Spec.EgressAllowlist = private.ManagedCentralAllOfSpecEgressAllowlist{
+		Domains: []string{"jira.example.com", ".registry.example.com"},
+		Cidrs:   []string{"10.1.0.0/16"},
+	}
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	allowlistSquidConf, allowlistConfigHash := getConfig()
+	assert.Contains(t, allowlistSquidConf, "acl tenant_allowlist_domains dstdomain jira.example.com .registry.example.com\n")
+	assert.Contains(t, allowlistSquidConf, "acl tenant_allowlist_cidrs dst 10.1.0.0/16\n")
+	assert.Contains(t, allowlistSquidConf, "http_access allow localnet tenant_allowlist_cidrs tenant_allowlist_domains\n",
+		"domains must only allow destinations within the allowlisted CIDR ranges")
+	assert.NotContains(t, allowlistSquidConf, "http_access allow localnet tenant_allowlist_domains")
+	assert.Contains(t, allowlistSquidConf, "acl to_forbidden dst 10.128.0.0/14 172.30.0.0/16")
+	assert.Less(t, strings.Index(allowlistSquidConf, "http_access deny to_forbidden"),
+		strings.Index(allowlistSquidConf, "http_access allow localnet tenant_allowlist_cidrs"), "link-local and cluster networks must be denied before the allowlist")
+	assert.Less(t, strings.Index(allowlistSquidConf, "http_access allow localnet tenant_allowlist_cidrs"),
+		strings.Index(allowlistSquidConf, "http_access deny to_localnet"), "allowlist must take precedence over denying local networks")
+	assert.NotEqual(t, configHash, allowlistConfigHash, "egress proxy must be rolled out when the allowlist changes")
+
+	managedCentral.Spec.EgressAllowlist.Cidrs = nil
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	domainsOnlySquidConf, _ := getConfig()
+	assert.NotContains(t, domainsOnlySquidConf, "tenant_allowlist", "domains alone must not allow any destination")
+	assert.Contains(t, domainsOnlySquidConf, "http_access deny to_localnet\n")
+}
+
+func TestTenantIsolationResourcesAreDeployed(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{})
+	managedCentral := simpleManagedCentral
+	managedCentral.Spec.Central.Resources = private.ResourceRequirements{
+		Requests: map[string]string{"cpu": "1", "memory": "2Gi"},
+	}
+	managedCentral.Spec.Scanner.Analyzer.Resources = private.ResourceRequirements{
+		Requests: map[string]string{"cpu": "500m", "memory": "1Gi"},
+	}
+	managedCentral.Spec.Scanner.Db.Resources = private.ResourceRequirements{
+		Requests: map[string]string{"cpu": "200m", "memory": "512Mi"},
+	}
+
+	_, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+
+	for _, name := range []string{"tenant-default-deny", "tenant-central", "tenant-scanner", "tenant-scanner-db"} {
+		networkPolicy := &networkingv1.NetworkPolicy{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: centralNamespace}, networkPolicy))
+		assert.Equal(t, k8s.ManagedByFleetshardValue, networkPolicy.GetLabels()[k8s.ManagedByLabelKey])
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, networkPolicy.Spec.PolicyTypes)
+	}
+
+	quota := &v1.ResourceQuota{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: "tenant-quota", Namespace: centralNamespace}, quota))
+	requestsCPU := quota.Spec.Hard[v1.ResourceRequestsCPU]
+	// Scanner scales up to the operator default of 5 replicas.
+	assert.Equal(t, "7800m", requestsCPU.String())
+	assert.NotContains(t, quota.Spec.Hard, v1.ResourceLimitsCPU)
+
+	limitRange := &v1.LimitRange{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: "tenant-limits", Namespace: centralNamespace}, limitRange))
+	require.Len(t, limitRange.Spec.Limits, 1)
+	defaultRequestCPU := limitRange.Spec.Limits[0].DefaultRequest[v1.ResourceCPU]
+	assert.Equal(t, "100m", defaultRequestCPU.String())
+}
+
+func TestReconcileSuspendAndResume(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{})
+	managedCentral := simpleManagedCentral
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+	managedCentral.Spec.Scanner.Analyzer.Scaling.Replicas = 3
+
+	_, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	scanner := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "scanner", Namespace: centralNamespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+	}
+	require.NoError(t, fakeClient.Create(context.TODO(), scanner))
+
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusSuspending.String()
+	status, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, ok := conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, ok)
+	assert.Equal(t, "False", readyCondition.Status)
+	assert.Equal(t, "Suspended", readyCondition.Reason)
+
+	central := &v1alpha1.Central{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+	assert.Equal(t, "true", central.GetAnnotations()[pauseReconcileAnnotation])
+	for _, name := range []string{"central", "scanner", "egress-proxy"} {
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: centralNamespace}, deployment))
+		assert.Equal(t, int32(0), *deployment.Spec.Replicas, "deployment %s is not scaled down", name)
+	}
+
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusSuspended.String()
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged)
+
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusResuming.String()
+	status, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, ok = conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, ok)
+	assert.Equal(t, "True", readyCondition.Status)
+
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+	assert.NotContains(t, central.GetAnnotations(), pauseReconcileAnnotation)
+	for name, replicas := range map[string]int32{"central": 1, "scanner": 3, "egress-proxy": 2} {
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: centralNamespace}, deployment))
+		assert.Equal(t, replicas, *deployment.Spec.Replicas, "deployment %s is not scaled up", name)
+	}
+}
+
+func TestReconcileSuspendingWaitsForPods(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{})
+	managedCentral := simpleManagedCentral
+
+	_, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	scanner := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "scanner", Namespace: centralNamespace},
+		Status:     appsv1.DeploymentStatus{Replicas: 1},
+	}
+	require.NoError(t, fakeClient.Create(context.TODO(), scanner))
+
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusSuspending.String()
+	status, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, ok := conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, ok)
+	assert.Equal(t, "Installing", readyCondition.Reason)
+	changed, err := r.centralChanged(managedCentral)
+	require.NoError(t, err)
+	assert.True(t, changed, "suspension is completed on the next reconciliation")
+}
+
+func TestReconcileDrift(t *testing.T) {
+	tests := []struct {
+		name        string
+		revertDrift bool
+		wantStatus  string
+		wantReason  string
+		wantLabel   string
+	}{
+		{
+			name:        "drift is reported",
+			revertDrift: false,
+			wantStatus:  "True",
+			wantReason:  driftDetectedReason,
+			wantLabel:   "drifted",
+		},
+		{
+			name:        "drift is reverted",
+			revertDrift: true,
+			wantStatus:  "False",
+			wantReason:  driftRevertedReason,
+			wantLabel:   centralID,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t).Build()
+			r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{
+				DriftCheckPeriod: time.Hour,
+				RevertDrift:      tc.revertDrift,
+			})
+			managedCentral := simpleManagedCentral
+			managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+
+			_, err := r.Reconcile(context.TODO(), managedCentral)
+			require.NoError(t, err)
+			_, err = r.Reconcile(context.TODO(), managedCentral)
+			require.ErrorIs(t, err, ErrCentralNotChanged, "drift must not be checked before the drift check period passed")
+
+			r.lastDriftCheck = time.Time{}
+			status, err := r.Reconcile(context.TODO(), managedCentral)
+			require.NoError(t, err)
+			driftCondition, ok := conditionForType(status.Conditions, centralConstants.CentralHealthConditionDrifted.String())
+			require.True(t, ok)
+			assert.Equal(t, "False", driftCondition.Status, "the result of the first drift check is reported")
+
+			r.lastDriftCheck = time.Time{}
+			_, err = r.Reconcile(context.TODO(), managedCentral)
+			require.ErrorIs(t, err, ErrCentralNotChanged, "central without drift must not be reconciled")
+
+			central := &v1alpha1.Central{}
+			require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+			central.Spec.Customize.Labels[tenantIDLabelKey] = "drifted"
+			require.NoError(t, fakeClient.Update(context.TODO(), central))
+
+			r.lastDriftCheck = time.Time{}
+			status, err = r.Reconcile(context.TODO(), managedCentral)
+			require.NoError(t, err)
+			driftCondition, ok = conditionForType(status.Conditions, centralConstants.CentralHealthConditionDrifted.String())
+			require.True(t, ok)
+			assert.Equal(t, tc.wantStatus, driftCondition.Status)
+			assert.Equal(t, tc.wantReason, driftCondition.Reason)
+			assert.Contains(t, driftCondition.Message, "Central/"+centralName)
+			readyCondition, ok := conditionForType(status.Conditions, conditionTypeReady)
+			require.True(t, ok)
+			assert.Equal(t, "True", readyCondition.Status)
+
+			require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+			assert.Equal(t, tc.wantLabel, central.Spec.Customize.Labels[tenantIDLabelKey])
+
+			// Once the drift is gone, the next drift check reports that the central is not drifted anymore.
+			central.Spec.Customize.Labels[tenantIDLabelKey] = centralID
+			require.NoError(t, fakeClient.Update(context.TODO(), central))
+			r.lastDriftCheck = time.Time{}
+			status, err = r.Reconcile(context.TODO(), managedCentral)
+			require.NoError(t, err)
+			driftCondition, ok = conditionForType(status.Conditions, centralConstants.CentralHealthConditionDrifted.String())
+			require.True(t, ok)
+			assert.Equal(t, "False", driftCondition.Status)
+			assert.Empty(t, driftCondition.Reason)
+		})
+	}
+}
+
+func TestReconcilePausedForTenant(t *testing.T) {
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        centralNamespace,
+			Annotations: map[string]string{pauseTenantReconcileAnnotation: "true"},
+		},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, namespace).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, CentralReconcilerOptions{})
+
+	_, err := r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.ErrorIs(t, err, ErrReconciliationPaused)
+
+	central := &v1alpha1.Central{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	assert.True(t, k8sErrors.IsNotFound(err), "central must not be created while reconciliation is paused")
+}
+
+func TestIsObjectSubset(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired interface{}
+		live    interface{}
+		want    bool
+	}{
+		{
+			name:    "fields set only in the live object are ignored",
+			desired: map[string]interface{}{"a": "x"},
+			live:    map[string]interface{}{"a": "x", "b": "y"},
+			want:    true,
+		},
+		{
+			name:    "changed field",
+			desired: map[string]interface{}{"a": "x"},
+			live:    map[string]interface{}{"a": "y"},
+			want:    false,
+		},
+		{
+			name:    "missing field",
+			desired: map[string]interface{}{"a": map[string]interface{}{"b": "x"}},
+			live:    map[string]interface{}{},
+			want:    false,
+		},
+		{
+			name:    "numbers of different types",
+			desired: map[string]interface{}{"replicas": int64(2)},
+			live:    map[string]interface{}{"replicas": float64(2)},
+			want:    true,
+		},
+		{
+			name:    "empty map matches unset field",
+			desired: map[string]interface{}{"annotations": map[string]interface{}{}},
+			live:    map[string]interface{}{},
+			want:    true,
+		},
+		{
+			name:    "lists of different length",
+			desired: []interface{}{"a"},
+			live:    []interface{}{"a", "b"},
+			want:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isObjectSubset(tc.desired, tc.live))
+		})
+	}
+}
+
 func TestNoRoutesSentWhenOneNotCreated(t *testing.T) {
 	fakeClient, tracker := testutils.NewFakeClientWithTracker(t)
 	tracker.AddRouteError(centralReencryptRouteName, errors.New("fake error"))
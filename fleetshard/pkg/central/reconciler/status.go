@@ -36,3 +36,15 @@ func installingStatus() *private.DataPlaneCentralStatus {
 		},
 	}
 }
+
+func suspendedStatus() *private.DataPlaneCentralStatus {
+	return &private.DataPlaneCentralStatus{
+		Conditions: []private.DataPlaneClusterUpdateStatusRequestConditions{
+			{
+				Type:   "Ready",
+				Status: "False",
+				Reason: "Suspended",
+			},
+		},
+	}
+}
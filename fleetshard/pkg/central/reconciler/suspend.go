@@ -0,0 +1,116 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	appsv1 "k8s.io/api/apps/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/pointer"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pauseReconcileAnnotation stops the operator from reconciling a Central CR, so that it does not scale the
+// deployments of a suspended Central up again.
+const pauseReconcileAnnotation = "stackrox.io/pause-reconcile"
+
+// suspendedDeployments are the deployments of a Central which are scaled down to zero while it is suspended. The
+// egress proxy is scaled down through the values of the tenant resources chart.
+var suspendedDeployments = []string{"central", "scanner", "scanner-db"}
+
+// isRemoteCentralSuspended returns true if the Central must be scaled down. This is the case while it is suspended, and
+// while it is migrated away from this cluster, since the migration target must not run it against the same managed DB.
+func isRemoteCentralSuspended(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusSuspending.String() ||
+		remoteCentral.RequestStatus == centralConstants.CentralRequestStatusSuspended.String() ||
+		isRemoteCentralMigrationSource(remoteCentral)
+}
+
+func isRemoteCentralResuming(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusResuming.String()
+}
+
+// ensureDeploymentsSuspended scales the deployments of a suspended Central down to zero. It returns true once no pods
+// of the deployments are left.
+func (r *CentralReconciler) ensureDeploymentsSuspended(ctx context.Context, namespace string) (bool, error) {
+	scaledDown := true
+	for _, name := range suspendedDeployments {
+		deployment := &appsv1.Deployment{}
+		err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: name}, deployment)
+		if err != nil {
+			if apiErrors.IsNotFound(err) {
+				continue
+			}
+			return false, errors.Wrapf(err, "retrieving deployment %s/%s", namespace, name)
+		}
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 0 {
+			glog.Infof("Scaling down deployment %s/%s", namespace, name)
+			deployment.Spec.Replicas = pointer.Int32(0)
+			if err := r.client.Update(ctx, deployment); err != nil {
+				return false, errors.Wrapf(err, "scaling down deployment %s/%s", namespace, name)
+			}
+		}
+		if deployment.Status.Replicas != 0 {
+			scaledDown = false
+		}
+	}
+	return scaledDown, nil
+}
+
+// ensureDeploymentsResumed scales the deployments of a resumed Central which are still scaled down to zero up again.
+func (r *CentralReconciler) ensureDeploymentsResumed(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	namespace := remoteCentral.Metadata.Namespace
+	for _, name := range suspendedDeployments {
+		deployment := &appsv1.Deployment{}
+		err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: name}, deployment)
+		if err != nil {
+			if apiErrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "retrieving deployment %s/%s", namespace, name)
+		}
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 0 {
+			continue
+		}
+		replicas := int32(1)
+		if name == "scanner" && remoteCentral.Spec.Scanner.Analyzer.Scaling.Replicas > replicas {
+			replicas = remoteCentral.Spec.Scanner.Analyzer.Scaling.Replicas
+		}
+		glog.Infof("Scaling up deployment %s/%s to %d replicas", namespace, name, replicas)
+		deployment.Spec.Replicas = pointer.Int32(replicas)
+		if err := r.client.Update(ctx, deployment); err != nil {
+			return errors.Wrapf(err, "scaling up deployment %s/%s", namespace, name)
+		}
+	}
+	return nil
+}
+
+// isRemoteCentralFinallySuspended returns true if fleet manager has already stored that the Central is suspended, so
+// that there is nothing left to do for it.
+func isRemoteCentralFinallySuspended(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusSuspended.String()
+}
+
+// reconcileSuspendedCentral scales down the deployments of a suspended Central. It reports the Central as suspended
+// once all of its pods are gone, and as installing until then.
+func (r *CentralReconciler) reconcileSuspendedCentral(ctx context.Context, remoteCentral private.ManagedCentral, dbStatus private.DataPlaneCentralStatusDb) (*private.DataPlaneCentralStatus, error) {
+	scaledDown, err := r.ensureDeploymentsSuspended(ctx, remoteCentral.Metadata.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !scaledDown {
+		status := installingStatus()
+		status.Db = dbStatus
+		return status, nil
+	}
+
+	status := suspendedStatus()
+	status.Db = dbStatus
+	if err := r.setLastCentralHash(remoteCentral); err != nil {
+		return nil, errors.Wrapf(err, "setting central reconcilation cache")
+	}
+	return status, nil
+}
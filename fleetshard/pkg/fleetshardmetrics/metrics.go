@@ -17,12 +17,17 @@ var (
 // Metrics holds the prometheus.Collector instances for fleetshard-sync's custom metrics
 // and provides methods to interact with them.
 type Metrics struct {
-	fleetManagerRequests        prometheus.Counter
-	fleetManagerRequestErrors   prometheus.Counter
-	centralReconcilations       prometheus.Counter
-	centralReconcilationErrors  prometheus.Counter
-	activeCentralReconcilations prometheus.Gauge
-	totalCentrals               prometheus.Gauge
+	fleetManagerRequests           prometheus.Counter
+	fleetManagerRequestErrors      prometheus.Counter
+	centralReconcilations          prometheus.Counter
+	centralReconcilationErrors     prometheus.Counter
+	activeCentralReconcilations    prometheus.Gauge
+	totalCentrals                  prometheus.Gauge
+	centralReconcilationRequeues   prometheus.Counter
+	centralQueueDepth              prometheus.Gauge
+	centralQueueWaitSeconds        prometheus.Histogram
+	orphanedTenantNamespaces       *prometheus.GaugeVec
+	orphanedTenantNamespaceActions *prometheus.CounterVec
 }
 
 // Register registers the metrics with the given prometheus.Registerer
@@ -33,6 +38,11 @@ func (m *Metrics) Register(r prometheus.Registerer) {
 	r.MustRegister(m.centralReconcilationErrors)
 	r.MustRegister(m.activeCentralReconcilations)
 	r.MustRegister(m.totalCentrals)
+	r.MustRegister(m.centralReconcilationRequeues)
+	r.MustRegister(m.centralQueueDepth)
+	r.MustRegister(m.centralQueueWaitSeconds)
+	r.MustRegister(m.orphanedTenantNamespaces)
+	r.MustRegister(m.orphanedTenantNamespaceActions)
 }
 
 // IncFleetManagerRequests increments the metric counter for fleet-manager requests
@@ -70,6 +80,36 @@ func (m *Metrics) DecActiveCentralReconcilations() {
 	m.activeCentralReconcilations.Dec()
 }
 
+// IncCentralReconcilationRequeues increments the metric counter for central reconcilations requeued after an error
+func (m *Metrics) IncCentralReconcilationRequeues() {
+	m.centralReconcilationRequeues.Inc()
+}
+
+// SetCentralQueueDepth sets the metric for the number of centrals waiting to be reconciled to the given value
+func (m *Metrics) SetCentralQueueDepth(v float64) {
+	m.centralQueueDepth.Set(v)
+}
+
+// ObserveCentralQueueWaitSeconds observes the time a central waited in the queue before it was reconciled
+func (m *Metrics) ObserveCentralQueueWaitSeconds(v float64) {
+	m.centralQueueWaitSeconds.Observe(v)
+}
+
+// SetOrphanedTenantNamespaces replaces the orphaned tenant namespaces found by the last garbage collection pass. The
+// keys of the given map are the names of the namespaces and the values the IDs of their tenants.
+func (m *Metrics) SetOrphanedTenantNamespaces(tenantIDsByNamespace map[string]string) {
+	m.orphanedTenantNamespaces.Reset()
+	for namespace, tenantID := range tenantIDsByNamespace {
+		m.orphanedTenantNamespaces.WithLabelValues(namespace, tenantID).Set(1)
+	}
+}
+
+// IncOrphanedTenantNamespaceActions increments the metric counter for the given garbage collection action taken on an
+// orphaned tenant namespace
+func (m *Metrics) IncOrphanedTenantNamespaceActions(action string) {
+	m.orphanedTenantNamespaceActions.WithLabelValues(action).Inc()
+}
+
 // MetricsInstance return the global Singleton instance for Metrics
 func MetricsInstance() *Metrics {
 	once.Do(initMetricsInstance)
@@ -106,5 +146,26 @@ func newMetrics() *Metrics {
 			Name: metricsPrefix + "total_centrals",
 			Help: "The total number of centrals monitored by fleetshard-sync",
 		}),
+		centralReconcilationRequeues: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metricsPrefix + "total_central_reconcilation_requeues",
+			Help: "The total number of central reconcilations requeued after an error",
+		}),
+		centralQueueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: metricsPrefix + "central_queue_depth",
+			Help: "The number of centrals waiting to be reconciled",
+		}),
+		centralQueueWaitSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    metricsPrefix + "central_queue_wait_seconds",
+			Help:    "The time in seconds a central waited in the queue before it was reconciled",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+		}),
+		orphanedTenantNamespaces: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: metricsPrefix + "orphaned_tenant_namespaces",
+			Help: "The tenant namespaces whose centrals are not managed by fleet-manager anymore",
+		}, []string{"namespace", "tenant_id"}),
+		orphanedTenantNamespaceActions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: metricsPrefix + "total_orphaned_tenant_namespace_actions",
+			Help: "The total number of garbage collection actions taken on orphaned tenant namespaces",
+		}, []string{"action"}),
 	}
 }
@@ -39,6 +39,12 @@ func TestCounterIncrements(t *testing.T) {
 				m.IncCentralReconcilationErrors()
 			},
 		},
+		{
+			metricName: "total_central_reconcilation_requeues",
+			callIncrementFunc: func(m *Metrics) {
+				m.IncCentralReconcilationRequeues()
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -88,6 +94,64 @@ func TestActiveCentralReconcilations(t *testing.T) {
 	assert.Equalf(t, 0.0, *value, "expected metric: %s to have value: %v", metricName, 0.0)
 }
 
+func TestCentralQueueDepth(t *testing.T) {
+	m := newMetrics()
+	metricName := metricsPrefix + "central_queue_depth"
+
+	m.SetCentralQueueDepth(3)
+	metrics := serveMetrics(t, m)
+
+	targetMetric := requireMetric(t, metrics, metricName)
+	value := targetMetric.Metric[0].Gauge.Value
+	assert.Equalf(t, 3.0, *value, "expected metric: %s to have value: %v", metricName, 3.0)
+}
+
+func TestCentralQueueWaitSeconds(t *testing.T) {
+	m := newMetrics()
+	metricName := metricsPrefix + "central_queue_wait_seconds"
+
+	m.ObserveCentralQueueWaitSeconds(0.5)
+	m.ObserveCentralQueueWaitSeconds(1.5)
+	metrics := serveMetrics(t, m)
+
+	targetMetric := requireMetric(t, metrics, metricName)
+	histogram := targetMetric.Metric[0].Histogram
+	assert.Equal(t, uint64(2), histogram.GetSampleCount())
+	assert.Equal(t, 2.0, histogram.GetSampleSum())
+}
+
+func TestOrphanedTenantNamespaces(t *testing.T) {
+	m := newMetrics()
+	metricName := metricsPrefix + "orphaned_tenant_namespaces"
+
+	m.SetOrphanedTenantNamespaces(map[string]string{"rhacs-1": "1", "rhacs-2": "2"})
+	m.SetOrphanedTenantNamespaces(map[string]string{"rhacs-2": "2"})
+	metrics := serveMetrics(t, m)
+
+	targetMetric := requireMetric(t, metrics, metricName)
+	require.Len(t, targetMetric.Metric, 1)
+	labels := map[string]string{}
+	for _, label := range targetMetric.Metric[0].Label {
+		labels[label.GetName()] = label.GetValue()
+	}
+	assert.Equal(t, map[string]string{"namespace": "rhacs-2", "tenant_id": "2"}, labels)
+	assert.Equal(t, 1.0, targetMetric.Metric[0].Gauge.GetValue())
+}
+
+func TestOrphanedTenantNamespaceActions(t *testing.T) {
+	m := newMetrics()
+	metricName := metricsPrefix + "total_orphaned_tenant_namespace_actions"
+
+	m.IncOrphanedTenantNamespaceActions("dry_run")
+	m.IncOrphanedTenantNamespaceActions("dry_run")
+	metrics := serveMetrics(t, m)
+
+	targetMetric := requireMetric(t, metrics, metricName)
+	require.Len(t, targetMetric.Metric, 1)
+	assert.Equal(t, "dry_run", targetMetric.Metric[0].Label[0].GetValue())
+	assert.Equal(t, 2.0, targetMetric.Metric[0].Counter.GetValue())
+}
+
 func requireMetric(t *testing.T, metrics metricResponse, metricName string) *io_prometheus_client.MetricFamily {
 	targetMetric, hasKey := metrics[metricName]
 	require.Truef(t, hasKey, "expected metrics to contain %s but it did not: %v", metricName, metrics)
@@ -0,0 +1,128 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Actions taken by the garbage collection on orphaned tenant namespaces. They are exported as metric labels.
+const (
+	gcActionGracePeriod = "grace_period"
+	gcActionDryRun      = "dry_run"
+	gcActionDeleting    = "deleting"
+	gcActionDeleted     = "deleted"
+	gcActionFailed      = "failed"
+)
+
+// collectGarbage deletes tenant namespaces whose centrals are not managed by fleet-manager for this cluster anymore,
+// e.g. because their database record was deleted by an admin. A namespace is only deleted once it was orphaned for
+// the configured grace period. In dry-run mode the orphaned namespaces are only reported.
+func (r *Runtime) collectGarbage(ctx context.Context) (time.Duration, error) {
+	list, _, err := r.client.PrivateAPI().GetCentrals(ctx, r.clusterID)
+	if err != nil {
+		err = errors.Wrap(err, "retrieving list of managed centrals for garbage collection")
+		glog.Error(err)
+		return 0, err
+	}
+	namespaces, err := centralReconciler.ListTenantNamespaces(ctx, r.k8sClient)
+	if err != nil {
+		glog.Error(err)
+		return 0, err
+	}
+
+	for _, namespace := range r.findExpiredOrphans(namespaces, list.Items, time.Now()) {
+		r.deleteOrphan(ctx, namespace)
+	}
+	return r.config.RuntimeGCPeriod, nil
+}
+
+// findExpiredOrphans updates the orphaned tenant namespaces with the given namespaces and managed centrals, and returns
+// those which have been orphaned for longer than the grace period.
+func (r *Runtime) findExpiredOrphans(namespaces []corev1.Namespace, managed []private.ManagedCentral, now time.Time) []corev1.Namespace {
+	managedIDs := make(map[string]struct{}, len(managed))
+	for _, central := range managed {
+		managedIDs[central.Id] = struct{}{}
+	}
+
+	orphanedSince := make(map[string]time.Time)
+	tenantIDsByNamespace := make(map[string]string)
+	var expired []corev1.Namespace
+	for _, namespace := range namespaces {
+		tenantID := centralReconciler.TenantID(namespace)
+		if _, ok := managedIDs[tenantID]; ok {
+			continue
+		}
+		since, ok := r.orphanedNamespaces[namespace.GetName()]
+		if !ok {
+			since = now
+			glog.Infof("Found orphaned tenant namespace %s of central %s", namespace.GetName(), tenantID)
+		}
+		orphanedSince[namespace.GetName()] = since
+		tenantIDsByNamespace[namespace.GetName()] = tenantID
+
+		if now.Sub(since) < r.config.RuntimeGCGracePeriod {
+			fleetshardmetrics.MetricsInstance().IncOrphanedTenantNamespaceActions(gcActionGracePeriod)
+			continue
+		}
+		expired = append(expired, namespace)
+	}
+	// Namespaces which were deleted or whose centrals are managed again are forgotten.
+	r.orphanedNamespaces = orphanedSince
+	fleetshardmetrics.MetricsInstance().SetOrphanedTenantNamespaces(tenantIDsByNamespace)
+	return expired
+}
+
+// deleteOrphan deletes an orphaned tenant namespace together with its Central, unless garbage collection runs in
+// dry-run mode. The managed DB of the Central is only deprovisioned if fleet-manager confirms that the Central is gone.
+// Centrals which still exist, e.g. because they moved to another cluster, keep their managed DB.
+func (r *Runtime) deleteOrphan(ctx context.Context, namespace corev1.Namespace) {
+	tenantID := centralReconciler.TenantID(namespace)
+	if r.config.RuntimeGCDryRun {
+		glog.Infof("Dry run: would delete orphaned tenant namespace %s of central %s", namespace.GetName(), tenantID)
+		fleetshardmetrics.MetricsInstance().IncOrphanedTenantNamespaceActions(gcActionDryRun)
+		return
+	}
+
+	keepDB, err := r.centralExists(ctx, tenantID)
+	if err != nil {
+		glog.Errorf("Checking whether central %s of orphaned tenant namespace %s exists: %v", tenantID, namespace.GetName(), err)
+		fleetshardmetrics.MetricsInstance().IncOrphanedTenantNamespaceActions(gcActionFailed)
+		return
+	}
+
+	reconciler := centralReconciler.NewCentralReconciler(r.k8sClient, private.ManagedCentral{Id: tenantID}, r.dbProvisionClient, r.reconcilerOpts)
+	deleted, err := reconciler.EnsureOrphanedCentralDeleted(ctx, namespace, keepDB)
+	if err != nil {
+		glog.Errorf("Deleting orphaned tenant namespace %s of central %s: %v", namespace.GetName(), tenantID, err)
+		fleetshardmetrics.MetricsInstance().IncOrphanedTenantNamespaceActions(gcActionFailed)
+		return
+	}
+	if !deleted {
+		glog.Infof("Deletion of orphaned tenant namespace %s of central %s in progress", namespace.GetName(), tenantID)
+		fleetshardmetrics.MetricsInstance().IncOrphanedTenantNamespaceActions(gcActionDeleting)
+		return
+	}
+	glog.Infof("Deleted orphaned tenant namespace %s of central %s", namespace.GetName(), tenantID)
+	fleetshardmetrics.MetricsInstance().IncOrphanedTenantNamespaceActions(gcActionDeleted)
+}
+
+// centralExists asks fleet-manager whether the central still exists. Only a not found response means that the central
+// is gone. The authorization of the request has been checked by listing the managed centrals before.
+func (r *Runtime) centralExists(ctx context.Context, centralID string) (bool, error) {
+	resp, err := r.client.PrivateAPI().CheckCentralExists(ctx, r.clusterID, centralID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "checking whether central %s exists", centralID)
+	}
+	return true, nil
+}
@@ -0,0 +1,181 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func tenantNamespace(tenantID string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "rhacs-" + tenantID,
+			Labels: map[string]string{"rhacs.redhat.com/tenant": tenantID},
+		},
+	}
+}
+
+func namespaceNames(namespaces []corev1.Namespace) []string {
+	names := []string{}
+	for _, namespace := range namespaces {
+		names = append(names, namespace.GetName())
+	}
+	return names
+}
+
+func TestFindExpiredOrphans(t *testing.T) {
+	ready := centralConstants.CentralRequestStatusReady
+	now := time.Now()
+	namespaces := []corev1.Namespace{*tenantNamespace("a"), *tenantNamespace("b"), *tenantNamespace("c")}
+
+	tests := []struct {
+		name          string
+		orphanedSince map[string]time.Time
+		managed       []private.ManagedCentral
+		wantExpired   []string
+		wantOrphaned  []string
+	}{
+		{
+			name:         "namespaces of managed centrals are kept",
+			managed:      []private.ManagedCentral{managedCentral("a", ready), managedCentral("b", ready), managedCentral("c", ready)},
+			wantExpired:  []string{},
+			wantOrphaned: []string{},
+		},
+		{
+			name:         "new orphans are within the grace period",
+			managed:      []private.ManagedCentral{managedCentral("a", ready)},
+			wantExpired:  []string{},
+			wantOrphaned: []string{"rhacs-b", "rhacs-c"},
+		},
+		{
+			name: "orphans expire after the grace period",
+			orphanedSince: map[string]time.Time{
+				"rhacs-b": now.Add(-2 * time.Hour),
+				"rhacs-c": now.Add(-30 * time.Minute),
+			},
+			managed:      []private.ManagedCentral{managedCentral("a", ready)},
+			wantExpired:  []string{"rhacs-b"},
+			wantOrphaned: []string{"rhacs-b", "rhacs-c"},
+		},
+		{
+			name: "orphans which are managed again are forgotten",
+			orphanedSince: map[string]time.Time{
+				"rhacs-b": now.Add(-2 * time.Hour),
+				"rhacs-x": now.Add(-2 * time.Hour),
+			},
+			managed:      []private.ManagedCentral{managedCentral("a", ready), managedCentral("b", ready), managedCentral("c", ready)},
+			wantExpired:  []string{},
+			wantOrphaned: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Runtime{
+				config:             &config.Config{RuntimeGCGracePeriod: time.Hour},
+				orphanedNamespaces: map[string]time.Time{},
+			}
+			for name, since := range tc.orphanedSince {
+				r.orphanedNamespaces[name] = since
+			}
+
+			expired := r.findExpiredOrphans(namespaces, tc.managed, now)
+
+			assert.ElementsMatch(t, tc.wantExpired, namespaceNames(expired))
+			orphaned := []string{}
+			for name := range r.orphanedNamespaces {
+				orphaned = append(orphaned, name)
+			}
+			assert.ElementsMatch(t, tc.wantOrphaned, orphaned)
+		})
+	}
+}
+
+func TestDeleteOrphan(t *testing.T) {
+	tests := []struct {
+		name              string
+		dryRun            bool
+		existsStatusCode  int
+		existsErr         error
+		wantDeleted       bool
+		wantDeprovisioned bool
+	}{
+		{
+			name:        "dry run keeps the namespace",
+			dryRun:      true,
+			wantDeleted: false,
+		},
+		{
+			name:              "namespace and managed DB of deleted central are deleted",
+			existsStatusCode:  http.StatusNotFound,
+			existsErr:         errors.New("404 Not Found"),
+			wantDeleted:       true,
+			wantDeprovisioned: true,
+		},
+		{
+			name:              "managed DB of existing central is kept",
+			existsStatusCode:  http.StatusNoContent,
+			wantDeleted:       true,
+			wantDeprovisioned: false,
+		},
+		{
+			name:              "namespace is kept if the central cannot be looked up",
+			existsStatusCode:  http.StatusInternalServerError,
+			existsErr:         errors.New("500 Internal Server Error"),
+			wantDeleted:       false,
+			wantDeprovisioned: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t, tenantNamespace("a")).Build()
+			fleetManagerClient := fleetmanager.NewClientMock()
+			fleetManagerClient.PrivateAPIMock.CheckCentralExistsFunc = func(_ context.Context, _ string, _ string) (*http.Response, error) {
+				return &http.Response{StatusCode: tc.existsStatusCode}, tc.existsErr
+			}
+			dbClient := &cloudprovider.DBClientMock{
+				EnsureDBDeprovisionedFunc: func(_ string) (bool, error) {
+					return true, nil
+				},
+			}
+			r := &Runtime{
+				config:            &config.Config{RuntimeGCDryRun: tc.dryRun},
+				client:            fleetManagerClient.Client(),
+				k8sClient:         fakeClient,
+				dbProvisionClient: dbClient,
+				reconcilerOpts:    centralReconciler.CentralReconcilerOptions{ManagedDBEnabled: true},
+			}
+
+			r.deleteOrphan(context.TODO(), *tenantNamespace("a"))
+
+			namespaces, err := centralReconciler.ListTenantNamespaces(context.TODO(), fakeClient)
+			require.NoError(t, err)
+			if tc.wantDeleted {
+				assert.Empty(t, namespaces)
+			} else {
+				assert.Equal(t, []string{"rhacs-a"}, namespaceNames(namespaces))
+			}
+			if tc.wantDeprovisioned {
+				require.Len(t, dbClient.EnsureDBDeprovisionedCalls(), 1)
+				assert.Equal(t, "a", dbClient.EnsureDBDeprovisionedCalls()[0].DatabaseID)
+			} else {
+				assert.Empty(t, dbClient.EnsureDBDeprovisionedCalls())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package runtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// centralQueue is a rate limited work queue of centrals keyed by the central ID. The underlying work queue guarantees
+// that a central is never reconciled by two workers at the same time. A central which is added multiple times before
+// a worker picks it up is reconciled once with its latest state.
+type centralQueue struct {
+	queue       workqueue.DelayingInterface
+	rateLimiter workqueue.RateLimiter
+
+	mu       sync.Mutex
+	centrals map[string]private.ManagedCentral
+	queuedAt map[string]time.Time
+}
+
+func newCentralQueue(baseDelay, maxDelay time.Duration, qps float64, burst int) *centralQueue {
+	return &centralQueue{
+		queue: workqueue.NewNamedDelayingQueue("centrals"),
+		rateLimiter: workqueue.NewMaxOfRateLimiter(
+			workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(qps), burst)},
+		),
+		centrals: make(map[string]private.ManagedCentral),
+		queuedAt: make(map[string]time.Time),
+	}
+}
+
+// Add stores the latest state of the central and queues it. A central waiting to be requeued after a failed
+// reconciliation is not queued again, so that the exponential backoff is not bypassed.
+func (q *centralQueue) Add(central private.ManagedCentral) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.centrals[central.Id] = central
+	if q.rateLimiter.NumRequeues(central.Id) > 0 {
+		return
+	}
+	if _, ok := q.queuedAt[central.Id]; !ok {
+		q.queuedAt[central.Id] = time.Now()
+	}
+	q.queue.Add(central.Id)
+	fleetshardmetrics.MetricsInstance().SetCentralQueueDepth(float64(q.queue.Len()))
+}
+
+// Get blocks until a central is available and returns its latest state. It returns false once the queue is shut
+// down. Every central returned by Get must be passed to Done after it was reconciled.
+func (q *centralQueue) Get() (private.ManagedCentral, bool) {
+	for {
+		item, shutdown := q.queue.Get()
+		if shutdown {
+			return private.ManagedCentral{}, false
+		}
+		id := item.(string)
+
+		q.mu.Lock()
+		central, ok := q.centrals[id]
+		if queuedAt, queued := q.queuedAt[id]; queued {
+			fleetshardmetrics.MetricsInstance().ObserveCentralQueueWaitSeconds(time.Since(queuedAt).Seconds())
+			delete(q.queuedAt, id)
+		}
+		q.mu.Unlock()
+		fleetshardmetrics.MetricsInstance().SetCentralQueueDepth(float64(q.queue.Len()))
+
+		if ok {
+			return central, true
+		}
+		// the central was removed while it was queued
+		q.queue.Done(id)
+	}
+}
+
+// Done marks the reconciliation of the central as finished. If requeue is true the central is queued again with an
+// exponential backoff, otherwise its backoff is reset.
+func (q *centralQueue) Done(id string, requeue bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Done(id)
+	if _, ok := q.centrals[id]; !ok || !requeue {
+		q.rateLimiter.Forget(id)
+		return
+	}
+
+	delay := q.rateLimiter.When(id)
+	if _, ok := q.queuedAt[id]; !ok {
+		q.queuedAt[id] = time.Now().Add(delay)
+	}
+	q.queue.AddAfter(id, delay)
+	fleetshardmetrics.MetricsInstance().IncCentralReconcilationRequeues()
+}
+
+// Retain removes all centrals from the queue which are not contained in the given central IDs.
+func (q *centralQueue) Retain(centralIds map[string]struct{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id := range q.centrals {
+		if _, ok := centralIds[id]; !ok {
+			delete(q.centrals, id)
+			delete(q.queuedAt, id)
+			q.rateLimiter.Forget(id)
+		}
+	}
+}
+
+// ShutDown stops the queue. Workers blocked in Get return immediately.
+func (q *centralQueue) ShutDown() {
+	q.queue.ShutDown()
+}
@@ -0,0 +1,61 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralQueueReconcilesLatestState(t *testing.T) {
+	q := newCentralQueue(time.Millisecond, time.Second, 100, 100)
+	defer q.ShutDown()
+
+	q.Add(private.ManagedCentral{Id: "a", RequestStatus: "provisioning"})
+	q.Add(private.ManagedCentral{Id: "a", RequestStatus: "ready"})
+	require.Equal(t, 1, q.queue.Len())
+
+	central, ok := q.Get()
+	require.True(t, ok)
+	assert.Equal(t, "ready", central.RequestStatus)
+	q.Done(central.Id, false)
+	assert.Equal(t, 0, q.queue.Len())
+}
+
+func TestCentralQueueRequeuesWithBackoff(t *testing.T) {
+	q := newCentralQueue(10*time.Millisecond, time.Second, 100, 100)
+	defer q.ShutDown()
+
+	q.Add(private.ManagedCentral{Id: "a"})
+	central, ok := q.Get()
+	require.True(t, ok)
+	q.Done(central.Id, true)
+	assert.Equal(t, 1, q.rateLimiter.NumRequeues("a"))
+
+	// adding a central waiting for its requeue must not bypass the backoff
+	q.Add(private.ManagedCentral{Id: "a", RequestStatus: "ready"})
+	assert.Equal(t, 0, q.queue.Len())
+
+	central, ok = q.Get()
+	require.True(t, ok)
+	assert.Equal(t, "ready", central.RequestStatus)
+	q.Done(central.Id, false)
+	assert.Equal(t, 0, q.rateLimiter.NumRequeues("a"))
+}
+
+func TestCentralQueueRetain(t *testing.T) {
+	q := newCentralQueue(time.Millisecond, time.Second, 100, 100)
+	defer q.ShutDown()
+
+	q.Add(private.ManagedCentral{Id: "a"})
+	q.Add(private.ManagedCentral{Id: "b"})
+	q.Retain(map[string]struct{}{"b": {}})
+
+	central, ok := q.Get()
+	require.True(t, ok)
+	assert.Equal(t, "b", central.Id)
+	q.Done(central.Id, false)
+	assert.NotContains(t, q.centrals, "a")
+}
@@ -4,16 +4,20 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/antihax/optional"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/awsclient"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/localdb"
 	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 	"github.com/stackrox/rox/pkg/concurrency"
@@ -39,10 +43,24 @@ type Runtime struct {
 	config            *config.Config
 	client            *fleetmanager.Client
 	clusterID         string
+	reconcilersMu     sync.Mutex
 	reconcilers       reconcilerRegistry
+	queue             *centralQueue
+	reconcilerOpts    centralReconciler.CentralReconcilerOptions
 	k8sClient         ctrlClient.Client
 	dbProvisionClient cloudprovider.DBClient
-	statusResponseCh  chan private.DataPlaneCentralStatus
+	statusReporter    *statusReporter
+
+	// centrals caches the last known state of the centrals received by watching fleet-manager.
+	centrals map[string]private.ManagedCentral
+	// resourceVersion is the resource version of the cached centrals. It is empty if a full resync is required.
+	resourceVersion    string
+	lastResync         time.Time
+	watchDisabledUntil time.Time
+
+	// orphanedNamespaces maps the names of orphaned tenant namespaces to the time they were first found orphaned by the
+	// garbage collection.
+	orphanedNamespaces map[string]time.Time
 }
 
 // NewRuntime creates a new runtime
@@ -72,12 +90,19 @@ func NewRuntime(config *config.Config, k8sClient ctrlClient.Client) (*Runtime, e
 	}
 	var dbProvisionClient cloudprovider.DBClient
 	if config.ManagedDB.Enabled {
-		dbProvisionClient, err = awsclient.NewRDSClient(config, auth)
+		dbProvisionClient, err = newDBProvisionClient(config, auth, k8sClient)
 		if err != nil {
 			return nil, fmt.Errorf("creating managed DB provisioning client: %v", err)
 		}
 	}
 
+	sendStatuses := func(ctx context.Context, statuses map[string]private.DataPlaneCentralStatus) error {
+		if _, err := client.PrivateAPI().UpdateCentralClusterStatus(ctx, config.ClusterID, statuses); err != nil {
+			return errors.Wrap(err, "updating central statuses")
+		}
+		return nil
+	}
+
 	return &Runtime{
 		config:            config,
 		k8sClient:         k8sClient,
@@ -85,11 +110,33 @@ func NewRuntime(config *config.Config, k8sClient ctrlClient.Client) (*Runtime, e
 		clusterID:         config.ClusterID,
 		dbProvisionClient: dbProvisionClient,
 		reconcilers:       make(reconcilerRegistry),
+		statusReporter:    newStatusReporter(sendStatuses, config.RuntimeStatusFlushPeriod, config.RuntimeStatusMaxBackoff),
+		queue:             newCentralQueue(config.RuntimeRequeueBaseDelay, config.RuntimeRequeueMaxDelay, config.RuntimeRequeueQPS, config.RuntimeRequeueBurst),
+		centrals:          make(map[string]private.ManagedCentral),
+
+		orphanedNamespaces: make(map[string]time.Time),
 	}, nil
 }
 
+// newDBProvisionClient creates the managed DB provisioning client of the configured provider
+func newDBProvisionClient(cfg *config.Config, auth fleetmanager.Auth, k8sClient ctrlClient.Client) (cloudprovider.DBClient, error) {
+	switch cfg.ManagedDB.Provider {
+	case config.ManagedDBProviderAWS:
+		return awsclient.NewRDSClient(cfg, auth)
+	case config.ManagedDBProviderLocal:
+		if cfg.ManagedDB.Local.Host != "" {
+			return localdb.NewServer(cfg.ManagedDB.Local)
+		}
+		return localdb.NewDeployment(k8sClient, cfg.ManagedDB.Local.Namespace, cfg.ManagedDB.Local.Image), nil
+	default:
+		return nil, errors.Errorf("unsupported managed DB provider %q", cfg.ManagedDB.Provider)
+	}
+}
+
 // Stop stops the runtime
 func (r *Runtime) Stop() {
+	r.queue.ShutDown()
+	r.statusReporter.Stop()
 }
 
 // Start starts the fleetshard runtime and schedules
@@ -99,94 +146,228 @@ func (r *Runtime) Start() error {
 
 	routesAvailable := r.routesAvailable()
 
-	reconcilerOpts := centralReconciler.CentralReconcilerOptions{
-		UseRoutes:         routesAvailable,
-		WantsAuthProvider: r.config.CreateAuthProvider,
-		EgressProxyImage:  r.config.EgressProxyImage,
-		ManagedDBEnabled:  r.config.ManagedDB.Enabled,
-		Telemetry:         r.config.Telemetry,
+	r.reconcilerOpts = centralReconciler.CentralReconcilerOptions{
+		UseRoutes:               routesAvailable,
+		WantsAuthProvider:       r.config.CreateAuthProvider,
+		EgressProxyImage:        r.config.EgressProxyImage,
+		EgressProxyClusterCIDRs: r.config.EgressProxyClusterCIDRs,
+		ManagedDBEnabled:        r.config.ManagedDB.Enabled,
+		Telemetry:               r.config.Telemetry,
+		DriftCheckPeriod:        r.config.RuntimeDriftCheckPeriod,
+		RevertDrift:             r.config.RuntimeDriftRevert,
+		HealthCheckPeriod:       r.config.RuntimeHealthCheckPeriod,
 	}
 
-	ticker := concurrency.NewRetryTicker(func(ctx context.Context) (timeToNextTick time.Duration, err error) {
-		list, _, err := r.client.PrivateAPI().GetCentrals(ctx, r.clusterID)
-		if err != nil {
-			err = errors.Wrapf(err, "retrieving list of managed centrals")
-			glog.Error(err)
-			return 0, err
+	r.statusReporter.Start()
+	glog.Infof("Starting %d central reconcile workers", r.config.RuntimeWorkers)
+	for i := 0; i < r.config.RuntimeWorkers; i++ {
+		go r.runWorker()
+	}
+
+	ticker := concurrency.NewRetryTicker(r.sync, 10*time.Minute, backoff)
+
+	err := ticker.Start()
+	if err != nil {
+		return fmt.Errorf("starting ticker: %w", err)
+	}
+
+	if r.config.RuntimeGCEnabled {
+		glog.Infof("Starting garbage collection of orphaned tenant namespaces (dry run: %t)", r.config.RuntimeGCDryRun)
+		gcTicker := concurrency.NewRetryTicker(r.collectGarbage, r.config.RuntimeGCPeriod, backoff)
+		if err := gcTicker.Start(); err != nil {
+			return fmt.Errorf("starting garbage collection ticker: %w", err)
 		}
+	}
 
-		// Start for each Central its own reconciler which can be triggered by sending a central to the receive channel.
-		glog.Infof("Received %d centrals", len(list.Items))
-		for _, central := range list.Items {
-			if _, ok := r.reconcilers[central.Id]; !ok {
-				r.reconcilers[central.Id] = centralReconciler.NewCentralReconciler(r.k8sClient, central, r.dbProvisionClient, reconcilerOpts)
-			}
-
-			reconciler := r.reconcilers[central.Id]
-			go func(reconciler *centralReconciler.CentralReconciler, central private.ManagedCentral) {
-				fleetshardmetrics.MetricsInstance().IncActiveCentralReconcilations()
-				defer fleetshardmetrics.MetricsInstance().DecActiveCentralReconcilations()
-
-				// a 15 minutes timeout should cover the duration of a Reconcile call, including the provisioning of an RDS database
-				ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
-				defer cancel()
-
-				glog.Infof("Start reconcile central %s/%s", central.Metadata.Namespace, central.Metadata.Name)
-				status, err := reconciler.Reconcile(ctx, central)
-				fleetshardmetrics.MetricsInstance().IncCentralReconcilations()
-				r.handleReconcileResult(central, status, err)
-			}(reconciler, central)
+	return nil
+}
+
+// sync watches fleet-manager for changed centrals and falls back to polling the full list of centrals if watching
+// is disabled or fails.
+func (r *Runtime) sync(ctx context.Context) (timeToNextTick time.Duration, err error) {
+	if r.config.RuntimeWatchEnabled && time.Now().After(r.watchDisabledUntil) {
+		err := r.watch(ctx)
+		if err == nil {
+			return 0, nil
 		}
-		fleetshardmetrics.MetricsInstance().SetTotalCentrals(float64(len(r.reconcilers)))
+		glog.Errorf("Watching centrals failed, falling back to polling for %s: %v", r.config.RuntimeResyncPeriod, err)
+		r.watchDisabledUntil = time.Now().Add(r.config.RuntimeResyncPeriod)
+		r.resourceVersion = ""
+	}
+	return r.poll(ctx)
+}
 
-		r.deleteStaleReconcilers(&list)
-		return r.config.RuntimePollPeriod, nil
-	}, 10*time.Minute, backoff)
+func (r *Runtime) poll(ctx context.Context) (time.Duration, error) {
+	list, _, err := r.client.PrivateAPI().GetCentrals(ctx, r.clusterID)
+	if err != nil {
+		err = errors.Wrapf(err, "retrieving list of managed centrals")
+		glog.Error(err)
+		return 0, err
+	}
 
-	err := ticker.Start()
+	glog.Infof("Received %d centrals", len(list.Items))
+	centralIds := map[string]struct{}{}
+	for _, central := range list.Items {
+		centralIds[central.Id] = struct{}{}
+	}
+	r.reconcileCentrals(list.Items)
+	r.deleteStaleReconcilers(centralIds)
+	return r.config.RuntimePollPeriod, nil
+}
+
+// watch blocks until fleet-manager returns the changed centrals or the watch timeout expires. While centrals are not
+// ready, the watch timeout is reduced to the poll period so that these centrals keep being reconciled.
+func (r *Runtime) watch(ctx context.Context) error {
+	if time.Since(r.lastResync) >= r.config.RuntimeResyncPeriod {
+		r.resourceVersion = ""
+	}
+	timeout := r.config.RuntimeWatchTimeout
+	if r.hasCentralsInProgress() {
+		timeout = r.config.RuntimePollPeriod
+	}
+	opts := &private.WatchCentralsOpts{
+		TimeoutSeconds: optional.NewInt32(int32(timeout.Seconds())),
+	}
+	if r.resourceVersion != "" {
+		opts.ResourceVersion = optional.NewString(r.resourceVersion)
+	}
+
+	list, _, err := r.client.PrivateAPI().WatchCentrals(ctx, r.clusterID, opts)
 	if err != nil {
-		return fmt.Errorf("starting ticker: %w", err)
+		return errors.Wrapf(err, "watching managed centrals")
 	}
 
+	glog.V(10).Infof("Received %d changed centrals", len(list.Items))
+	centrals, centralIds := r.applyWatchList(list)
+	r.reconcileCentrals(centrals)
+	r.deleteStaleReconcilers(centralIds)
 	return nil
 }
 
-func (r *Runtime) handleReconcileResult(central private.ManagedCentral, status *private.DataPlaneCentralStatus, err error) {
+// applyWatchList updates the cached centrals with the watch result and returns the centrals to reconcile and the IDs
+// of all centrals of the cluster. After a full resync all centrals are reconciled. Otherwise the changed centrals and
+// the centrals which are still in progress are reconciled.
+func (r *Runtime) applyWatchList(list private.ManagedCentralWatchList) ([]private.ManagedCentral, map[string]struct{}) {
+	fullResync := r.resourceVersion == ""
+	if fullResync {
+		r.lastResync = time.Now()
+	}
+	r.resourceVersion = list.ResourceVersion
+
+	centralIds := map[string]struct{}{}
+	for _, id := range list.CentralIds {
+		centralIds[id] = struct{}{}
+	}
+	changed := map[string]struct{}{}
+	for _, central := range list.Items {
+		r.centrals[central.Id] = central
+		changed[central.Id] = struct{}{}
+	}
+	for id := range r.centrals {
+		if _, ok := centralIds[id]; !ok {
+			delete(r.centrals, id)
+		}
+	}
+
+	var centrals []private.ManagedCentral
+	for id, central := range r.centrals {
+		if _, ok := changed[id]; ok || fullResync || isCentralInProgress(central) {
+			centrals = append(centrals, central)
+		}
+	}
+	return centrals, centralIds
+}
+
+func (r *Runtime) hasCentralsInProgress() bool {
+	for _, central := range r.centrals {
+		if isCentralInProgress(central) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCentralInProgress returns true if a central is neither ready nor suspended yet or a backup or restore of its
+// managed DB was requested. Such centrals are reconciled on every poll until they report their final status.
+func isCentralInProgress(central private.ManagedCentral) bool {
+	return (central.RequestStatus != centralConstants.CentralRequestStatusReady.String() &&
+		central.RequestStatus != centralConstants.CentralRequestStatusSuspended.String()) ||
+		central.Spec.Central.Db.BackupId != "" || central.Spec.Central.Db.RestoreId != ""
+}
+
+// reconcileCentrals queues the given centrals to be reconciled by the workers.
+func (r *Runtime) reconcileCentrals(centrals []private.ManagedCentral) {
+	for _, central := range centrals {
+		r.queue.Add(central)
+	}
+}
+
+// runWorker reconciles queued centrals until the queue is shut down.
+func (r *Runtime) runWorker() {
+	for {
+		central, ok := r.queue.Get()
+		if !ok {
+			return
+		}
+		requeue := r.reconcile(central)
+		r.queue.Done(central.Id, requeue)
+	}
+}
+
+// reconcile reconciles the given central and returns true if the reconciliation failed and should be retried.
+func (r *Runtime) reconcile(central private.ManagedCentral) bool {
+	r.reconcilersMu.Lock()
+	reconciler, ok := r.reconcilers[central.Id]
+	if !ok {
+		reconciler = centralReconciler.NewCentralReconciler(r.k8sClient, central, r.dbProvisionClient, r.reconcilerOpts)
+		r.reconcilers[central.Id] = reconciler
+	}
+	r.reconcilersMu.Unlock()
+
+	fleetshardmetrics.MetricsInstance().IncActiveCentralReconcilations()
+	defer fleetshardmetrics.MetricsInstance().DecActiveCentralReconcilations()
+
+	// a 15 minutes timeout should cover the duration of a Reconcile call, including the provisioning of an RDS database
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	glog.Infof("Start reconcile central %s/%s", central.Metadata.Namespace, central.Metadata.Name)
+	status, err := reconciler.Reconcile(ctx, central)
+	fleetshardmetrics.MetricsInstance().IncCentralReconcilations()
+	return r.handleReconcileResult(central, status, err)
+}
+
+func (r *Runtime) handleReconcileResult(central private.ManagedCentral, status *private.DataPlaneCentralStatus, err error) bool {
 	if err != nil {
 		if centralReconciler.IsSkippable(err) {
 			glog.V(10).Infof("Skip sending the status for central %s/%s: %v", central.Metadata.Namespace, central.Metadata.Name, err)
-		} else {
-			fleetshardmetrics.MetricsInstance().IncCentralReconcilationErrors()
-			glog.Errorf("Unexpected error occurred %s/%s: %s", central.Metadata.Namespace, central.Metadata.Name, err.Error())
+			return false
 		}
-		return
+		fleetshardmetrics.MetricsInstance().IncCentralReconcilationErrors()
+		glog.Errorf("Unexpected error occurred %s/%s: %s", central.Metadata.Namespace, central.Metadata.Name, err.Error())
+		return true
 	}
 	if status == nil {
 		glog.Infof("No status update for Central %s/%s", central.Metadata.Namespace, central.Metadata.Name)
-		return
-	}
-	_, err = r.client.PrivateAPI().UpdateCentralClusterStatus(context.TODO(), r.clusterID, map[string]private.DataPlaneCentralStatus{
-		central.Id: *status,
-	})
-	if err != nil {
-		err = errors.Wrapf(err, "updating status for Central %s/%s", central.Metadata.Namespace, central.Metadata.Name)
-		glog.Error(err)
+		return false
 	}
+	r.statusReporter.Report(central.Id, *status)
+	return false
 }
 
-func (r *Runtime) deleteStaleReconcilers(list *private.ManagedCentralList) {
-	// This map collects all central ids in the current list, it is later used to find and delete all reconcilers of
-	// centrals that are no longer in the GetManagedCentralList
-	centralIds := map[string]struct{}{}
-	for _, central := range list.Items {
-		centralIds[central.Id] = struct{}{}
-	}
+func (r *Runtime) deleteStaleReconcilers(centralIds map[string]struct{}) {
+	r.queue.Retain(centralIds)
 
+	r.reconcilersMu.Lock()
+	defer r.reconcilersMu.Unlock()
+	// centralIds contains all central ids of the cluster, it is used to find and delete all reconcilers of
+	// centrals that are no longer assigned to the cluster
 	for key := range r.reconcilers {
 		if _, hasKey := centralIds[key]; !hasKey {
 			delete(r.reconcilers, key)
 		}
 	}
+	fleetshardmetrics.MetricsInstance().SetTotalCentrals(float64(len(r.reconcilers)))
 }
 
 func (r *Runtime) routesAvailable() bool {
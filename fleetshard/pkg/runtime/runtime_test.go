@@ -1 +1,111 @@
 package runtime
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func managedCentral(id string, status centralConstants.CentralStatus) private.ManagedCentral {
+	return private.ManagedCentral{Id: id, RequestStatus: status.String()}
+}
+
+func withDBBackupID(central private.ManagedCentral, backupID string) private.ManagedCentral {
+	central.Spec.Central.Db.BackupId = backupID
+	return central
+}
+
+func TestApplyWatchList(t *testing.T) {
+	ready := centralConstants.CentralRequestStatusReady
+	provisioning := centralConstants.CentralRequestStatusProvisioning
+
+	tests := []struct {
+		name            string
+		cached          []private.ManagedCentral
+		resourceVersion string
+		list            private.ManagedCentralWatchList
+		wantReconciled  []string
+		wantCached      []string
+	}{
+		{
+			name:   "full resync reconciles all centrals",
+			cached: []private.ManagedCentral{managedCentral("a", ready)},
+			list: private.ManagedCentralWatchList{
+				ResourceVersion: "2-abc",
+				Items:           []private.ManagedCentral{managedCentral("a", ready), managedCentral("b", ready)},
+				CentralIds:      []string{"a", "b"},
+			},
+			wantReconciled: []string{"a", "b"},
+			wantCached:     []string{"a", "b"},
+		},
+		{
+			name:            "incremental update reconciles changed and unready centrals",
+			cached:          []private.ManagedCentral{managedCentral("a", ready), managedCentral("b", provisioning), managedCentral("c", ready)},
+			resourceVersion: "1-abc",
+			list: private.ManagedCentralWatchList{
+				ResourceVersion: "2-abc",
+				Items:           []private.ManagedCentral{managedCentral("c", ready)},
+				CentralIds:      []string{"a", "b", "c"},
+			},
+			wantReconciled: []string{"b", "c"},
+			wantCached:     []string{"a", "b", "c"},
+		},
+		{
+			name:            "incremental update reconciles centrals with requested DB operations",
+			cached:          []private.ManagedCentral{managedCentral("a", ready), withDBBackupID(managedCentral("b", ready), "backup")},
+			resourceVersion: "1-abc",
+			list: private.ManagedCentralWatchList{
+				ResourceVersion: "2-abc",
+				CentralIds:      []string{"a", "b"},
+			},
+			wantReconciled: []string{"b"},
+			wantCached:     []string{"a", "b"},
+		},
+		{
+			name:            "removed centrals are dropped from the cache",
+			cached:          []private.ManagedCentral{managedCentral("a", ready), managedCentral("b", provisioning)},
+			resourceVersion: "1-abc",
+			list: private.ManagedCentralWatchList{
+				ResourceVersion: "2-def",
+				CentralIds:      []string{"a"},
+			},
+			wantCached: []string{"a"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Runtime{
+				config:          &config.Config{},
+				centrals:        map[string]private.ManagedCentral{},
+				resourceVersion: tc.resourceVersion,
+			}
+			for _, central := range tc.cached {
+				r.centrals[central.Id] = central
+			}
+
+			centrals, centralIds := r.applyWatchList(tc.list)
+
+			var reconciled []string
+			for _, central := range centrals {
+				reconciled = append(reconciled, central.Id)
+			}
+			sort.Strings(reconciled)
+			assert.Equal(t, tc.wantReconciled, reconciled)
+
+			var cached []string
+			for id := range r.centrals {
+				cached = append(cached, id)
+			}
+			sort.Strings(cached)
+			assert.Equal(t, tc.wantCached, cached)
+			require.Len(t, centralIds, len(tc.list.CentralIds))
+			assert.Equal(t, tc.list.ResourceVersion, r.resourceVersion)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+)
+
+type sendStatusesFunc func(ctx context.Context, statuses map[string]private.DataPlaneCentralStatus) error
+
+// statusReporter collects the statuses of reconciled centrals and sends them to fleet-manager in batches. Only the
+// latest status of a central is kept. If sending a batch fails, its statuses are kept unless a newer status was
+// reported in the meantime, and sending is retried with an exponential backoff.
+//
+// Reconcilers don't know the previous state of a central after fleetshard-sync was restarted, so the first
+// reconciliation of every central reports its status again and fleet-manager catches up with the data plane.
+type statusReporter struct {
+	send        sendStatusesFunc
+	flushPeriod time.Duration
+	maxBackoff  time.Duration
+
+	mu          sync.Mutex
+	pending     map[string]private.DataPlaneCentralStatus
+	failures    int
+	nextAttempt time.Time
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+func newStatusReporter(send sendStatusesFunc, flushPeriod, maxBackoff time.Duration) *statusReporter {
+	return &statusReporter{
+		send:        send,
+		flushPeriod: flushPeriod,
+		maxBackoff:  maxBackoff,
+		pending:     make(map[string]private.DataPlaneCentralStatus),
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// Report queues the status of a central to be sent with the next batch. It replaces any status of the same central
+// which was not sent yet.
+func (s *statusReporter) Report(centralID string, status private.DataPlaneCentralStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[centralID] = status
+}
+
+// Start sends the reported statuses every flush period until Stop is called.
+func (s *statusReporter) Start() {
+	go func() {
+		ticker := time.NewTicker(s.flushPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.flush(context.Background())
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops sending statuses periodically and makes a last attempt to send the pending statuses.
+func (s *statusReporter) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+
+		s.mu.Lock()
+		s.nextAttempt = time.Time{}
+		s.mu.Unlock()
+		ctx, cancel := context.WithTimeout(context.Background(), s.flushPeriod)
+		defer cancel()
+		s.flush(ctx)
+	})
+}
+
+// flush sends all pending statuses in a single request unless sending is backing off after a failure.
+func (s *statusReporter) flush(ctx context.Context) {
+	s.mu.Lock()
+	if len(s.pending) == 0 || time.Now().Before(s.nextAttempt) {
+		s.mu.Unlock()
+		return
+	}
+	batch := s.pending
+	s.pending = make(map[string]private.DataPlaneCentralStatus)
+	s.mu.Unlock()
+
+	err := s.send(ctx, batch)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		glog.V(10).Infof("Sent the statuses of %d centrals", len(batch))
+		s.failures = 0
+		s.nextAttempt = time.Time{}
+		return
+	}
+
+	for id, status := range batch {
+		if _, reported := s.pending[id]; !reported {
+			s.pending[id] = status
+		}
+	}
+	s.failures++
+	backoff := s.backoff()
+	s.nextAttempt = time.Now().Add(backoff)
+	glog.Errorf("Sending the statuses of %d centrals failed, retrying in %s: %v", len(batch), backoff, err)
+}
+
+func (s *statusReporter) backoff() time.Duration {
+	backoff := s.flushPeriod
+	for i := 1; i < s.failures && backoff < s.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > s.maxBackoff {
+		return s.maxBackoff
+	}
+	return backoff
+}
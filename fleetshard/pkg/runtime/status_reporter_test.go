@@ -0,0 +1,80 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readyCentralStatus(reason string) private.DataPlaneCentralStatus {
+	return private.DataPlaneCentralStatus{
+		Conditions: []private.DataPlaneClusterUpdateStatusRequestConditions{{Type: "Ready", Status: "True", Reason: reason}},
+	}
+}
+
+func TestStatusReporterSendsLatestStatusesInOneBatch(t *testing.T) {
+	var batches []map[string]private.DataPlaneCentralStatus
+	reporter := newStatusReporter(func(ctx context.Context, statuses map[string]private.DataPlaneCentralStatus) error {
+		batches = append(batches, statuses)
+		return nil
+	}, time.Second, time.Minute)
+
+	reporter.Report("a", readyCentralStatus("first"))
+	reporter.Report("b", readyCentralStatus("first"))
+	reporter.Report("a", readyCentralStatus("second"))
+	reporter.flush(context.Background())
+
+	require.Len(t, batches, 1)
+	assert.Equal(t, map[string]private.DataPlaneCentralStatus{
+		"a": readyCentralStatus("second"),
+		"b": readyCentralStatus("first"),
+	}, batches[0])
+
+	reporter.flush(context.Background())
+	assert.Len(t, batches, 1, "expected no request without pending statuses")
+}
+
+func TestStatusReporterRetriesFailedBatch(t *testing.T) {
+	sendErr := errors.New("unavailable")
+	var batches []map[string]private.DataPlaneCentralStatus
+	reporter := newStatusReporter(func(ctx context.Context, statuses map[string]private.DataPlaneCentralStatus) error {
+		batches = append(batches, statuses)
+		return sendErr
+	}, time.Second, time.Minute)
+
+	reporter.Report("a", readyCentralStatus("first"))
+	reporter.Report("b", readyCentralStatus("first"))
+	reporter.flush(context.Background())
+	require.Len(t, batches, 1)
+	assert.True(t, reporter.nextAttempt.After(time.Now()))
+
+	// a flush during the backoff does not send the statuses
+	reporter.Report("a", readyCentralStatus("second"))
+	reporter.flush(context.Background())
+	require.Len(t, batches, 1)
+
+	sendErr = nil
+	reporter.nextAttempt = time.Time{}
+	reporter.flush(context.Background())
+	require.Len(t, batches, 2)
+	assert.Equal(t, map[string]private.DataPlaneCentralStatus{
+		"a": readyCentralStatus("second"),
+		"b": readyCentralStatus("first"),
+	}, batches[1])
+	assert.Equal(t, 0, reporter.failures)
+}
+
+func TestStatusReporterBackoff(t *testing.T) {
+	reporter := newStatusReporter(nil, time.Second, 5*time.Second)
+
+	var backoffs []time.Duration
+	for reporter.failures = 1; reporter.failures <= 5; reporter.failures++ {
+		backoffs = append(backoffs, reporter.backoff())
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, backoffs)
+}
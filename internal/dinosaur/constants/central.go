@@ -11,6 +11,27 @@ type CentralStatus string
 // CentralOperation type
 type CentralOperation string
 
+// CentralMigrationStatus type
+type CentralMigrationStatus string
+
+// CentralDBOperationStatus is the status of a backup or a restore of the managed database of a central
+type CentralDBOperationStatus string
+
+// CentralUpgradeRolloutStatus is the status of a rollout of a new version across the fleet
+type CentralUpgradeRolloutStatus string
+
+// CentralUpgradeStatus is the status of the upgrade of a central by a rollout
+type CentralUpgradeStatus string
+
+// CentralEventType is the kind of change recorded in the event history of a central
+type CentralEventType string
+
+// CentralWebhookDeliveryStatus is the status of the delivery of a central event to a webhook endpoint
+type CentralWebhookDeliveryStatus string
+
+// CentralHealthConditionType is the kind of health condition of a central reported by its data plane cluster
+type CentralHealthConditionType string
+
 // CentralRequestStatusAccepted ...
 const (
 	// CentralRequestStatusAccepted - central request status when accepted by central worker
@@ -27,12 +48,115 @@ const (
 	CentralRequestStatusDeprovision CentralStatus = "deprovision"
 	// CentralRequestStatusDeleting - external resources are being deleted for the central request
 	CentralRequestStatusDeleting CentralStatus = "deleting"
+	// CentralRequestStatusSuspending - central is being scaled down to zero by the data plane cluster
+	CentralRequestStatusSuspending CentralStatus = "suspending"
+	// CentralRequestStatusSuspended - central is scaled down to zero, its namespace, volumes and database are kept
+	CentralRequestStatusSuspended CentralStatus = "suspended"
+	// CentralRequestStatusResuming - central is being scaled up again by the data plane cluster
+	CentralRequestStatusResuming CentralStatus = "resuming"
 	// CentralOperationCreate - Central cluster create operations
 	CentralOperationCreate CentralOperation = "create"
 	// CentralOperationDelete = Central cluster delete operations
 	CentralOperationDelete CentralOperation = "delete"
 	// CentralOperationDeprovision = Central cluster deprovision operations
 	CentralOperationDeprovision CentralOperation = "deprovision"
+	// CentralOperationMigrate = Central cluster migrate operations
+	CentralOperationMigrate CentralOperation = "migrate"
+	// CentralOperationBackup = Central managed database backup operations
+	CentralOperationBackup CentralOperation = "backup"
+	// CentralOperationRestore = Central managed database restore operations
+	CentralOperationRestore CentralOperation = "restore"
+	// CentralOperationUpgrade = Central version upgrade operations
+	CentralOperationUpgrade CentralOperation = "upgrade"
+	// CentralOperationSuspend = Central suspend operations
+	CentralOperationSuspend CentralOperation = "suspend"
+	// CentralOperationResume = Central resume operations
+	CentralOperationResume CentralOperation = "resume"
+	// CentralOperationExtendLifespan = Central lifespan extension operations
+	CentralOperationExtendLifespan CentralOperation = "extend_lifespan"
+
+	// CentralMigrationStatusScalingDownSource - central is being scaled down on the migration source cluster, so that
+	// only one cluster runs it against its managed DB at a time
+	CentralMigrationStatusScalingDownSource CentralMigrationStatus = "scaling_down_source"
+	// CentralMigrationStatusProvisioning - central is being provisioned on the migration target cluster
+	CentralMigrationStatusProvisioning CentralMigrationStatus = "provisioning"
+	// CentralMigrationStatusSwitchingDNS - central routes are being switched to the migration target cluster
+	CentralMigrationStatusSwitchingDNS CentralMigrationStatus = "switching_dns"
+	// CentralMigrationStatusDeprovisioningSource - central is being removed from the migration source cluster
+	CentralMigrationStatusDeprovisioningSource CentralMigrationStatus = "deprovisioning_source"
+	// CentralMigrationStatusDeprovisioningTarget - central is being removed from the migration target cluster after a failed migration
+	CentralMigrationStatusDeprovisioningTarget CentralMigrationStatus = "deprovisioning_target"
+	// CentralMigrationStatusCompleted - central has been migrated to the target cluster
+	CentralMigrationStatusCompleted CentralMigrationStatus = "completed"
+	// CentralMigrationStatusFailed - central could not be migrated and is still served by the source cluster
+	CentralMigrationStatusFailed CentralMigrationStatus = "failed"
+
+	// CentralDBOperationStatusPending - the operation has been requested and not been reported by the data plane yet
+	CentralDBOperationStatusPending CentralDBOperationStatus = "pending"
+	// CentralDBOperationStatusInProgress - the data plane is performing the operation
+	CentralDBOperationStatusInProgress CentralDBOperationStatus = "in_progress"
+	// CentralDBOperationStatusCompleted - the operation completed successfully
+	CentralDBOperationStatusCompleted CentralDBOperationStatus = "completed"
+	// CentralDBOperationStatusFailed - the operation failed
+	CentralDBOperationStatusFailed CentralDBOperationStatus = "failed"
+
+	// CentralUpgradeRolloutStatusRunning - the rollout upgrades centrals wave by wave
+	CentralUpgradeRolloutStatusRunning CentralUpgradeRolloutStatus = "running"
+	// CentralUpgradeRolloutStatusPaused - the rollout was paused by an admin or because too many upgrades failed
+	CentralUpgradeRolloutStatusPaused CentralUpgradeRolloutStatus = "paused"
+	// CentralUpgradeRolloutStatusCompleted - all waves of the rollout have been upgraded
+	CentralUpgradeRolloutStatusCompleted CentralUpgradeRolloutStatus = "completed"
+	// CentralUpgradeRolloutStatusAborted - the rollout was aborted by an admin
+	CentralUpgradeRolloutStatusAborted CentralUpgradeRolloutStatus = "aborted"
+
+	// CentralUpgradeStatusUpgrading - the desired versions of the central have been set by a rollout
+	CentralUpgradeStatusUpgrading CentralUpgradeStatus = "upgrading"
+	// CentralUpgradeStatusSucceeded - the central is ready with the desired versions
+	CentralUpgradeStatusSucceeded CentralUpgradeStatus = "succeeded"
+	// CentralUpgradeStatusFailed - the central failed or did not become ready with the desired versions in time
+	CentralUpgradeStatusFailed CentralUpgradeStatus = "failed"
+
+	// CentralEventTypeStatusChange - the status of the central changed
+	CentralEventTypeStatusChange CentralEventType = "status_change"
+	// CentralEventTypePlacement - the central was placed on a data plane cluster
+	CentralEventTypePlacement CentralEventType = "placement"
+	// CentralEventTypeFailure - the central changed to failed status
+	CentralEventTypeFailure CentralEventType = "failure"
+	// CentralEventTypeDeletion - the central was deleted after all of its resources had been cleaned up
+	CentralEventTypeDeletion CentralEventType = "deletion"
+	// CentralEventTypeExpiryWarning - the central is about to expire and will be deprovisioned
+	CentralEventTypeExpiryWarning CentralEventType = "expiry_warning"
+	// CentralEventTypeLifespanExtension - the expiration of the central was postponed
+	CentralEventTypeLifespanExtension CentralEventType = "lifespan_extension"
+
+	// CentralEventActorFleetManager - the actor of events caused by the workers of fleet-manager
+	CentralEventActorFleetManager = "fleet-manager"
+	// CentralEventActorDataPlane - the actor of events caused by the status reported by the data plane cluster
+	CentralEventActorDataPlane = "fleetshard-sync"
+
+	// CentralWebhookDeliveryStatusPending - the event has not been delivered yet and is retried
+	CentralWebhookDeliveryStatusPending CentralWebhookDeliveryStatus = "pending"
+	// CentralWebhookDeliveryStatusDelivered - the endpoint accepted the event
+	CentralWebhookDeliveryStatusDelivered CentralWebhookDeliveryStatus = "delivered"
+	// CentralWebhookDeliveryStatusFailed - the event could not be delivered within the maximum number of attempts
+	CentralWebhookDeliveryStatusFailed CentralWebhookDeliveryStatus = "failed"
+
+	// CentralHealthConditionCentralReady - all replicas of the Central deployment are available
+	CentralHealthConditionCentralReady CentralHealthConditionType = "CentralReady"
+	// CentralHealthConditionScannerReady - all replicas of the Scanner deployment are available
+	CentralHealthConditionScannerReady CentralHealthConditionType = "ScannerReady"
+	// CentralHealthConditionScannerDBReady - all replicas of the Scanner DB deployment are available
+	CentralHealthConditionScannerDBReady CentralHealthConditionType = "ScannerDBReady"
+	// CentralHealthConditionEgressProxyReady - all replicas of the egress proxy deployment are available
+	CentralHealthConditionEgressProxyReady CentralHealthConditionType = "EgressProxyReady"
+	// CentralHealthConditionPodsHealthy - no container of the central is crash looping, the message lists the restarts
+	CentralHealthConditionPodsHealthy CentralHealthConditionType = "PodsHealthy"
+	// CentralHealthConditionStorageReady - all persistent volume claims of the central are bound
+	CentralHealthConditionStorageReady CentralHealthConditionType = "StorageReady"
+	// CentralHealthConditionManagedDBReady - the managed database of the central is available
+	CentralHealthConditionManagedDBReady CentralHealthConditionType = "ManagedDBReady"
+	// CentralHealthConditionDrifted - the resources of the central on the cluster drifted from their desired state
+	CentralHealthConditionDrifted CentralHealthConditionType = "Drifted"
 
 	// ObservabilityCanaryPodLabelKey that will be used by the observability operator to scrap metrics
 	ObservabilityCanaryPodLabelKey = "managed-central-canary"
@@ -55,6 +179,9 @@ var ordinals = map[string]int{
 	CentralRequestStatusPreparing.String():    10,
 	CentralRequestStatusProvisioning.String(): 20,
 	CentralRequestStatusReady.String():        30,
+	CentralRequestStatusSuspending.String():   31,
+	CentralRequestStatusSuspended.String():    32,
+	CentralRequestStatusResuming.String():     33,
 	CentralRequestStatusDeprovision.String():  40,
 	CentralRequestStatusDeleting.String():     50,
 	CentralRequestStatusFailed.String():       500,
@@ -76,6 +203,63 @@ func (k CentralStatus) String() string {
 	return string(k)
 }
 
+// String ...
+func (k CentralMigrationStatus) String() string {
+	return string(k)
+}
+
+// String ...
+func (k CentralDBOperationStatus) String() string {
+	return string(k)
+}
+
+// IsActive returns true if the operation has not completed or failed yet
+func (k CentralDBOperationStatus) IsActive() bool {
+	return k == CentralDBOperationStatusPending || k == CentralDBOperationStatusInProgress
+}
+
+// String ...
+func (k CentralUpgradeRolloutStatus) String() string {
+	return string(k)
+}
+
+// IsActive returns true if the rollout has not completed or been aborted
+func (k CentralUpgradeRolloutStatus) IsActive() bool {
+	return k == CentralUpgradeRolloutStatusRunning || k == CentralUpgradeRolloutStatusPaused
+}
+
+// String ...
+func (k CentralUpgradeStatus) String() string {
+	return string(k)
+}
+
+// String ...
+func (k CentralEventType) String() string {
+	return string(k)
+}
+
+// String ...
+func (k CentralWebhookDeliveryStatus) String() string {
+	return string(k)
+}
+
+// String ...
+func (k CentralHealthConditionType) String() string {
+	return string(k)
+}
+
+// IsCentralHealthConditionType returns true if the given condition type reported by a data plane cluster describes the
+// health of a central
+func IsCentralHealthConditionType(conditionType string) bool {
+	switch CentralHealthConditionType(conditionType) {
+	case CentralHealthConditionCentralReady, CentralHealthConditionScannerReady, CentralHealthConditionScannerDBReady,
+		CentralHealthConditionEgressProxyReady, CentralHealthConditionPodsHealthy, CentralHealthConditionStorageReady,
+		CentralHealthConditionManagedDBReady, CentralHealthConditionDrifted:
+		return true
+	}
+	return false
+}
+
 // CompareTo - Compare this status with the given status returning an int. The result will be 0 if k==k1, -1 if k < k1, and +1 if k > k1
 func (k CentralStatus) CompareTo(k1 CentralStatus) int {
 	ordinalK := ordinals[k.String()]
@@ -100,3 +284,20 @@ func GetUpdateableStatuses() []string {
 		CentralRequestStatusReady.String(),
 	}
 }
+
+// GetActiveMigrationStatuses returns the migration statuses of centrals which are deployed on more than one data plane
+// cluster.
+func GetActiveMigrationStatuses() []string {
+	return append([]string{CentralMigrationStatusScalingDownSource.String()}, GetMigrationTargetStatuses()...)
+}
+
+// GetMigrationTargetStatuses returns the migration statuses in which centrals are deployed on the migration target
+// cluster. The target cluster only deploys a central once it has been scaled down on the source cluster.
+func GetMigrationTargetStatuses() []string {
+	return []string{
+		CentralMigrationStatusProvisioning.String(),
+		CentralMigrationStatusSwitchingDNS.String(),
+		CentralMigrationStatusDeprovisioningSource.String(),
+		CentralMigrationStatusDeprovisioningTarget.String(),
+	}
+}
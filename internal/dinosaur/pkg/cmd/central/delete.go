@@ -40,7 +40,7 @@ func runDelete(env *environments.Env, cmd *cobra.Command, _ []string) {
 	})
 	ctx := auth.SetTokenInContext(context.TODO(), jwt)
 
-	if err := centralService.RegisterDinosaurDeprovisionJob(ctx, id); err != nil {
+	if err := centralService.RegisterDinosaurDeprovisionJob(ctx, id, nil); err != nil {
 		glog.Fatalf("Unable to register the deprovisioning request: %s", err.Error())
 	} else {
 		glog.V(10).Infof("Deprovisioning request accepted for central cluster with id %s", id)
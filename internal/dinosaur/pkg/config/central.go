@@ -27,6 +27,8 @@ type CentralConfig struct {
 
 	CentralLifespan *CentralLifespanConfig `json:"central_lifespan"`
 	Quota           *CentralQuotaConfig    `json:"central_quota"`
+	Upgrade         *CentralUpgradeConfig  `json:"central_upgrade"`
+	Webhook         *CentralWebhookConfig  `json:"central_webhook"`
 
 	// Central's IdP static configuration (optional).
 	CentralIDPClientID         string `json:"central_idp_client_id"`
@@ -37,6 +39,14 @@ type CentralConfig struct {
 	// TODO: this parameter does not belong here, as it's configuration of central request, not central.
 	// TODO: However, for the time being there's no better place to put this parameter.
 	CentralRequestExpirationTimeout time.Duration `json:"central_request_expiration_timeout"`
+	// IdempotencyKeyTTL is the time after which the Idempotency-Key of a request creating a central can be reused.
+	IdempotencyKeyTTL time.Duration `json:"idempotency_key_ttl"`
+	// MigrationTimeout is the time after which a migration whose target cluster did not report the central as ready
+	// is considered failed.
+	MigrationTimeout time.Duration `json:"central_migration_timeout"`
+	// EgressAllowlistClusterCIDRs are the pod and service networks of the data plane clusters. The egress proxies of
+	// the centrals never connect to them, so they must not be added to the egress allowlist of a central.
+	EgressAllowlistClusterCIDRs []string `json:"egress_allowlist_cluster_cidrs"`
 }
 
 // NewCentralConfig ...
@@ -48,9 +58,14 @@ func NewCentralConfig() *CentralConfig {
 		CentralDomainName:                "rhacs-dev.com",
 		CentralLifespan:                  NewCentralLifespanConfig(),
 		Quota:                            NewCentralQuotaConfig(),
+		Upgrade:                          NewCentralUpgradeConfig(),
+		Webhook:                          NewCentralWebhookConfig(),
 		CentralIDPClientSecretFile:       "secrets/central.idp-client-secret", //pragma: allowlist secret
 		CentralIDPIssuer:                 "https://sso.redhat.com/auth/realms/redhat-external",
 		CentralRequestExpirationTimeout:  60 * time.Minute,
+		IdempotencyKeyTTL:                24 * time.Hour,
+		MigrationTimeout:                 60 * time.Minute,
+		EgressAllowlistClusterCIDRs:      []string{"10.128.0.0/14", "172.30.0.0/16"},
 	}
 }
 
@@ -61,6 +76,9 @@ func (c *CentralConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.EnableCentralExternalCertificate, "enable-central-external-certificate", c.EnableCentralExternalCertificate, "Enable custom certificate for Central TLS")
 	fs.BoolVar(&c.CentralLifespan.EnableDeletionOfExpiredCentral, "enable-deletion-of-expired-central", c.CentralLifespan.EnableDeletionOfExpiredCentral, "Enable the deletion of centrals when its life span has expired")
 	fs.IntVar(&c.CentralLifespan.CentralLifespanInHours, "central-lifespan", c.CentralLifespan.CentralLifespanInHours, "The desired lifespan of a Central instance")
+	fs.IntVar(&c.CentralLifespan.CentralLifespanExtensionInHours, "central-lifespan-extension", c.CentralLifespan.CentralLifespanExtensionInHours, "The time in hours by which a self-service extension postpones the expiration of a Central instance")
+	fs.IntVar(&c.CentralLifespan.MaxCentralLifespanExtensions, "max-central-lifespan-extensions", c.CentralLifespan.MaxCentralLifespanExtensions, "The number of self-service lifespan extensions allowed per Central instance")
+	fs.IntVar(&c.CentralLifespan.CentralExpiryWarningInHours, "central-expiry-warning", c.CentralLifespan.CentralExpiryWarningInHours, "The time in hours before the expiration of a Central instance at which an expiry warning event is recorded")
 	fs.StringVar(&c.CentralDomainName, "central-domain-name", c.CentralDomainName, "The domain name to use for Central instances")
 	fs.StringVar(&c.Quota.Type, "quota-type", c.Quota.Type, "The type of the quota service to be used. The available options are: 'ams' for AMS backed implementation and 'quota-management-list' for quota list backed implementation (default).")
 	fs.BoolVar(&c.Quota.AllowEvaluatorInstance, "allow-evaluator-instance", c.Quota.AllowEvaluatorInstance, "Allow the creation of central evaluator instances")
@@ -68,7 +86,21 @@ func (c *CentralConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.CentralIDPClientID, "central-idp-client-id", c.CentralIDPClientID, "OIDC client_id to pass to Central's auth config")
 	fs.StringVar(&c.CentralIDPClientSecretFile, "central-idp-client-secret-file", c.CentralIDPClientSecretFile, "File containing OIDC client_secret to pass to Central's auth config")
 	fs.StringVar(&c.CentralIDPIssuer, "central-idp-issuer", c.CentralIDPIssuer, "OIDC issuer URL to pass to Central's auth config")
+	fs.StringSliceVar(&c.Upgrade.CanaryOrganisations, "central-upgrade-canary-organisations", c.Upgrade.CanaryOrganisations, "Internal organisations whose Centrals are upgraded first by a version rollout")
+	fs.IntSliceVar(&c.Upgrade.DefaultWaves, "central-upgrade-default-waves", c.Upgrade.DefaultWaves, "Cumulative percentages of Centrals upgraded by the waves of a version rollout following the canary wave")
+	fs.Float64Var(&c.Upgrade.DefaultFailureThreshold, "central-upgrade-default-failure-threshold", c.Upgrade.DefaultFailureThreshold, "Ratio of failed Central upgrades above which a version rollout is paused")
+	fs.DurationVar(&c.Upgrade.UpgradeTimeout, "central-upgrade-timeout", c.Upgrade.UpgradeTimeout, "Time after which a Central upgrade which did not become ready is considered failed")
+	fs.BoolVar(&c.Webhook.AllowInsecureURLs, "central-webhook-allow-insecure-urls", c.Webhook.AllowInsecureURLs, "Allow the registration of webhook endpoints using plain HTTP")
+	fs.BoolVar(&c.Webhook.AllowPrivateAddresses, "central-webhook-allow-private-addresses", c.Webhook.AllowPrivateAddresses, "Allow the delivery of Central events to webhooks on loopback, private and link-local addresses")
+	fs.IntVar(&c.Webhook.MaxDeliveryAttempts, "central-webhook-max-delivery-attempts", c.Webhook.MaxDeliveryAttempts, "Number of attempts after which the delivery of a Central event to a webhook is considered failed")
+	fs.DurationVar(&c.Webhook.InitialBackoff, "central-webhook-initial-backoff", c.Webhook.InitialBackoff, "Time to wait before retrying a failed webhook delivery for the first time")
+	fs.DurationVar(&c.Webhook.MaxBackoff, "central-webhook-max-backoff", c.Webhook.MaxBackoff, "Maximum time to wait before retrying a failed webhook delivery")
+	fs.DurationVar(&c.Webhook.DeliveryTimeout, "central-webhook-delivery-timeout", c.Webhook.DeliveryTimeout, "Timeout of the requests delivering Central events to webhooks")
+	fs.DurationVar(&c.Webhook.DeliveryRetention, "central-webhook-delivery-retention", c.Webhook.DeliveryRetention, "Time after which delivered and failed webhook deliveries are removed")
 	fs.DurationVar(&c.CentralRequestExpirationTimeout, "central-request-expiration-timeout", c.CentralRequestExpirationTimeout, "Timeout for central requests")
+	fs.DurationVar(&c.IdempotencyKeyTTL, "central-idempotency-key-ttl", c.IdempotencyKeyTTL, "Time after which the Idempotency-Key of a request creating a central can be reused")
+	fs.DurationVar(&c.MigrationTimeout, "central-migration-timeout", c.MigrationTimeout, "Time after which a central migration whose target cluster did not report the central as ready is considered failed")
+	fs.StringSliceVar(&c.EgressAllowlistClusterCIDRs, "central-egress-allowlist-cluster-cidrs", c.EgressAllowlistClusterCIDRs, "Pod and service networks of the data plane clusters, which must not be added to the egress allowlist of a Central")
 }
 
 // ReadFiles ...
@@ -1,15 +1,42 @@
 package config
 
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
+)
+
 // CentralLifespanConfig ...
 type CentralLifespanConfig struct {
 	EnableDeletionOfExpiredCentral bool
 	CentralLifespanInHours         int
+	// CentralLifespanExtensionInHours is the time by which a single self-service extension postpones the expiration
+	// of a central.
+	CentralLifespanExtensionInHours int
+	// MaxCentralLifespanExtensions is the number of self-service extensions allowed per central. Admins can extend the
+	// lifespan of a central without limit.
+	MaxCentralLifespanExtensions int
+	// CentralExpiryWarningInHours is the time before the expiration of a central at which an expiry warning event is
+	// recorded and delivered to the webhooks of its organisation.
+	CentralExpiryWarningInHours int
 }
 
 // NewCentralLifespanConfig ...
 func NewCentralLifespanConfig() *CentralLifespanConfig {
 	return &CentralLifespanConfig{
-		EnableDeletionOfExpiredCentral: true,
-		CentralLifespanInHours:         48,
+		EnableDeletionOfExpiredCentral:  true,
+		CentralLifespanInHours:          48,
+		CentralLifespanExtensionInHours: 24,
+		MaxCentralLifespanExtensions:    2,
+		CentralExpiryWarningInHours:     24,
+	}
+}
+
+// LifespanForInstanceType returns the lifespan of new centrals of the given instance type, or zero if they do not
+// expire. Only eval centrals expire.
+func (c *CentralLifespanConfig) LifespanForInstanceType(instanceType types.DinosaurInstanceType) time.Duration {
+	if instanceType != types.EVAL {
+		return 0
 	}
+	return time.Duration(c.CentralLifespanInHours) * time.Hour
 }
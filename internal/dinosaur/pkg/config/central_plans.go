@@ -0,0 +1,164 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/pflag"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/environments"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// CentralPlan is a named size profile of a Central. It defines the resources of Central, Scanner analyzer and
+// Scanner DB, the scaling of the Scanner analyzer and the capacity of the managed Central DB.
+type CentralPlan struct {
+	Name    string            `json:"name"`
+	Central dbapi.CentralSpec `json:"central"`
+	Scanner dbapi.ScannerSpec `json:"scanner"`
+}
+
+// CentralPlanList ...
+type CentralPlanList []CentralPlan
+
+// GetByName ...
+func (pl CentralPlanList) GetByName(name string) (CentralPlan, bool) {
+	for _, p := range pl {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return CentralPlan{}, false
+}
+
+// Names returns the sorted names of the plans in the list.
+func (pl CentralPlanList) Names() []string {
+	names := make([]string, 0, len(pl))
+	for _, p := range pl {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CentralResourceBounds are the maximum resources and scaling owners can configure for the Centrals of an instance type.
+type CentralResourceBounds struct {
+	Central             corev1.ResourceList `json:"central"`
+	ScannerAnalyzer     corev1.ResourceList `json:"scanner_analyzer"`
+	ScannerDb           corev1.ResourceList `json:"scanner_db"`
+	MaxAnalyzerReplicas int32               `json:"max_analyzer_replicas"`
+}
+
+// CentralPlansConfiguration ...
+type CentralPlansConfiguration struct {
+	// DefaultPlan is the plan of Centrals created without a plan
+	DefaultPlan string          `json:"default_plan"`
+	Plans       CentralPlanList `json:"plans"`
+	// InstanceTypeBounds limit the changes of resources and scaling by the owners of Centrals per instance type.
+	// Owners can not change the resources and scaling of Centrals of instance types without bounds.
+	InstanceTypeBounds map[string]CentralResourceBounds `json:"instance_type_bounds,omitempty"`
+}
+
+// CentralPlansConfig ...
+type CentralPlansConfig struct {
+	PlansConfig     CentralPlansConfiguration `json:"plans"`
+	PlansConfigFile string                    `json:"plans_config_file"`
+}
+
+// NewCentralPlansConfig ...
+func NewCentralPlansConfig() *CentralPlansConfig {
+	return &CentralPlansConfig{
+		PlansConfigFile: "config/central-plans-configuration.yaml",
+	}
+}
+
+var _ environments.ServiceValidator = &CentralPlansConfig{}
+
+// Validate ...
+func (c *CentralPlansConfig) Validate() error {
+	if len(c.PlansConfig.Plans) == 0 {
+		return fmt.Errorf("no central plans configured")
+	}
+	seen := map[string]bool{}
+	for _, p := range c.PlansConfig.Plans {
+		if p.Name == "" {
+			return fmt.Errorf("central plan without name")
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("duplicate central plan %s", p.Name)
+		}
+		seen[p.Name] = true
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	if _, ok := c.PlansConfig.Plans.GetByName(c.PlansConfig.DefaultPlan); !ok {
+		return fmt.Errorf("default central plan %q is not a configured plan", c.PlansConfig.DefaultPlan)
+	}
+	for instanceType := range c.PlansConfig.InstanceTypeBounds {
+		if instanceType != types.STANDARD.String() && instanceType != types.EVAL.String() {
+			return fmt.Errorf("central resource bounds for unknown instance type %s", instanceType)
+		}
+	}
+	return nil
+}
+
+// Validate ...
+func (p CentralPlan) Validate() error {
+	db := p.Central.DB
+	if db.MinCapacity < 0 || db.MaxCapacity < 0 || db.MinCapacity > db.MaxCapacity {
+		return fmt.Errorf("invalid DB capacity %v-%v in central plan %s", db.MinCapacity, db.MaxCapacity, p.Name)
+	}
+	scaling := p.Scanner.Analyzer.Scaling
+	if scaling.MinReplicas > scaling.MaxReplicas {
+		return fmt.Errorf("invalid scanner analyzer replicas %d-%d in central plan %s", scaling.MinReplicas, scaling.MaxReplicas, p.Name)
+	}
+	return nil
+}
+
+// GetPlan returns the plan with the given name.
+func (c *CentralPlansConfig) GetPlan(name string) (CentralPlan, bool) {
+	return c.PlansConfig.Plans.GetByName(name)
+}
+
+// GetDefaultPlan returns the plan of Centrals created without a plan.
+func (c *CentralPlansConfig) GetDefaultPlan() (CentralPlan, error) {
+	p, ok := c.PlansConfig.Plans.GetByName(c.PlansConfig.DefaultPlan)
+	if !ok {
+		return CentralPlan{}, fmt.Errorf("default central plan %q is not a configured plan", c.PlansConfig.DefaultPlan)
+	}
+	return p, nil
+}
+
+// GetInstanceTypeBounds returns the bounds of the resources and scaling of Centrals of the given instance type.
+func (c *CentralPlansConfig) GetInstanceTypeBounds(instanceType string) (CentralResourceBounds, bool) {
+	bounds, ok := c.PlansConfig.InstanceTypeBounds[instanceType]
+	return bounds, ok
+}
+
+// AddFlags ...
+func (c *CentralPlansConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.PlansConfigFile, "central-plans-config-file", c.PlansConfigFile, "Central size plans configuration file")
+}
+
+// ReadFiles ...
+func (c *CentralPlansConfig) ReadFiles() error {
+	return readFileCentralPlansConfig(c.PlansConfigFile, &c.PlansConfig)
+}
+
+// Read the contents of file into the central plans config
+func readFileCentralPlansConfig(file string, val *CentralPlansConfiguration) error {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("reading central plans config file: %w", err)
+	}
+	// The plans embed the JSON tagged dbapi specs, hence the JSON compatible YAML parser.
+	err = yaml.UnmarshalStrict([]byte(fileContents), val)
+	if err != nil {
+		return fmt.Errorf("unmarshalling central plans config file from YAML: %w", err)
+	}
+	return nil
+}
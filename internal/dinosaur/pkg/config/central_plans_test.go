@@ -0,0 +1,117 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralPlansConfig_ReadFiles(t *testing.T) {
+	c := NewCentralPlansConfig()
+	require.NoError(t, c.ReadFiles())
+	require.NoError(t, c.Validate())
+
+	plan, err := c.GetDefaultPlan()
+	require.NoError(t, err)
+	assert.Equal(t, "small", plan.Name)
+	assert.Equal(t, "250Mi", plan.Central.Resources.Requests.Memory().String())
+	assert.Equal(t, 16.0, plan.Central.DB.MaxCapacity)
+	assert.Equal(t, int32(3), plan.Scanner.Analyzer.Scaling.MaxReplicas)
+	assert.Equal(t, []string{"large", "medium", "small"}, c.PlansConfig.Plans.Names())
+
+	bounds, ok := c.GetInstanceTypeBounds("standard")
+	require.True(t, ok)
+	assert.Equal(t, "16Gi", bounds.Central.Memory().String())
+	assert.Equal(t, int32(10), bounds.MaxAnalyzerReplicas)
+}
+
+func TestCentralPlansConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  CentralPlansConfiguration
+		wantErr bool
+	}{
+		{
+			name: "valid plans",
+			config: CentralPlansConfiguration{
+				DefaultPlan: "small",
+				Plans:       CentralPlanList{{Name: "small"}, {Name: "large"}},
+			},
+		},
+		{
+			name:    "no plans",
+			config:  CentralPlansConfiguration{DefaultPlan: "small"},
+			wantErr: true,
+		},
+		{
+			name: "plan without name",
+			config: CentralPlansConfiguration{
+				DefaultPlan: "small",
+				Plans:       CentralPlanList{{Name: "small"}, {}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate plan",
+			config: CentralPlansConfiguration{
+				DefaultPlan: "small",
+				Plans:       CentralPlanList{{Name: "small"}, {Name: "small"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown default plan",
+			config: CentralPlansConfiguration{
+				DefaultPlan: "medium",
+				Plans:       CentralPlanList{{Name: "small"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bounds of unknown instance type",
+			config: CentralPlansConfiguration{
+				DefaultPlan:        "small",
+				Plans:              CentralPlanList{{Name: "small"}},
+				InstanceTypeBounds: map[string]CentralResourceBounds{"huge": {}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid DB capacity",
+			config: CentralPlansConfiguration{
+				DefaultPlan: "small",
+				Plans: CentralPlanList{{
+					Name:    "small",
+					Central: dbapi.CentralSpec{DB: dbapi.CentralDBSpec{MinCapacity: 4, MaxCapacity: 2}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid scanner analyzer replicas",
+			config: CentralPlansConfiguration{
+				DefaultPlan: "small",
+				Plans: CentralPlanList{{
+					Name: "small",
+					Scanner: dbapi.ScannerSpec{Analyzer: dbapi.ScannerAnalyzerSpec{
+						Scaling: dbapi.ScannerAnalyzerScaling{MinReplicas: 3, MaxReplicas: 1},
+					}},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &CentralPlansConfig{PlansConfig: tt.config}
+			err := c.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
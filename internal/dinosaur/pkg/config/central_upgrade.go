@@ -0,0 +1,27 @@
+package config
+
+import "time"
+
+// CentralUpgradeConfig configures the rollouts of new Central versions across the fleet
+type CentralUpgradeConfig struct {
+	// CanaryOrganisations are the internal organisations whose Centrals are upgraded in the canary wave of a rollout
+	CanaryOrganisations []string `json:"canary_organisations"`
+	// DefaultWaves are the cumulative percentages of the fleet upgraded by the waves following the canary wave,
+	// unless a rollout specifies its own waves
+	DefaultWaves []int `json:"default_waves"`
+	// DefaultFailureThreshold is the ratio of failed upgrades above which a rollout is paused, unless a rollout specifies
+	// its own threshold
+	DefaultFailureThreshold float64 `json:"default_failure_threshold"`
+	// UpgradeTimeout is the time after which the upgrade of a Central which did not become ready with the desired
+	// versions is considered failed
+	UpgradeTimeout time.Duration `json:"upgrade_timeout"`
+}
+
+// NewCentralUpgradeConfig ...
+func NewCentralUpgradeConfig() *CentralUpgradeConfig {
+	return &CentralUpgradeConfig{
+		DefaultWaves:            []int{10, 50, 100},
+		DefaultFailureThreshold: 0.1,
+		UpgradeTimeout:          30 * time.Minute,
+	}
+}
@@ -0,0 +1,36 @@
+package config
+
+import "time"
+
+// CentralWebhookConfig configures the delivery of central lifecycle events to the webhook endpoints of organisations
+type CentralWebhookConfig struct {
+	// AllowInsecureURLs allows to register webhook endpoints using plain HTTP, e.g. a local stand-in during development
+	AllowInsecureURLs bool `json:"allow_insecure_urls"`
+	// AllowPrivateAddresses allows to deliver events to endpoints on loopback, private and link-local addresses, e.g.
+	// a local stand-in during development
+	AllowPrivateAddresses bool `json:"allow_private_addresses"`
+	// MaxDeliveryAttempts is the number of attempts after which the delivery of an event is considered failed
+	MaxDeliveryAttempts int `json:"max_delivery_attempts"`
+	// InitialBackoff is the time to wait before retrying a failed delivery for the first time. It is doubled on
+	// every further attempt up to MaxBackoff.
+	InitialBackoff time.Duration `json:"initial_backoff"`
+	MaxBackoff     time.Duration `json:"max_backoff"`
+	// DeliveryTimeout is the time after which a request to a webhook endpoint is aborted
+	DeliveryTimeout time.Duration `json:"delivery_timeout"`
+	// DeliveryBatchSize is the maximum number of deliveries attempted per reconciliation
+	DeliveryBatchSize int `json:"delivery_batch_size"`
+	// DeliveryRetention is the time after which delivered and failed deliveries are pruned
+	DeliveryRetention time.Duration `json:"delivery_retention"`
+}
+
+// NewCentralWebhookConfig ...
+func NewCentralWebhookConfig() *CentralWebhookConfig {
+	return &CentralWebhookConfig{
+		MaxDeliveryAttempts: 10,
+		InitialBackoff:      30 * time.Second,
+		MaxBackoff:          1 * time.Hour,
+		DeliveryTimeout:     10 * time.Second,
+		DeliveryBatchSize:   100,
+		DeliveryRetention:   7 * 24 * time.Hour,
+	}
+}
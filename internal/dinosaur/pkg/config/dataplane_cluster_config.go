@@ -31,8 +31,15 @@ type DataplaneClusterConfig struct {
 	DataPlaneClusterScalingType string `json:"dataplane_cluster_scaling_type"`
 	DataPlaneClusterConfigFile  string `json:"dataplane_cluster_config_file"`
 	DataPlaneClusterTarget      string `json:"dataplane_cluster_target"`
-	ReadOnlyUserList            userv1.OptionalNames
-	ReadOnlyUserListFile        string
+	// Possible values are:
+	// 'first-ready' to place centrals on the first ready cluster,
+	// 'least-loaded' to score clusters by their load using ClusterPlacementWeights
+	DataPlaneClusterPlacementStrategy string                  `json:"dataplane_cluster_placement_strategy"`
+	ClusterPlacementWeights           ClusterPlacementWeights `json:"cluster_placement_weights"`
+	// ClusterDrainMaxConcurrentMigrations is the maximum number of centrals migrated off a draining cluster at the same time.
+	ClusterDrainMaxConcurrentMigrations int `json:"cluster_drain_max_concurrent_migrations"`
+	ReadOnlyUserList                    userv1.OptionalNames
+	ReadOnlyUserListFile                string
 	// TODO ROX-11294 adjust or drop sre user list
 	SREUsers                              userv1.OptionalNames
 	ClusterConfig                         *ClusterConfig `json:"clusters_config"`
@@ -52,6 +59,21 @@ type OperatorInstallationConfig struct {
 	SubscriptionChannel    string `json:"subscription_channel"`
 }
 
+// ClusterPlacementWeights contains the weights used to score candidate clusters
+// when the least-loaded placement strategy is selected.
+type ClusterPlacementWeights struct {
+	// Load is applied to the free capacity ratio of a cluster.
+	Load float64 `json:"load"`
+}
+
+// FirstReadyPlacement ...
+const (
+	// FirstReadyPlacement places centrals on the first ready and schedulable cluster
+	FirstReadyPlacement string = "first-ready"
+	// LeastLoadedPlacement places centrals on the ready and schedulable cluster with the best weighted score
+	LeastLoadedPlacement string = "least-loaded"
+)
+
 // ManualScaling ...
 const (
 	// ManualScaling is the manual DataPlaneClusterScalingType via the configuration file
@@ -73,13 +95,18 @@ func getDefaultKubeconfig() string {
 // NewDataplaneClusterConfig ...
 func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 	return &DataplaneClusterConfig{
-		OpenshiftVersion:                      "",
-		ComputeMachineType:                    "m5.2xlarge",
-		ImagePullDockerConfigContent:          "",
-		ImagePullDockerConfigFile:             "secrets/image-pull.dockerconfigjson",
-		DataPlaneClusterConfigFile:            "config/dataplane-cluster-configuration.yaml",
-		ReadOnlyUserListFile:                  "config/read-only-user-list.yaml",
-		DataPlaneClusterScalingType:           ManualScaling,
+		OpenshiftVersion:                  "",
+		ComputeMachineType:                "m5.2xlarge",
+		ImagePullDockerConfigContent:      "",
+		ImagePullDockerConfigFile:         "secrets/image-pull.dockerconfigjson",
+		DataPlaneClusterConfigFile:        "config/dataplane-cluster-configuration.yaml",
+		ReadOnlyUserListFile:              "config/read-only-user-list.yaml",
+		DataPlaneClusterScalingType:       ManualScaling,
+		DataPlaneClusterPlacementStrategy: FirstReadyPlacement,
+		ClusterPlacementWeights: ClusterPlacementWeights{
+			Load: 1,
+		},
+		ClusterDrainMaxConcurrentMigrations:   1,
 		ClusterConfig:                         &ClusterConfig{},
 		EnableReadyDataPlaneClustersReconcile: true,
 		Kubeconfig:                            getDefaultKubeconfig(),
@@ -204,6 +231,13 @@ func (conf *ClusterConfig) IsClusterSchedulable(clusterID string) bool {
 	return true
 }
 
+// GetClusterInstanceLimit returns the central instance limit of the given cluster.
+// The returned boolean is false if the cluster is not part of the configuration.
+func (conf *ClusterConfig) GetClusterInstanceLimit(clusterID string) (int, bool) {
+	manualCluster, exist := conf.clusterConfigMap[clusterID]
+	return manualCluster.CentralInstanceLimit, exist
+}
+
 // GetClusterSupportedInstanceType ...
 func (conf *ClusterConfig) GetClusterSupportedInstanceType(clusterID string) (string, bool) {
 	manualCluster, exist := conf.clusterConfigMap[clusterID]
@@ -263,6 +297,11 @@ func (c *DataplaneClusterConfig) IsDataPlaneAutoScalingEnabled() bool {
 	return c.DataPlaneClusterScalingType == AutoScaling
 }
 
+// IsLeastLoadedPlacementEnabled ...
+func (c *DataplaneClusterConfig) IsLeastLoadedPlacementEnabled() bool {
+	return c.DataPlaneClusterPlacementStrategy == LeastLoadedPlacement
+}
+
 // IsReadyDataPlaneClustersReconcileEnabled ...
 func (c *DataplaneClusterConfig) IsReadyDataPlaneClustersReconcileEnabled() bool {
 	return c.EnableReadyDataPlaneClustersReconcile
@@ -319,6 +358,9 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.FleetshardOperatorOLMConfig.Package, "fleetshard-operator-package", c.FleetshardOperatorOLMConfig.Package, "fleetshard operator package")
 	fs.StringVar(&c.FleetshardOperatorOLMConfig.SubscriptionChannel, "fleetshard-operator-sub-channel", c.FleetshardOperatorOLMConfig.SubscriptionChannel, "fleetshard operator subscription channel")
 	fs.StringVar(&c.DataPlaneClusterTarget, "dataplane-cluster-target", "", "specify cluster by ID on which new centrals should be created")
+	fs.StringVar(&c.DataPlaneClusterPlacementStrategy, "dataplane-cluster-placement-strategy", c.DataPlaneClusterPlacementStrategy, "Strategy used to place new centrals on data plane clusters. Its value should be either 'first-ready' or 'least-loaded'.")
+	fs.Float64Var(&c.ClusterPlacementWeights.Load, "cluster-placement-load-weight", c.ClusterPlacementWeights.Load, "Weight of the free capacity of a cluster when using the 'least-loaded' placement strategy")
+	fs.IntVar(&c.ClusterDrainMaxConcurrentMigrations, "cluster-drain-max-concurrent-migrations", c.ClusterDrainMaxConcurrentMigrations, "Maximum number of centrals migrated off a draining data plane cluster at the same time")
 }
 
 // ReadFiles ...
@@ -38,6 +38,9 @@ type Region struct {
 	Name                   string          `yaml:"name"`
 	Default                bool            `yaml:"default"`
 	SupportedInstanceTypes InstanceTypeMap `yaml:"supported_instance_type"`
+	// NearestRegions is an ordered list of regions of the same provider that centrals
+	// are placed in when no cluster in this region is able to host them.
+	NearestRegions []string `yaml:"nearest_regions,omitempty"`
 }
 
 // IsInstanceTypeSupported ...
@@ -149,6 +152,13 @@ func (provider Provider) Validate() error {
 	if defaultCount != 1 {
 		return fmt.Errorf("expected 1 default region in provider %s, got %d", provider.Name, defaultCount)
 	}
+	for _, r := range provider.Regions {
+		for _, nearest := range r.NearestRegions {
+			if nearest == r.Name || !provider.IsRegionSupported(nearest) {
+				return fmt.Errorf("invalid nearest region %s for region %s in provider %s", nearest, r.Name, provider.Name)
+			}
+		}
+	}
 	return nil
 }
 
@@ -200,3 +210,16 @@ func (provider Provider) IsRegionSupported(regionName string) bool {
 	_, ok := provider.Regions.GetByName(regionName)
 	return ok
 }
+
+// GetNearestRegions returns the nearest regions configured for the given provider and region.
+func (pl ProviderList) GetNearestRegions(providerName string, regionName string) []string {
+	provider, ok := pl.GetByName(providerName)
+	if !ok {
+		return nil
+	}
+	region, ok := provider.Regions.GetByName(regionName)
+	if !ok {
+		return nil
+	}
+	return region.NearestRegions
+}
@@ -0,0 +1,31 @@
+package defaults
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v6"
+)
+
+// CentralDBDefaults contains the sizing of the managed database of a Central.
+type CentralDBDefaults struct {
+	EngineVersion       string  `env:"ENGINE_VERSION" envDefault:"13.7"`
+	MinCapacity         float64 `env:"MIN_CAPACITY" envDefault:"0.5"`
+	MaxCapacity         float64 `env:"MAX_CAPACITY" envDefault:"16"`
+	BackupRetentionDays int32   `env:"BACKUP_RETENTION_DAYS" envDefault:"30"`
+}
+
+var (
+	// CentralDB ...
+	CentralDB CentralDBDefaults
+)
+
+func init() {
+	defaults := CentralDBDefaults{}
+	opts := env.Options{
+		Prefix: "CENTRAL_DB_",
+	}
+	if err := env.Parse(&defaults, opts); err != nil {
+		panic(fmt.Sprintf("Unable to parse Central DB Defaults configuration from environment: %v", err))
+	}
+	CentralDB = defaults
+}
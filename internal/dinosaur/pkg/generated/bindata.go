@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _fleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x73\xdb\xb6\xb2\xf8\xff\xfe\x14\xfb\x53\x7f\x67\x7c\xda\xb1\x64\x49\x7e\x24\xd1\xdc\xde\x19\x27\x76\x12\xf7\x24\x4e\xea\x47\xd3\xb4\x73\x46\x86\x48\x48\x42\x4c\x02\x0c\x00\xda\x56\xee\xb9\xdf\xfd\x0e\x1e\x24\xc1\xa7\x28\x39\x0f\xa7\xb5\x67\x3a\x8d\x48\x60\xb9\xbb\xd8\x5d\xec\x02\xbb\x00\x8b\x30\x45\x11\x19\xc1\x4e\xaf\xdf\xeb\xc3\x0f\x40\x31\xf6\x41\xce\x89\x00\x24\x60\x4a\xb8\x90\x10\x10\x8a\x41\x32\x40\x41\xc0\x6e\x40\xb0\x10\xc3\xf1\xe1\x91\x50\x8f\xae\x28\xbb\x31\xad\x55\x07\x0a\x16\x1c\xf8\xcc\x8b\x43\x4c\x65\x6f\xe3\x07\x38\x08\x02\xc0\xd4\x8f\x18\xa1\x52\x80\x8f\xa7\x84\x62\x1f\xe6\x98\x63\xb8\x21\x41\x00\x13\x0c\x3e\x11\x1e\xbb\xc6\x1c\x4d\x02\x0c\x93\x85\xfa\x12\xc4\x02\x73\xd1\x83\xe3\x29\x48\xdd\x56\x7d\xc0\x62\xc7\xe0\x0a\xe3\xc8\x60\x92\x41\xee\x44\x9c\x5c\x23\x89\x3b\x5b\x80\x7c\x45\x03\x0e\x55\x53\x39\xc7\xd0\x09\x11\x45\x33\xec\x77\x05\xe6\xd7\xc4\xc3\xa2\x8b\x22\xd2\xb5\xed\x7b\x0b\x14\x06\x1d\x98\x92\x00\x6f\x10\x3a\x65\xa3\x0d\x00\x49\x64\x80\x47\x70\x8a\x7d\x78\x89\x24\x1c\xf8\xd7\x88\x7a\xd8\x87\x67\x41\x2c\x24\xe6\x70\x86\xbd\x98\x13\xb9\x80\x33\x03\x10\x9e\x07\x18\x4b\x78\xad\x3f\xc3\x37\x00\xae\x31\x17\x84\xd1\x11\x0c\x7a\xc3\x5e\x7f\x03\xc0\xc7\xc2\xe3\x24\x92\xfa\xe1\x72\xb8\xff\x3c\x7d\x79\xf0\xec\xec\xc7\x6a\xf8\x86\x17\xa7\x58\x48\x38\x78\x7b\xac\x88\x34\xf4\x01\xa1\x42\x2a\x80\x02\xd8\x14\x0e\x9e\x9d\x81\xc7\xc2\x88\x51\x4c\xa5\xe8\x6d\x28\xda\x31\x17\x8a\xbc\x2e\xc4\x3c\x18\xc1\x5c\xca\x48\x8c\xb6\xb7\x51\x44\x7a\x6a\xe4\xc4\x9c\x4c\x65\xcf\x63\xe1\x06\x40\x01\xe3\xd7\x88\x50\xf8\x67\xc4\x99\x1f\x7b\xea\xc9\x8f\x60\xc0\x55\x03\x13\x12\xcd\xf0\x32\x90\x67\x12\xcd\x08\x9d\x55\x02\x1a\x6d\x6f\x07\xcc\x43\xc1\x9c\x09\x39\x7a\xdc\xef\xf7\xcb\xdd\xd3\xf7\x59\xcf\xed\x72\x2b\x2f\xe6\x1c\x53\x09\x3e\x0b\x11\xa1\x1b\x11\x92\x73\xcd\x01\x85\xe6\x36\x9f\x23\x4f\x6c\x5f\x0f\x46\xba\xdf\x0c\x4b\xf3\x0f\x50\x62\xcc\x91\x02\x70\xec\x8f\xd4\xf3\xdf\xcc\x68\xbe\xc6\x12\xf9\x48\x22\xdb\x8a\x63\x11\x31\x2a\xb0\x48\xba\x01\x74\x86\xfd\x7e\x27\xfb\x09\xe0\x31\x2a\x31\x95\xee\x23\x00\x14\x45\x01\xf1\xf4\x07\xb6\x3f\x08\x46\xf3\x6f\x01\x84\x37\xc7\x21\x2a\x3e\x05\xf8\xff\x1c\x4f\x47\xd0\xf9\x61\x3b\x1b\xd6\x6d\xd3\x56\x6c\x17\x50\xec\x38\x9d\x73\x0c\xb1\xed\x20\xcc\xd3\x22\xe2\x30\x44\x7c\xa1\x44\x53\xc6\x9c\x0a\xad\x36\xd7\xc5\xb6\x45\xc6\x6d\x63\xce\x19\x17\xdb\xff\x43\xfc\xff\x5d\xca\xc4\x23\xd5\xf6\xe9\xe2\xd8\xbf\x8f\xec\xd3\xc8\xd5\x32\xed\x05\x96\xa0\x49\x55\xc6\x29\x25\xa0\x92\x67\x69\x33\x92\x34\x93\x68\xe6\x90\xd8\x35\x2d\x84\x7d\x10\x21\x8e\x42\x2c\xad\x5e\x26\x4d\xaa\x30\xcd\x5a\x6e\x13\xbf\x53\x37\x14\xed\x46\x41\xdc\xdb\x21\x78\x45\x84\xac\x1d\x06\xf5\x52\x59\xb6\x88\x09\x41\xd4\x54\x91\x63\x65\xe5\x70\x04\xc5\x2e\xca\x60\xe6\xba\xd5\x0c\x4f\x89\xbf\x42\x22\x19\x2f\xe7\xaf\x35\xd8\x67\xba\xf5\x7d\x64\x73\x0e\xc1\x5a\x56\xbf\xb9\xca\x50\xdd\x2b\xa0\x9a\x6b\x78\x41\xf1\x6d\x84\x3d\x89\x7d\x2b\xfa\xcc\xd3\x36\xd7\xbf\x17\x5a\xac\xfe\xf0\x2d\x0a\xa3\xc0\x65\x7e\xf2\xb7\xd7\xef\x1f\x99\x97\xe5\x77\xd5\x1f\x4a\x60\x6d\x67\x5d\x3b\x4d\xe2\x67\x84\x46\x09\x20\xc7\x82\xc5\xdc\xc3\x62\x0b\x44\xec\xcd\x95\x77\x75\x33\xc7\xca\xb5\x81\x10\xdd\x92\x30\x0e\xc1\x3a\x27\xe0\xa1\x08\x79\xca\x09\x98\x23\x01\x13\x8c\x29\x70\x8c\xbc\x79\xca\x52\x61\x9d\x04\x57\x6a\x9f\x62\xc4\x31\x1f\xc1\x9f\xff\x2e\x09\xae\x87\xa9\xe4\x28\x68\x69\xa5\x9f\x99\xd6\x8e\x9d\xce\x0d\xf7\xb9\xf2\xf5\xd2\x3e\xca\x11\x61\x34\x58\x00\x8a\xe5\x9c\x71\xf2\xc9\x78\x67\xda\x75\x03\x42\x0d\x0b\x50\x88\x81\xf1\x19\xa2\x44\x98\x4e\xc8\xf0\x86\xdd\x50\xcc\xf3\x6f\xd8\xd4\x74\x89\xb0\x47\xa6\x44\xf9\x45\x06\x9b\xde\x7d\x54\x24\x8b\xdb\x29\xfe\x18\xe3\xbc\xd1\x6a\x96\xba\x7c\xbf\x17\x58\x9e\x5a\xaa\xd6\x95\xc5\x3c\xc0\x82\x58\xb6\xf8\xee\x3b\x22\xe7\xcf\x11\x09\xb0\xff\x8c\x63\xcd\x23\x63\x1c\x3e\x0f\x3e\x0d\x90\x6b\xad\x8f\x85\x00\xdc\x80\x80\x29\x8b\xa9\xaf\xe7\xde\xc3\x6c\xe0\x77\xfb\x83\x7b\xe2\x2b\x34\x8f\xf7\x6e\x7f\xb0\x2e\x27\xb3\xae\xb5\xac\x3a\x88\xe5\x1c\x24\xbb\xc2\x5a\x19\x09\xbd\x46\x01\xf1\x5d\x26\xed\x7c\x27\x4c\xda\x59\x9f\x49\x3b\xcb\x98\x74\x21\x30\x07\xca\x64\xc1\x4e\x21\xcf\xc3\xc2\x1a\x6a\x63\x7b\x5d\xc6\xed\x7e\x27\x8c\xdb\x5d\x9f\x71\xbb\xcb\x18\x77\xc2\x4a\xba\x78\x43\xe4\xdc\xb1\xd0\xc7\x87\x80\x6f\x89\x90\xa2\xde\x5f\xb8\xaf\xac\xfb\xac\xd3\x7f\x89\x75\xcb\x1c\xa3\xa5\xb3\x38\x54\x39\x15\xa8\x34\x1e\x99\x55\xf4\x71\x80\x25\xae\x9c\xd8\xcd\xab\x25\x73\xfb\x7f\x52\x4c\xce\xd5\xf4\xac\xe6\x75\x33\x93\x3b\x5a\x33\x65\xdc\xac\xf7\x64\x3e\x00\xe2\x0e\xff\x06\x3f\xea\xce\xc8\x0f\x09\x25\x42\x72\x24\x15\xe5\xd3\x75\x27\x7c\x80\xa1\x01\x68\xfa\x2a\x74\xb6\x00\x51\xdf\x60\x47\xa6\x40\xa4\x5e\x0c\x09\x04\x53\xa1\x94\xbc\xc3\xa7\xaa\x23\x31\x42\x47\xf0\x31\xc6\x7c\xe1\x0c\x33\x45\x21\x1e\x01\x12\x0b\xea\xd5\x0d\xfe\x5b\xcc\xa7\x8c\x87\xfa\x8b\xc8\x33\xae\x12\x05\x44\x4d\xaf\x39\x67\x94\xc5\x02\x42\x44\xa9\x5e\xf9\x68\x12\x7a\xb9\x88\xf0\x08\x26\x8c\x05\x18\x51\xe7\x8d\x1a\x7f\xc2\xb1\x3f\x02\xc9\x63\xdc\xe8\x20\x0d\xeb\xdd\xf7\x43\x2d\x18\xb9\x09\xe3\xfb\x50\xde\xdd\x7e\x5f\xe3\x4e\x18\x5d\xdf\xfe\x15\x41\xd4\xaf\x9a\xa8\x59\xd5\xc8\x91\x89\x0f\xcb\x61\xce\x83\x3f\xf2\xe0\x8f\x3c\xf8\x23\xca\x1f\x31\x36\xe5\x0e\x5e\x49\x0e\xc0\xdf\xd6\x37\xb9\x1b\x1b\x8b\x00\xd6\xf7\x53\x12\x17\xc4\x80\x6b\x76\x41\x5a\xb9\x35\xe5\x99\xb6\xd5\x8a\x67\xdd\xba\x86\x01\x12\x31\x51\xbd\xa6\xe1\xa9\xc8\x33\x71\x7d\xaa\xdc\x9e\x23\xe4\xcd\xc1\x02\xd3\x4b\x2e\x08\x04\xa1\xb3\xa0\xd2\x8b\x50\xbe\x47\xe1\xbd\x72\x4a\x7a\xa0\x03\x5c\x6c\xf6\xa8\x6e\x52\x0e\xc9\x39\xd2\x0e\x8a\x6a\xa9\x03\x58\xa5\xdb\xaa\x83\x71\x62\x72\x90\x63\x39\xc7\x54\x2a\xb9\x4b\xfd\x2c\x9c\xb0\xf8\x2f\xe6\xa4\x68\x9a\x9e\x32\xdf\x91\x92\xca\xf8\xdf\xd9\xa0\xa8\x54\xd5\x66\x45\xad\x56\xd3\xf6\x4b\x3a\x6f\xd1\x22\x60\xc8\xcf\x2b\x6d\x9d\xca\x5e\x9c\x9d\xe2\x19\x29\xdb\x8a\x25\x6a\x9a\x74\xab\x59\xb5\x39\xba\x58\x0b\x6a\xd2\xad\x04\x75\x6d\xa7\xf1\x3b\x5b\x55\x7b\xcb\xc4\x97\x5f\x56\xcb\xfb\x3d\x9e\x87\xa3\xef\xd5\x93\x4e\x56\xe7\xee\xe0\x49\x17\x40\x3c\x78\xd2\x0f\x9e\x74\xc2\xa4\xcf\xec\x49\xa7\x60\x5f\xa3\xdb\x83\x20\x60\x37\xd8\x3f\xb6\x79\x0f\xa7\x66\x9f\xe4\x0e\xdf\x5b\x06\xb3\x12\x91\x73\xcc\x43\x71\xc2\x64\x62\x03\xee\xf0\xfd\x1a\x50\xcd\x91\xc4\x94\xf1\x09\xf1\x7d\x4c\x01\x13\xbd\xa3\x34\xc1\x1e\x8a\x05\xce\xbc\x0d\x22\x5a\x85\x1b\xc0\xf2\x7d\x93\x9d\x29\x1a\x87\x13\xac\xd7\x71\xb2\x0c\x13\xed\xda\x78\x88\xc2\x04\x5b\x1f\xcb\x3a\x38\x44\x98\x6f\x16\x77\xaf\x7a\xdf\x65\x30\xf3\x05\x17\x57\xcf\x33\xff\x0e\xfb\xe9\x06\x21\xf8\x0c\x0b\xba\x29\x4d\xe8\xe2\xf2\xec\xc9\x77\xc2\xb3\x27\x27\x28\xc4\xcf\x18\x9d\x06\xc4\x93\xeb\xf3\xaf\x0a\x4c\xbd\xb1\x54\xfc\xd0\x2d\x33\xb9\xf3\xb1\x34\x71\x8d\xdd\x89\xf4\xec\x14\x65\x96\x02\x89\x48\x59\xfe\x5d\x86\x87\x5f\x70\xe9\xfa\x80\x42\x5c\x17\x15\xc2\xcd\x9c\x04\x09\x2f\xe9\x4c\x33\xb6\x10\x0f\xb6\x8f\x04\x9d\xe8\x32\x8b\x9f\xaa\xa0\x39\x1b\xd6\x15\x4b\xe2\x49\x92\x47\xa1\xa7\xa8\x8a\xf6\xde\xd0\x60\x01\x3c\xdd\xa2\x67\x02\x27\xb1\x9f\x35\x69\x88\xe3\x7c\xb8\x56\xb5\x8a\x6c\x42\xb8\x36\x11\x5b\xcd\xfe\xba\x58\x85\x49\x6d\xb6\xbd\x0b\xda\xb0\x84\x25\xf0\xed\x5c\xfa\x62\x86\x0f\xac\xe0\xd6\xab\xbe\x9f\xc7\x9d\x77\x20\x75\xea\x5d\xf6\x1c\x53\x9f\x22\xbf\x20\xe1\x5f\x95\x8b\x2b\x5a\x88\x63\xe3\x2f\xfe\x1a\x63\xbe\xb8\x83\x5b\x5f\x01\xa6\x53\xef\xa8\xaf\xe0\xbf\xde\x5f\xce\x7d\x66\xaf\xfe\xef\xeb\xa8\xdf\x75\xc9\xfb\x21\xef\xac\xcd\xec\xbd\x56\x06\x69\x84\x66\xce\x50\x2d\x6d\x2e\xc8\xa7\x55\x9a\x33\xee\x63\xfe\x74\xb1\xca\x07\x30\xe2\xde\xbc\x62\x8d\x37\x60\xb1\x3f\x8e\x38\xbb\x26\x3e\xae\xc8\x6e\x6d\xcc\xf9\x14\x71\x14\x31\xae\x24\x44\x83\x81\x14\x4c\xdd\xd4\xac\x5a\xbd\x2d\x34\xfa\x32\x13\xb4\x41\x17\xfb\xad\x71\x85\xaf\x3a\x61\xbb\x8c\xc8\xcf\xd7\x0f\x26\xbf\x8d\xc9\x7f\xb0\x5c\xf7\xcd\x72\x35\x9a\x15\x9d\x19\xbb\xcd\xf5\x92\xf9\xda\x36\xc6\x76\x4f\xf3\x4c\x6a\x14\xba\x8d\xed\x31\x8b\xf7\xf7\xc4\x02\x25\x84\x7d\x33\x43\x64\xb8\xf1\x60\x86\x1e\xcc\x50\xfa\xf7\xed\xcd\x10\xf1\x57\x30\x42\x5f\xd6\xdb\x4a\x96\x64\xc7\x72\x11\xd5\xda\x3a\xe4\x79\x2c\xa6\x72\x45\xeb\x66\x2c\x41\xd2\x17\x6e\xe6\xc4\x9b\xc3\x04\x07\x8c\xce\x92\x3c\xff\x4d\x61\x17\x48\x3e\x69\x89\x68\x32\x6f\x07\x16\x4e\x1b\xbb\x06\x7f\x03\xc3\x96\xf0\xe3\xc1\xb4\x3d\x98\xb6\xf4\xef\xb3\x99\xb6\x1f\xd4\x7f\x70\x3e\xc7\x02\xeb\x35\xcd\x64\xd9\xb2\x3b\x45\x1e\xa1\x33\xe0\x38\xd0\xeb\x96\x69\x01\xb5\xed\xd3\x50\x3c\xb4\x1d\x62\xc9\x89\x27\xb6\x75\xb2\xc9\x98\x23\x3a\xc3\xcb\x0d\x8a\xed\x64\x92\xb3\x24\x09\xb1\xc0\x9c\x60\x01\xba\xbb\xc9\x5b\x81\xc9\x22\x5d\xa9\x4c\x33\x89\x8a\x36\xe4\xb5\x81\xf3\x74\x71\xaa\x3a\xfe\xea\xe4\xbb\x7c\x61\x0f\xe9\x97\xb3\x37\x27\x80\x38\x47\x0b\x65\x4e\xde\x72\x16\x62\x39\xc7\x71\x46\x19\x9b\x7c\xc0\x9e\x14\x30\xe5\x2c\x04\x36\x11\x98\x5f\x23\xc9\x38\x89\xc3\x6f\x21\x70\x96\x4f\x19\x97\x1e\xec\xcb\x83\x7d\x49\xff\xbe\x33\xd7\xc9\x8f\x8d\x0d\x58\xc9\x1f\x92\x4a\x01\x83\x15\xba\x4c\x49\xa0\xfe\x5f\x9f\x63\x58\x61\xfe\x56\x34\x7c\xc6\x4b\x93\xeb\xd8\x3b\x93\x89\x20\x1f\x2c\xde\x12\x8b\xe7\xf2\xe9\xc1\xe6\x3d\xd8\xbc\xf4\xef\x3b\xb3\x79\x2b\x5a\xa3\x29\xf6\x95\xe1\x68\xe1\x89\xa1\x20\x48\x35\x98\x50\x10\x1e\x47\x11\xd6\x67\xee\x4c\x19\x0f\x91\xd4\x7b\xd8\x08\x66\xe4\x1a\xd3\x25\xf6\x29\xf9\xa8\x55\xbd\xaf\x63\x96\x12\x94\x1c\x1a\x90\x6b\x9d\x24\xbe\x95\x96\x94\x65\x52\xa9\x9a\x6e\x47\x01\x22\xad\xe5\xd1\xa4\x38\x0b\xc9\x09\x9d\xb9\x86\xe5\x2f\xb3\x63\xfc\x9a\x08\x41\xe8\xec\x6d\x22\x88\x77\xd8\x35\xae\x01\xf5\x60\x90\x57\xdd\x39\xde\xad\x67\x52\xa6\x9f\x7a\x5b\x55\x97\xc8\x7f\x17\x3c\xfa\xac\x29\x75\x0f\x93\xd6\x97\x9d\xb4\x36\xb2\x57\xaa\xa7\xa5\xc5\x00\x79\xa3\x9d\xc0\x53\x3c\xc5\x1c\x53\x2f\x45\xd3\x18\x4a\xe3\x21\x26\x9f\xe7\x6a\xf2\x90\xc4\xa5\x93\xf8\x2e\x5d\x95\xd6\xf5\x8a\xd0\xe5\x8d\xe6\x8a\x88\xa6\x46\xca\x15\x1c\xa5\x73\x8f\xad\x7f\x70\xb8\xa0\xbe\xe2\xfc\x8c\xd0\x0c\x3b\x3f\x05\xf9\xe4\xfe\x94\x4c\xa6\x55\x44\xba\xf4\x46\xe2\x50\xac\x46\x78\x2b\xaa\x14\x16\xe5\x46\x2a\xb4\x99\x39\xc5\x38\x0a\xb9\xe5\xad\x34\xce\xcd\xcd\xb4\x6c\x26\x4d\x50\x10\xbc\x99\x2e\x93\x93\x44\xaa\x0b\x42\xe0\xba\x39\x15\xfc\xa8\xe3\x09\x68\x45\xf4\x4b\xa2\x5e\xc9\x1b\xd0\x03\x89\x2a\xd4\xb2\xb6\x79\xea\xbb\x8c\xf3\x62\x57\xd9\x29\x3d\x88\x6a\x2d\x86\xe4\x23\x8f\x95\xb9\xa0\x05\xaa\x1a\x45\x1d\x90\x15\xde\x54\x36\x6f\x6d\x87\x4e\x6d\x02\xac\x4b\x6c\x05\xbe\xc8\xf7\x89\x62\x1e\x0a\xde\x56\x60\x5d\xe2\x5f\x02\xf5\xd4\x28\x5b\x88\xd3\x7d\x87\xd6\x3a\x92\xa4\x2b\xba\x94\x35\xd1\xe4\x12\x92\x31\x3f\x20\x21\xb9\x0b\x8c\x7c\xca\xe0\x57\x52\x8f\xb2\x89\x32\xcd\xc3\x38\x90\x64\x8c\x3e\xb5\x90\x21\xf7\xa8\xb2\xec\x2f\x37\x33\x76\x7e\x43\x41\x8c\xc5\x08\xfe\x44\xb6\xd2\x60\x0b\x22\x8e\x23\xa4\x46\x71\xcb\xec\x91\x08\xc2\xa8\xfe\xc5\x31\xf2\x17\x5b\x30\xd5\x27\xf9\x6c\x81\x8f\xd3\xd7\x5b\xe6\x64\x09\x42\x67\xff\x86\xe2\xd4\x56\xab\x8c\xf9\xdd\xf7\x66\x34\x4f\xf4\xe9\x51\x53\xd0\x1b\x26\x10\x0b\x93\x35\xe6\xe3\x28\x60\x8b\x1e\x3c\x67\x3c\x99\x41\xe1\xe0\xdd\xd9\x8a\x18\xd8\x7d\xad\x0a\x93\x90\xc7\xc1\x7c\xdb\xee\xd6\xc0\xf1\x61\xeb\xcf\x24\x43\x56\xad\xce\xe5\x6a\x49\xb0\x5b\x52\x6d\x46\x2e\x3d\x3b\xd5\xc9\x3b\xb0\x95\xcf\x5e\x61\xa3\x2b\xc7\xa7\x11\xc4\xa2\x8b\x91\x90\xdd\x81\x0e\xf7\x56\x61\x9b\xae\x77\x6d\x6d\x73\x75\x05\x6a\xeb\x11\x31\xaa\x76\x71\x7c\x71\xfa\x6a\xd5\x4e\x87\x48\xa2\x95\xba\x99\x6a\x95\x31\x92\xc5\x2e\x26\x76\x1c\x81\x8f\x24\xee\x4a\x12\xe2\xb6\x20\xe3\xc8\xff\xdc\x20\x8d\xb6\x8d\x57\x9c\xe8\x92\x73\x67\xdb\xb6\xcf\x6d\x1e\xb7\xec\x85\xab\xdc\xd2\x75\x4b\x29\xcb\x69\xd9\x5f\x72\xd6\xad\x44\x5d\x3b\x64\xd0\x59\x96\xa6\xae\x5d\x32\xe8\x0c\xf2\x4f\xb5\x0b\x56\x7a\x6a\x5c\xae\xd2\x63\x35\x5b\x17\x99\x7c\xb7\x1a\xd4\x2f\xeb\x46\x14\x86\x20\xfb\x6b\x1e\x8c\xaa\x1a\x5e\xfb\xec\x2c\xc2\xde\xca\xbe\x80\x3d\x39\x71\xd5\x89\xdc\xf5\x3f\x0c\x12\x67\x9e\x2e\x66\x5f\x03\x09\x44\x51\xb0\xf8\x94\xb7\x7e\x2b\x7a\x76\x95\x74\xac\x4f\x4b\xf2\x27\x3c\x14\x10\x3a\xab\x1e\xeb\x12\x72\x4d\x08\x6a\x3a\x63\xc9\xce\xaa\x21\x42\x93\x1d\x31\x04\xea\xa0\xb9\x02\x6a\x5d\x64\x92\xfd\x25\x66\x92\x50\xb9\x33\xac\x78\x1f\x12\x4a\xc2\x38\x1c\xc1\xa0\xf4\x32\x24\xf4\xf4\x1b\x7d\x19\xdd\x7e\xe5\x2f\xfb\x93\xfb\x23\x80\x85\x13\xa7\xbf\x92\x7b\xdc\x64\xc1\x0f\xde\x1e\x5b\xa4\x0a\x66\x57\xbd\xbc\x2e\xd8\xe2\xb9\x41\x2b\xb7\xc2\x9e\x6f\xe1\xb1\x20\xc0\xfa\x5c\x8c\x12\xc7\xba\x06\x66\xb2\x20\x5f\xae\x0a\xae\x82\xbe\x5d\xdf\x3c\x3f\x05\x15\xe7\x9e\xfa\x88\xb9\x16\xc1\xaf\x65\xea\x2b\x07\x30\x77\xc4\x70\x02\x33\x7f\xf6\xbb\xee\x9e\xd6\xcc\x25\xe5\xc7\xf6\xb8\xdc\x64\x87\x00\x26\xcc\x4f\xd0\x2f\x8d\x7b\xfe\xf8\x17\xf3\x17\xa2\xdb\x71\x72\x7a\xee\xd8\x96\x1d\xe7\x8e\xba\x68\x69\xed\xab\x60\x97\x4a\x77\xf5\x09\xfd\x59\xd9\x2e\x8a\x88\xc5\x7f\xb9\x86\xd6\xc6\x78\x15\xf8\xb7\x90\x83\x4a\xb2\x9b\x62\x88\x63\xea\xeb\xaa\xc5\xf5\xcf\x20\xce\x13\xe8\x86\x32\x95\x67\xa8\xb4\x11\x82\xf4\x34\x21\xe6\x2f\x40\x60\x2a\x55\xa4\x97\xea\x0c\xbc\x7d\x73\x76\xbe\x51\xc7\xbe\xae\x8e\x36\x56\x1c\xe4\xda\x30\xb4\x34\xd4\x85\xc4\xc1\x1b\x7d\x21\x85\x5b\xf9\x9a\x6a\x46\x1a\x96\x25\x25\xf1\x84\x96\xc4\xa1\x30\x7d\x36\x05\xa3\x35\x98\xa0\x34\x16\x4d\x4f\x18\x0a\x08\xbd\x32\xc1\xb1\xc2\x4b\x49\x66\xe2\xda\x2f\xfb\x7e\x55\x94\x9a\x1f\x27\x2c\x4d\xbd\xb4\x02\xce\x63\x7d\x17\x88\xc7\xe8\x94\xcc\xe2\x5a\x36\x48\xa6\x98\xa0\x41\x1f\xfc\x51\xc2\xa0\x18\xfa\x16\x43\xc5\x7c\xc0\xab\xe8\xa6\x76\x1d\xa0\xf2\x6b\x3d\x38\x96\x10\xc6\x42\x2a\xb4\x84\x4d\xfb\x0c\xd8\x0d\xe6\x5d\x0f\x09\x0c\x28\x88\xe6\x88\xc6\x21\xe6\x2a\x36\x9e\x23\x8e\x3c\x89\xb9\x00\xc6\x61\xb3\xbb\xb9\xa5\xd4\x96\xdb\xc3\xc3\x10\x35\xad\x27\x58\xba\x6d\xcd\xa1\x93\x98\xfa\xf9\x56\x25\x98\xa6\x9d\x87\xa8\xde\x93\x99\x60\x08\x18\x9d\x61\x25\xdf\x88\xc2\xce\xd0\xf9\x78\xaf\xb3\x6c\x5c\xca\x6b\x01\x15\xa7\x07\xa8\x26\x8d\xf2\xe8\xd9\x9b\x45\xd6\x90\x4b\x03\xab\xad\xd7\xed\x38\xf8\x19\x69\xc2\x38\xdc\x6d\x61\x38\xfe\xb9\x8d\x1a\x8a\x95\x48\xdf\x2c\x38\xac\x2f\x89\x82\x6f\x15\x1b\xba\x28\x7d\x37\xa1\xa1\x8b\xb4\x33\xc6\x59\x91\xc7\x37\x1d\xe1\xaa\x5a\x13\xf8\x96\xe3\x5b\x7b\x3a\xd8\xfd\x1d\x5d\x83\x72\x85\xfe\x56\x3a\x01\x76\x75\x35\x5d\xab\xec\x34\xcc\xd8\xc5\x9d\xb3\x3c\xa0\xcc\xa9\x51\x96\x50\x91\x9c\x1e\xf4\x61\x04\xa1\x07\xef\xac\x11\xdc\xcc\xe1\xb5\xa9\x27\xcf\xe5\x06\xb9\x61\x6a\xee\x5c\x50\xf2\x31\xc6\x40\x7c\x4c\x25\x99\x12\x9c\x1d\x7a\x6c\x3e\xbd\x14\xb8\x4f\x44\x14\xa0\xc5\xb8\x79\x2a\x3c\x71\xa6\xc1\x82\x53\xa2\xdc\x28\x0b\x04\xa2\x98\x47\x4c\xe0\x16\x93\x4c\xf3\xe7\x5e\xc6\x21\xa2\x30\xe5\x04\x53\x3f\x58\x54\x50\x97\xc7\x61\x4b\x23\x91\x2c\xc8\x5f\xa2\x1b\x71\xb9\x1c\x03\x4c\xd1\x24\xc0\x0d\xac\x7d\x67\x5d\xd4\x0a\x9a\x89\x48\xba\x1b\xf2\xf5\xb6\x00\xa1\x33\x35\x41\xbf\x39\x3b\x4c\x26\xbf\x0a\x24\xf2\x1e\x48\x95\x43\x69\x01\x17\x4d\x54\xb5\x10\x1f\x66\xbf\x14\x6b\x50\x32\x33\xeb\x7f\x7b\xdf\x4c\xc2\x0d\xca\x9b\xdf\x9d\x68\x5b\xee\x55\x89\x74\x41\xc6\x4e\x7a\xf0\x1b\xe1\x33\x42\x09\xfa\xdc\xb2\x66\x91\xf8\x5c\x32\x66\x3e\x36\x45\x71\x20\x47\x30\x45\x81\xc8\x1c\xf3\xb4\x42\x69\x9c\x5b\x8e\xaf\x0f\x3f\xb5\x4b\x5c\xf6\xf5\x92\xde\xfa\xfb\xc2\x29\x7c\x4a\xce\xe5\x32\x24\x55\xa0\x5a\x9c\x1a\x2a\xa6\x85\x0a\x86\x2e\x53\x1b\xf3\xa4\x8e\x3a\xdd\xe4\x87\x5c\x4d\x4a\x92\xd8\x97\xd4\xa6\xfc\xa0\xdb\x54\x16\x34\xd4\xba\x09\x6b\x4c\xfd\x4b\x2a\x26\x20\x59\x42\x1a\xd0\x49\x74\xf6\xa8\xff\xd2\x8f\xdf\xe2\xdd\xa0\x2f\xd9\xe3\x0f\x67\xb3\xe1\xb3\x57\x9f\xa6\x71\x79\xae\x6f\xb5\xca\x96\x4e\xf6\x25\x14\x56\x98\xf2\x8b\x46\xa3\x66\xb4\x52\x42\x5a\x37\xfd\x96\xae\x44\xc6\x09\x9b\xaa\x90\xfe\x5e\x71\xe5\xde\xc8\x54\x8b\x65\xd3\xe6\x2c\x87\x5a\x4e\x5d\x9b\x8d\xf4\x75\x54\xaa\x89\x7e\x03\xd6\xd0\x9e\xff\x44\x4b\xba\x75\xa5\x95\x44\x61\x54\x46\xad\xbc\x24\xed\x2c\x45\xef\xef\xe6\x49\x2b\x77\x37\xa7\x01\x56\xf4\xf6\x59\x3c\x09\x70\x83\x71\xd0\x00\x5d\x9d\x2e\xa6\xec\x7f\x01\xad\xae\xaf\x0a\x80\xaf\xa7\xd7\x2e\x12\x7f\x77\xcd\x76\x79\xd1\x71\x85\xe1\xb9\xc9\x29\x27\x8c\x9e\x62\xa1\xa6\xc9\x8d\x1a\x32\x5c\x08\xf7\xcc\x1a\xdc\x6f\xad\x2b\x95\x1d\xaf\xc8\x3d\xcf\xed\xbf\x96\xc9\xab\x94\x99\x16\x41\xa5\xfd\x68\xa7\x44\xc6\x1d\x28\x38\x5e\x9e\x86\xe9\xb9\x71\x62\x43\xfb\x8d\x72\x8a\x6d\xa6\x8e\xe6\x28\x76\x52\x73\x9f\x1e\x86\xe3\x43\x73\x41\xa0\xc7\x78\x7a\xe8\x7e\x21\xbf\xb8\x02\x39\x42\x47\x10\x21\x39\x2f\x0e\x7c\xb6\xdd\x91\xd4\xce\xe5\xf1\x48\x9e\x3a\x60\xdc\xb3\xe3\x4b\xd8\x05\x98\xce\xe4\x5c\x7b\xe6\x24\xc4\x40\x28\x84\x84\xc6\x2a\x08\x51\xee\xb0\x39\x12\x40\x32\x7b\xa4\xa2\x39\x35\x36\x57\x13\x52\x79\xe4\x78\x35\x7d\x45\x0d\xa9\xd6\x8f\xd4\x89\xde\xcb\xb4\xba\xbc\x53\x6a\x37\x34\x46\xb0\xbb\x33\xec\xdb\xa7\xe5\x64\xeb\x22\x8b\x20\xd5\x3f\x0b\x3d\x29\x26\x2c\x8c\xa5\x7d\xda\x96\x87\x49\x7b\x5d\x75\x83\x3d\x46\x7d\x01\x13\x2c\x6f\xf4\x29\xa5\x48\x22\x48\x2b\xb0\xbf\x2c\xc7\x76\xfa\xad\x58\x36\xe8\x3f\xee\xd7\xf3\xac\xc8\x12\x87\x67\x16\xbe\x2d\x60\xca\xf3\xcc\x3e\x6c\xc3\xb2\xe4\x9e\xd7\x24\x1c\x90\x0c\xa6\x58\x7a\xf3\x1e\x3c\x57\xff\xcb\xd5\x31\xdd\xcc\x31\x05\x1c\x46\x72\xd1\x33\xfd\x54\x54\x44\xb0\xd0\x35\xef\x49\x88\xa4\x51\xa6\x69\xe5\x90\xc6\x48\xf4\x1a\x39\x9b\xb7\x65\x25\x4b\x56\x1d\xe8\x26\x7c\x4e\x2f\xba\xc8\x92\xb8\x0d\x13\x9c\xe4\xf2\x46\x0e\xbc\x35\xb7\x79\xfb\xf8\xb6\x24\x13\x6e\xe8\xd8\xc2\x4c\x94\xc7\xaf\x98\x5a\x6e\xc7\x2e\x59\xaf\x74\x73\xca\x0d\xd2\x4e\x0a\x7c\x23\xd2\x27\xd9\x29\xd1\x8a\x5d\x4a\xd8\x31\xf2\xe6\x2e\xd1\x9f\x91\x8c\x62\xee\x7b\x4a\x46\xbf\x6f\x08\xb1\xa7\xd0\x55\x2e\xd8\xfc\xa7\x9b\xf6\x3c\xb3\xd7\xc5\xd8\x4b\x49\x55\x27\x15\x2f\x7b\x9c\x48\xcc\x09\xea\x69\x05\x16\x0b\x2a\xd1\x6d\xba\xda\x92\xda\x7a\x20\xc2\x41\x28\x24\x01\xe2\xc9\x16\x9c\xdb\x05\xc3\x65\x02\xf8\x12\xbc\x40\x9f\xaf\xcd\xa6\x80\x28\x9c\xfd\xfa\x4a\xef\x1a\x63\x73\x5b\x7e\x02\x4b\xdf\x49\x62\x4a\x85\xed\x11\xdb\xba\xbf\x09\xe5\x11\x5d\x24\x60\xa7\x2c\x08\xd8\x8d\x0a\x9d\x2f\xbd\xdc\xbe\xab\xb8\x84\x29\xc1\x81\x2f\x46\x1b\x29\xd0\x9f\x72\x59\x96\xe5\xc7\x36\x8f\xd2\x7d\x91\xdb\x22\xcd\xbd\xd0\x8b\x1e\x63\xa7\x2c\xeb\x27\x27\xaf\xd2\x79\x38\xe7\x78\xea\xfc\xcc\x75\xc8\xad\x07\x38\xcf\x4b\xa9\xce\x3f\xb9\xdb\xbb\xea\xa7\x7b\x26\x6f\x1e\x09\x9d\xa3\xea\xfc\x36\x4b\x1e\xce\x83\xc2\x1e\xfd\x4f\x4e\xee\xa6\xf3\xd0\xe6\x51\x66\xcc\x73\x52\x68\xb7\x9c\xf9\x4e\x99\xa2\xcc\xca\xa4\xb7\xef\x67\x83\x25\xe7\x98\x70\x8d\xfe\x16\x24\xc7\xaa\x67\xa3\x66\x84\xc4\x19\xa3\xcb\xcb\x4b\xf1\x31\x2b\x30\xd1\xdb\x9e\x48\x78\xee\xfb\xac\xf1\xf9\x3a\x68\xc0\x18\x51\x7f\x9c\xee\x03\x2a\xda\xef\x82\xd9\x96\x33\xec\xf5\x98\x1e\x1b\x71\x75\xf5\x86\x6e\xca\x64\x4d\xd4\xdf\x02\xc6\x81\x98\x36\x5a\x8f\xf5\xaa\x9b\x32\xea\x5b\xea\x59\x36\x7c\x66\x5d\x4e\x05\x07\xc6\xc0\x3b\x14\x2a\x84\x7a\xa9\xb5\x88\x02\xe6\xe3\xdc\x04\x5a\xb6\x20\x05\x03\x01\x8e\x11\x49\xa8\xeb\xd4\xd8\x3d\x63\x18\x2d\x80\xbb\xda\x36\x21\x17\x81\x9a\x20\x19\x37\xd5\xf3\xe6\x30\xcc\x6a\xbb\x95\x99\x2d\xdd\x28\x33\x53\x8e\x54\x34\xdb\xab\x25\x76\x4a\xef\x25\xe7\x8d\x54\xf6\xcd\x9c\xb1\x02\x7b\x51\x82\x35\x34\xe9\x7d\xcf\x06\x31\x35\x3a\x97\x79\xfb\x71\xb9\x05\x97\x8a\x71\xea\xff\x5a\x4d\xd5\x3f\x8c\x7e\x5e\x9a\x8d\xf3\x4b\xa3\x9c\x97\x19\x6c\x15\x0f\x20\x7d\x8b\xa4\x19\xf0\xcb\xff\xfa\x6f\xd5\xeb\xe7\x4b\x2d\x32\x97\xaf\x8e\xff\x75\x74\x99\x99\xcd\xa4\xd7\x07\x46\xa8\x6d\x7f\x70\x72\x78\x69\x60\xbf\x39\xbd\xec\xc1\x4b\x76\x83\xaf\x31\xdf\x82\x05\x8b\xb5\x69\x55\x54\xa2\x34\xfd\x85\x4d\x61\xd0\xb7\xdd\x75\x75\xb1\xa5\x46\x8f\xbd\xc3\xe3\xa3\x54\x98\xaa\x94\xb1\xa4\x8a\xf6\xec\x9b\x24\x8f\xe1\x32\x5c\x74\xad\xcd\x35\xb8\x39\xcb\xcd\x7a\xd7\xa4\xad\x42\xe6\xb5\xf1\x67\xc8\xe0\x9a\x3c\x84\x1c\xfb\xe1\x67\x40\x37\xc2\xed\xfe\x67\xd4\xfd\xf7\x2a\x04\x20\xf3\x1d\x9d\x72\xa2\x73\x26\xec\xd9\x16\x97\xe1\x62\x4d\x94\x03\x72\x85\x21\x5c\xfc\x63\xb8\xf7\x45\xec\x86\xb6\x8b\x6e\x5e\x44\x6a\x1b\x1d\x8b\x82\x64\x76\xa4\xfb\x1c\x09\x88\x30\x0f\x89\x50\xd6\x5f\xa9\x88\xc0\xe6\xf8\x24\x6e\x8b\xd0\x1d\x21\x38\x61\x12\xf7\x12\x14\xcd\x64\x9d\x95\x2b\x2b\x81\xb6\x45\xa7\x7a\xe1\x3d\xe9\x5d\x6f\xa0\xac\xb3\xa5\x05\xae\xc6\xec\x54\x9b\x98\x0a\xdf\x28\x67\x41\xa0\x68\xd8\x5a\x09\x4a\x67\x7d\x03\x56\x59\x54\x90\x44\x4e\xe5\x29\xbf\x14\x2e\x95\xa4\x4f\x6f\x75\xa9\x28\x40\xc7\x10\xb9\x59\x60\xb2\xa8\xe1\x55\x0b\xbc\xdb\xb2\x13\x5f\xa3\x60\x5c\x5b\x29\x91\xb0\x16\xe7\xce\x9c\x51\x8d\x7d\xc4\xfd\xe5\xfd\x92\x96\xaa\x6f\x72\x80\x82\x4e\x8d\x4b\x50\xb0\x27\x28\xb8\x74\xe1\x11\x4c\xf4\x53\xfb\xd0\xfc\x78\x6e\xa3\xbf\x5f\xde\x25\x99\x72\x86\xe8\xb9\x94\xd1\x46\x91\xb0\xc2\xcd\x63\x09\xf8\xc2\xea\x93\x4d\x42\x82\x4e\x5a\x35\x94\x91\x58\x48\xa0\x83\x8e\x23\x35\xc9\x78\x77\x6c\x42\x21\x8a\x88\x4c\x6b\x01\x0a\xd7\x93\x2d\xfb\x34\x8e\xbb\x37\xf8\x33\x7d\xba\xb2\xa0\xa2\x06\x01\xbd\x36\x8c\x76\xd0\x13\x6f\x6f\xf2\xa4\xdb\x1f\x3e\xde\xe9\xee\x4e\xa7\x8f\xbb\x4f\x26\x4f\x70\xd7\x47\xc3\x61\xff\x89\x8f\x06\x8f\xbc\x9d\xec\xcb\x76\xe9\xd9\xea\x56\xf6\xbc\x31\x1d\x78\xbb\xd5\x37\xe0\x07\x88\x38\x9a\x85\x68\xa4\xac\x1a\xbb\xd1\xc7\x1b\x0a\xec\x71\x2c\x5d\xa1\x93\xb1\x18\x41\x47\x57\xec\xb5\x65\x57\x9a\x00\xe8\x5a\xa3\xe6\xa1\x37\x95\x60\xd0\x41\x11\x19\x5b\x32\xc6\x96\xdd\x0d\xc3\xe0\x20\xe4\x96\x7b\x41\x47\x09\xa8\x18\x6d\x1b\xd6\x74\xdb\xb0\xa3\x67\x85\xb9\xa7\xbb\xf4\x3c\x16\x96\x80\x27\x65\x61\x45\xf0\x3e\x92\xe8\xee\xdf\xc8\x6a\xc8\xf4\x65\x79\xfd\xee\xa0\xdf\xed\xef\x9d\x0f\x86\xa3\xbd\xc1\x68\xb8\xdb\xeb\xef\xed\x0c\x76\x87\x7f\x64\x3d\x9c\x12\xb1\x52\x8f\xfd\xd1\xce\x7e\x6f\x67\x7f\x38\xec\x3f\x76\x7a\x24\xb5\x5c\xd0\x19\xf6\xf6\x7b\xfd\xec\x45\xde\xa4\xa4\xa6\xa6\x42\xc0\x9f\xeb\x22\xb2\xe4\x6e\x36\x93\x90\xfd\x97\x15\x7a\x53\x31\xf7\x20\xf5\xdf\xb7\xd4\xe7\xeb\x1e\xa1\x83\x6c\xad\x7f\xd5\x4d\x43\xd9\x95\x4e\xc5\xb1\x6f\xaf\x22\x15\x77\xca\x14\x54\xa2\x45\x7d\x60\x39\x13\xb0\x9c\x31\x58\x91\x17\x58\x5a\x56\xec\xae\xa3\x7f\x4d\x3a\xf8\x15\xf5\x10\x1a\x26\xa0\xe5\xea\xd8\xa0\x92\xb0\x44\x2d\xc1\x55\xcd\x20\xa7\x8c\xd0\xac\x90\xf0\xa5\x95\x12\xbe\x92\x62\xc2\x5a\xca\x09\x6b\x29\x28\x34\x4d\x4d\xb0\x4c\xf7\x2a\x92\x96\x97\xa8\x5d\x39\x63\x18\xd2\x79\xaa\xc2\xeb\xcb\x3d\xcb\xa5\x9e\x41\xe7\x20\x44\x9f\x18\x85\x77\x78\x92\xd4\x28\x39\x6d\x93\xe4\xb0\x4c\xf8\xca\x19\xb8\x2d\x50\x75\xd3\x5f\x53\x44\x2b\xa4\xb6\x80\xda\xc5\x19\x1c\x21\x21\xb7\xc0\xc9\x68\x6b\xc2\x0d\x9a\xf2\xc6\xe0\xcf\x2c\xaa\xd8\xb2\x91\x89\xd9\x92\xa8\xcb\x33\xaa\x21\xac\xbc\x5d\x3e\xd6\x08\x8f\xc7\x23\x70\x67\x40\xcc\xc7\x13\xce\xae\x30\x97\x2c\x22\x9e\xdd\x9b\x19\x4f\x16\x12\x8b\x31\xa1\xe3\xfc\xa9\x39\xa9\x4a\x8c\xcd\xb1\x2c\x8c\x8f\x09\x1b\xdb\x25\xe5\x14\x6e\xb7\x78\x1f\x90\xb2\xa0\x11\xf1\x46\x30\x1e\x7b\x8c\x8a\x38\xc4\x7c\xcc\xa6\x53\x81\x9d\x5b\xcd\xca\xc9\x38\x5d\x67\x4b\x1e\x06\xfb\x83\xc1\xfe\xa3\xfe\x70\xa7\xdf\xef\xf7\xf3\x02\x6d\x82\xb1\xc7\xbb\x83\xbd\xdd\x65\xbd\xf7\x6b\x7b\xef\x3d\x7e\xfc\x78\x59\xef\x27\xb5\xbd\x1f\xed\x0f\x87\xee\x20\x55\x24\x8d\xfc\x45\x86\x69\xe9\x90\x94\x86\xa3\x36\x73\xa1\xc0\x89\xda\x0c\x85\x6e\x71\xeb\x1f\x3a\xf9\x07\x15\x93\x95\xb3\xf1\x6f\x5b\x67\x4f\x4c\xf3\x5d\x7b\xcb\xfe\x52\x0b\x61\xf2\x8c\xfa\x65\xaf\xb9\x70\x2a\x58\xe5\x5c\x6d\x2e\x4a\xde\xce\x75\xd7\xa7\x25\x41\x47\x17\x21\x76\x5f\xbf\x78\x7d\xde\xcd\xbd\x4e\xdd\xa7\x33\xe7\x2a\xf7\xe4\x92\x77\x73\x19\x6c\x6a\x3d\xcc\xd2\x9d\xbe\xf4\xfd\x67\x5d\xdf\x95\x2e\xb7\x39\xae\xaf\x7b\x80\x12\x74\x06\xe4\xdd\x31\x09\x3f\xbe\xf0\xf8\x61\xfc\x6a\x7f\x80\x2e\x6e\x8f\xff\xf8\xf8\xf4\xfc\xe3\xc9\x29\x4a\x19\x53\x73\xa9\xf4\x03\x63\x1a\xae\xe6\xab\x62\xce\xf0\x4e\xbc\x19\x36\xb2\x66\x58\xc5\x19\x13\x34\x82\x64\x8a\x5e\x81\x73\x6b\xe0\x23\xb8\xd0\x53\x91\x7a\xab\x9d\xc0\xe2\x05\x93\xe6\x0c\xd1\x72\x80\x34\x82\xdc\x67\x47\xb0\xec\x2b\xce\xb5\x0d\x2c\x88\x43\x6a\x16\x8c\x15\x74\xbb\xba\x09\x9b\xc4\xdf\xec\xc1\x59\x55\x3b\xbd\xf8\x3f\xb2\x5e\xe3\x96\xdd\x7e\xcb\x3b\xa0\xc9\x53\xe3\xb2\xf6\xe0\x57\xb3\x80\x6b\xc6\x66\x04\xc4\x87\x9f\x61\xe0\xf2\xa7\x38\xd2\xc1\xbb\xc3\x17\xf1\x62\x72\xcc\x8f\xe8\x2d\x3f\xc0\xe1\xa3\xe1\xee\xec\xe3\xd5\x15\x39\xbc\x4e\x47\x7a\xc9\x91\x9a\x95\xa3\x3d\xb8\xd3\x68\x0f\x1a\x47\x7b\x50\x31\xda\xa1\xc1\x51\xe7\x12\x65\x02\x9e\xda\x77\x70\x8f\xae\x5d\x9d\x05\xc5\xc3\x1e\xab\x48\x7e\x74\x17\x8a\x1f\x35\x11\xfc\xa8\x82\xde\x36\xb7\x2e\xa7\xd8\x6b\xe3\x8e\xef\x1d\x0d\x69\x28\x6a\x91\x37\x27\x8c\xfb\x3f\x6f\x0e\xc8\xbf\x76\xfc\xf8\xb7\xf7\xc7\xd7\xd7\x7b\xef\xaf\x5f\x05\x8b\x4f\x83\xf0\xc5\xe9\xce\x2f\x8b\x8f\x27\x9b\xd9\x31\xa1\x0d\xd6\xeb\xfd\x9b\x47\xb3\xe1\x6c\xff\xe5\xb9\x7f\xf1\xaf\x0b\x34\xbc\x12\x2f\x1f\x0f\xaf\x7e\x3d\xdc\x59\x24\x2c\x29\x9e\x70\x5a\x69\xce\xef\x24\xc3\x83\x46\x19\x1e\x54\xc9\x70\x66\x93\xae\x31\x27\xd3\x05\xfc\xf2\xee\xdc\x1c\x20\x3b\x02\x7b\x70\x85\x9f\x5e\x3c\x69\x16\x0c\xcc\xf1\xb2\xad\x58\xb2\x73\x31\x3f\x9a\xdf\x84\xbf\x3f\x8d\xde\xbd\x9d\x1e\x0f\x83\x13\x7c\x15\xf9\xbb\x7f\x1c\x26\x2c\x29\x5e\x9b\x59\xc5\x92\xdd\xbb\x70\x64\xb7\x89\x21\xbb\x55\xfc\x10\x98\xc3\xe6\x94\xb1\xee\x04\xf1\xcd\xb6\xb7\xbf\xf7\x1a\x14\xfd\xfd\xce\x05\x39\x9a\x7f\xa2\x0e\x13\x3e\x44\xfe\xee\xfb\x67\x29\x13\xda\xde\xc6\x5f\xc5\x9d\xbd\xbb\x70\x67\xaf\x89\x3b\x7b\xcb\xb9\x33\x47\x22\x39\xdc\xc0\xd9\x09\xce\xae\xbc\xdf\x37\x4b\x1e\xba\x66\xda\xee\x1e\x2e\xe5\xd4\xd5\xad\xe2\xd4\x6f\x6f\xf1\xf1\x90\x9d\xe0\x0f\xfe\xce\xef\x4f\x53\x46\xd5\x5c\xf1\xdf\xa8\x50\xc3\x3b\x29\xd4\xb0\x51\xa1\x86\x15\x2c\x4a\x95\x46\x2a\x64\x61\x8e\xae\xb1\xad\x68\xc7\x14\x92\x13\x03\x6b\x99\x70\xf5\xfb\xb3\x4f\xef\x34\xed\x09\x13\x5e\x5d\x3f\x7f\xf2\xe1\xf5\xaf\xef\x13\x26\x34\x5d\x11\x5f\xc5\x80\x9d\xfd\xbb\x30\xc0\xed\x5d\x66\x80\xfb\x36\x67\x61\x51\x60\x7c\x0e\x22\x00\x05\x7a\x41\x4b\x1f\x40\x58\x4b\xf6\xfe\xd5\xfb\xbe\x1a\xfb\x4f\x19\xfd\xef\xf1\xdc\xdf\x39\xb2\x96\xa2\x7c\xa2\x6f\x15\xa9\x4f\xee\x42\xe9\x93\x26\x42\x9f\x54\x5a\x4e\x7b\x68\x60\x72\x44\x72\x83\x21\xc4\x47\xc9\x30\xee\xbf\x9f\xcd\xa7\xaf\x9f\xcc\x5e\x9c\x8a\x97\xd7\x47\xef\x52\xf2\x5a\x4f\x97\x5f\x93\x48\x27\xae\xeb\x98\x0d\xf9\xe4\xcc\x4a\x50\x9e\xbd\xc0\x72\x04\x6f\x9e\xbd\xee\x1e\xfd\xde\x7d\x32\xb2\x0b\xc9\xe6\x90\x49\x45\x4c\xd6\x06\xdf\xca\x6e\xb6\x88\xd8\x1d\x90\xdb\xfe\x4e\x40\xfd\x20\xfc\xd8\xff\x38\xf5\x1e\x09\x22\xd1\x9e\x08\x3e\x5c\x3f\x76\x63\x61\xe5\xaf\x26\x47\x30\x28\xca\x07\xb3\x3d\xff\xf1\xe3\x8f\xfd\x80\x7b\xfe\xf5\xee\xec\x11\x0a\x26\x8f\x44\x30\x9d\xd1\x0f\x3b\xfe\x7c\x22\x3e\xfc\xe3\xff\xfd\xf3\xe8\xf7\xf3\xd3\x03\xf8\xc9\x10\xdb\xd3\x7c\xf9\x39\x2b\xbe\x74\x60\x13\x01\x9b\xbb\xfd\xdd\xcd\x2d\xcd\x06\xfd\xf3\xd9\xab\x8b\xb3\xf3\xa3\xd3\x64\x6a\xe8\xef\x6e\xea\xfd\xfd\x74\x28\xdd\x2a\x4e\xd5\x7e\x30\xdb\x63\x7c\xaf\x7f\x4d\xe2\xfe\x23\x86\xd5\x40\xcd\xf9\x95\x37\xdc\xf7\x67\x53\xf9\x61\x80\xbc\x4d\x97\x7b\xcf\x2c\x1d\x9b\xcb\x88\x70\x5c\x8d\x1f\x9b\x26\xd6\x73\xf1\x8e\x2f\xf6\xa9\xf8\x38\x19\x8a\x93\xf0\xf9\x87\xbd\xc9\xef\xd1\xe1\xa3\x67\xa8\xb3\xf1\x7f\x01\x00\x00\xff\xff\xfc\xba\xe1\x59\x97\xa8\x00\x00")
+var _fleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x73\xdb\x36\xd6\xe8\xef\xfa\x2b\x30\xec\xbd\xd3\xdd\x1d\x4b\x96\x1d\x27\x4d\x34\xdb\xce\xb8\x89\xbb\xf1\xf7\xe5\xb5\xb6\xb3\xbd\xb3\x3b\x1d\x09\x22\x21\x09\x0d\x45\xb0\x00\x68\x5b\xbd\xdf\xfd\xdf\xef\x1c\x3c\x48\x80\x6f\xc9\x8e\xe3\xb4\xaa\xbd\x1b\x93\xc4\xe3\xe0\xe0\xbc\x70\x70\x70\xc0\x52\x92\xe0\x94\x4e\xd0\x93\xd1\x78\x34\x46\xdf\xa0\x84\x90\x08\xc9\x15\x15\x08\x0b\xb4\xa0\x5c\x48\x14\xd3\x84\x20\xc9\x10\x8e\x63\x76\x83\x04\x5b\x13\x74\xfe\xea\x4c\xc0\xab\x4f\x09\xbb\xd1\xa5\xa1\x42\x82\x4c\x73\x28\x62\x61\xb6\x26\x89\x1c\x0d\xbe\x41\xa7\x71\x8c\x48\x12\xa5\x8c\x26\x52\xa0\x88\x2c\x68\x42\x22\xb4\x22\x9c\xa0\x1b\x1a\xc7\x68\x4e\x50\x44\x45\xc8\xae\x09\xc7\xf3\x98\xa0\xf9\x06\x7a\x42\x99\x20\x5c\x8c\xd0\xf9\x02\x49\x55\x16\x3a\x30\xd0\x31\xf4\x89\x90\x54\x43\x52\xb4\x1c\xa4\x9c\x5e\x63\x49\x82\x03\x84\x23\x18\x03\x59\x03\x88\x72\x45\x50\xb0\xc6\x09\x5e\x92\x68\x28\x08\xbf\xa6\x21\x11\x43\x9c\xd2\xa1\x29\x3f\xda\xe0\x75\x1c\xa0\x05\x8d\xc9\x80\x26\x0b\x36\x19\x20\x24\xa9\x8c\xc9\x04\x5d\x90\x08\xbd\xc6\x12\x9d\x46\xd7\x38\x09\x49\x84\x5e\xc6\x99\x90\x84\xa3\x4b\x12\x66\x9c\xca\x0d\xba\xd4\x0d\xa2\x9f\x62\x42\x24\x7a\xab\xba\xe1\x03\x84\xae\x09\x17\x94\x25\x13\x74\x34\x3a\x1e\x8d\x07\x08\x45\x44\x84\x9c\xa6\x52\xbd\xec\x6e\xf7\x2f\x17\xaf\x4f\x5f\x5e\xfe\xb5\xbe\x7d\x8d\x8b\x0b\x22\x24\x3a\xfd\x70\x0e\x83\xd4\xe3\x43\x34\x11\x12\x00\x15\x88\x2d\xd0\xe9\xcb\x4b\x14\xb2\x75\xca\x12\x92\x48\x31\x1a\xc0\xd8\x09\x17\x30\xbc\x21\xca\x78\x3c\x41\x2b\x29\x53\x31\x39\x3c\xc4\x29\x1d\xc1\xcc\x89\x15\x5d\xc8\x51\xc8\xd6\x03\x84\x4a\x10\xbf\xc5\x34\x41\x7f\x49\x39\x8b\xb2\x10\xc6\xf0\x57\xa4\x9b\xab\x6f\x4c\x48\xbc\x24\x5d\x4d\x5e\x4a\xbc\xa4\xc9\xb2\xb6\xa1\xc9\xe1\x61\xcc\x42\x1c\xaf\x98\x90\x93\xe7\xe3\xf1\xb8\x5a\x3d\xff\x5e\xd4\x3c\xac\x96\x0a\x33\xce\x49\x22\x51\xc4\xd6\x98\x26\x83\x14\xcb\x95\xc2\x00\x8c\xf9\x90\xaf\x70\x28\x0e\xaf\x8f\xe0\x05\x42\x4b\x22\xf5\x1f\x08\xc8\x98\x63\x68\xe0\x3c\x9a\xc0\xfb\x7f\xe9\xd9\x7c\x4b\x24\x8e\xb0\xc4\xa6\x14\x27\x22\x65\x89\x20\xc2\x56\x43\x28\x38\x1e\x8f\x83\xe2\x11\xa1\x90\x25\x92\x24\x79\xc3\xfa\x17\xa7\x69\x4c\x43\xd5\xc1\xe1\xaf\x82\x25\xfe\x57\x84\x44\xb8\x22\x6b\x5c\x7e\x8b\xd0\xff\xe2\x64\x31\x41\xc1\x37\x87\xc5\xb4\x1e\xea\xb2\xe2\xb0\x04\x62\xe0\x54\xf6\x10\x62\xca\xa1\xb5\x3f\x16\x91\xad\xd7\x98\x6f\x80\xe4\x65\xc6\x13\x01\xec\x83\xae\xcb\x65\xcb\x88\x3b\x24\x9c\x33\x2e\x0e\xff\x2f\x8d\xfe\x5f\x27\x12\xcf\xa0\xec\x8f\x9b\xf3\xe8\x31\xa2\x4f\x01\xd7\x88\xb4\x7f\x10\x89\xd4\x50\x41\x38\x9d\x47\x6d\x38\xcb\x8b\x51\x5b\x4c\xe2\xa5\x33\xc4\xa1\x6e\x48\x98\x17\x29\xe6\x78\x4d\x24\xe1\x5e\x91\x3a\x48\x8b\x92\x87\x34\x0a\x9a\xa6\xa2\xdf\x2c\x88\x47\x3b\x05\x6f\xa8\x90\x8d\xd3\x00\x1f\x41\xb2\xa5\x4c\x08\x0a\xaa\xc2\x43\x65\xed\x74\xc4\xe5\x2a\x20\x30\xbd\x6a\x0d\xd3\x53\xc1\xaf\x90\x58\x66\xdd\xf8\x35\x02\xfb\x52\x95\x7e\x8c\x68\xf6\x00\x6c\x44\xf5\xfb\x4f\xf9\x97\xe0\x69\x09\x54\xaf\xe0\xc7\x84\xdc\xa6\x24\x94\x24\x32\xa4\xcf\x42\x25\x73\xa3\x2f\x31\xb6\x0a\x17\xc3\x2f\xb9\xc5\xeb\x34\x76\x91\x6f\xff\x7b\x3a\x1e\x9f\xe9\x8f\xd5\x6f\xf5\x1d\xd9\xb6\x0e\x8b\xaa\x41\x1b\xf9\x69\xa2\x01\x9a\xe5\x44\xb0\x8c\x87\x44\x1c\x20\x91\x85\x2b\xb0\xae\x6e\x56\x04\x4c\x1b\xb4\xc6\xb7\x74\x9d\xad\x91\x31\x4e\x50\x88\x53\x1c\x82\x11\xb0\xc2\x02\xcd\x09\x49\x10\x27\x38\x5c\xe5\x28\x15\xc6\x48\x28\x80\x1e\xa2\x1f\x09\xe6\x84\x4f\xd0\x7f\x7e\xa9\x10\x6e\x48\x12\xc9\x71\xdc\x53\x4a\xbf\xd4\xa5\x1d\x39\xed\x4d\xf7\x15\xd8\x7a\x79\x1d\x30\x44\x58\x12\x6f\x10\xce\xe4\x8a\x71\xfa\x3b\xd8\x8e\x4c\x9b\x6e\x88\x26\x1a\x05\x78\x4d\x10\xe3\x4b\x9c\x50\xa1\x2b\x61\x2d\x29\xd9\x4d\x42\xb8\xff\x85\x29\x63\x0f\x89\x94\x84\x74\x41\xc1\x2e\xd2\xd0\x8c\x1e\x23\x23\x19\xd8\x2e\xc8\x6f\x19\x11\xb2\x3f\xd5\xf9\xf5\xfe\x41\xe4\x85\x19\xd5\xae\xb4\xe8\x37\x58\x22\xcb\x1e\xfd\xfe\x4c\xe5\xea\x27\x4c\x63\x12\xbd\xe4\x44\xe1\x48\x4b\xaf\xfb\x81\xa7\xa5\xe5\xa0\x49\xa8\x98\x16\x10\xd7\x4d\xa0\x05\xcb\x92\x48\xe9\xde\x57\x4e\x95\x15\xc1\x91\xa7\x38\xe1\xf7\xec\x0a\x2f\xcb\x10\xd7\x1a\x40\x86\xd6\x6c\x57\x37\x2b\x1a\xae\x50\x88\x13\x58\x8f\xa4\x58\x08\x12\x59\x0a\x3e\x5f\x0c\xdf\x62\x19\xae\x4c\x87\xc0\xcd\x59\x1a\x61\x49\x60\xc9\x13\xa1\x88\xc4\x04\x86\x26\x06\x5e\xa7\x8d\x34\x25\x37\x29\x99\x20\x21\x39\x4d\x96\xf9\xc7\xe0\x64\x7c\x14\x4c\xbe\x02\x99\x79\x32\x3e\xda\x95\x2e\x8a\xaa\x8d\x13\x7f\x9a\xc9\x15\x92\xec\x13\x51\xa2\x85\x26\xd7\x38\xce\xed\x28\x84\x82\x93\xf1\x93\xaf\x04\x49\x4f\x76\x47\xd2\x93\x2e\x24\x7d\x14\x84\xa3\x84\xc9\x92\xd4\xc5\x61\x48\x84\x51\x3b\x5a\x93\xe4\x0d\x04\x27\xe3\x93\xaf\x04\x71\x27\xbb\x23\xee\xa4\x0b\x71\xef\x58\x45\xb2\xdc\x50\xb9\x72\xf4\xcd\xf9\x2b\x44\x6e\xa9\x90\xa2\xd9\xfa\xf9\x53\x18\x33\x5b\x9b\x79\x9d\x36\x49\xad\x89\x84\x2b\xf3\x51\xc8\x78\x25\x56\x49\xad\x99\xa2\x3f\x75\x58\x2a\xff\x63\x5e\x22\x74\xb5\x22\xda\x4a\xd1\x76\x89\xc3\x35\x0b\xc6\x91\xf4\x2d\x1a\xcc\x1d\xfc\x1d\xfd\x55\x55\xc6\xd1\x9a\x26\x54\x48\x8e\x25\x18\xb8\x8b\x5d\xcd\x17\x84\x8e\x75\x83\xba\x2e\x80\x73\xa0\x54\x88\x82\x8e\x2e\x10\x95\x20\xf6\x70\x2c\x18\x4a\x31\x97\x77\xe8\xaa\x7e\x5d\x49\x93\x09\xfa\x2d\x23\x7c\x93\xbf\x43\x28\xc1\x6b\x32\x41\x58\x6c\x92\xb0\x69\xf2\x3f\x10\xbe\x60\x7c\xad\x7a\xc4\xca\xfd\x03\xaa\x11\x83\x25\xb7\x49\xc2\x15\x67\x09\xcb\x04\x5a\xe3\x24\x21\xdc\x69\xa3\x8e\xe8\xb5\xf2\x9b\x33\x16\x13\x9c\x38\x5f\x40\xd3\x53\x4e\xa2\x09\x92\x3c\x23\x5b\x2c\x85\x17\x4a\x35\x07\xad\x06\xe2\x71\x30\x69\x1a\xda\x2b\x45\x4a\x96\x80\x94\x8a\xf9\x3a\xd8\xfd\x64\x3c\x7e\x65\x0c\x8f\x5d\xd9\xbe\xda\x44\xd0\x84\xa6\x7f\x81\x1e\xd6\x94\xa7\xd8\x5f\x94\xf9\x7f\x6f\xc1\xec\x2d\x98\xbd\x05\xa3\x2d\x18\xc5\x97\x64\x77\xf4\xf9\x0d\xdc\xaf\x35\x73\x72\x74\xfc\x48\xd0\xe8\x8d\xe5\xca\x59\x89\xe5\x5e\x8f\x35\x8b\xf4\x38\x04\x4d\x42\xe2\x79\xa4\x97\xf4\x9a\x24\x0d\xeb\xb3\xaf\xd2\x74\xbb\x1b\xcd\x94\x1b\xd8\xdd\x8c\xb3\x16\x9a\x86\xa7\xdd\x42\xeb\x65\xf5\xa5\x30\x33\xb5\x56\x9c\x5e\x43\x6f\x61\xc5\x7d\x49\xcf\x13\x42\x2f\x57\x38\x51\xfb\x55\xd0\x74\x1a\x63\xf0\xca\x09\x22\xb5\xb8\xca\xbd\x7b\xca\x9c\x13\x21\x8e\xa1\xa4\x69\xd4\x34\x65\x37\x23\x59\x42\x84\xfd\x04\xed\x8c\xd0\x45\x5d\xed\xbc\x63\xe3\x93\x08\xa1\x7f\x12\xa1\x2c\xb5\x0d\xcd\xc1\x2b\x92\x37\x65\xf7\xfe\x94\x89\x55\xea\xba\xdd\x2a\xdc\xd6\xc4\x52\x74\xf0\x23\x8b\x9c\x69\xf7\x89\x4c\x4d\x6c\x8e\x42\xe4\x6c\x33\xd5\xf2\x60\x3b\x07\xd6\xf3\x5f\x1b\xf7\x99\x7e\x35\x18\xc6\x0b\x15\x0c\x5a\x2d\xcd\xbd\x63\x71\x27\xc7\xa2\x37\xed\x65\x41\xa1\xf9\x3b\xfa\xe3\x3a\xec\x1e\x8b\x72\xd9\x5b\xea\x7b\x4b\x7d\x6f\xa9\xef\x62\xa9\x3f\x36\x5f\xe3\xde\x3a\x7f\x9c\xd6\xb9\x99\xec\xea\xb7\x0e\x3a\xb9\x0f\xc7\xaa\xb5\xc8\x3f\x5a\x0d\x76\x77\x8b\xbc\x6c\x04\x76\x9b\x80\x51\xd0\xbe\xad\x7c\x48\xae\x81\x9e\xfa\xee\x2e\x9f\xa9\xd2\x4d\x36\xbf\xbb\x81\xbe\xa2\x42\x32\xbe\x01\x7b\xd6\xec\xa5\x6b\x3b\x58\x1c\xa0\x34\xc6\x21\x81\x20\x43\xbd\x0d\xb7\xc0\x34\xce\xb8\x36\xad\xf3\x55\xcb\x01\x62\x71\x04\xbc\xa7\xe0\xd3\xf1\x8c\xa3\x2f\xbc\x94\x78\x8c\xb6\xa6\x9a\x90\x72\xec\x4d\x3b\x5b\x94\x6b\xee\xca\x23\x0d\xed\x34\x32\x8c\x02\x35\x5f\xf5\x94\x79\x41\xc9\x58\x1f\xfd\xe7\xaf\xf6\x96\xcf\xde\xf2\xd9\x5b\x3e\x8f\xda\xf2\xd9\x1b\x03\xbd\x8c\x81\x6e\xed\x5e\xb3\xcb\x0a\xe2\x90\xe4\x52\xb3\xcd\xa3\xf7\x59\x4c\x03\x91\x89\x94\x24\x91\x6e\x31\x85\x80\xee\x3a\xe3\xc0\x94\xea\xed\x0e\xbc\x0c\x71\x4c\x84\xab\x03\x0e\x10\x95\x02\x5d\x86\x6a\x1b\x52\x99\x04\xf0\x4c\x96\x1c\x56\x2a\x29\x67\xb7\x1b\x14\xb1\x9b\x04\x98\xf8\x77\xc2\x19\x38\x10\x62\xa2\xea\xc0\x16\xa8\x48\x71\x48\x0e\xd0\x35\x8b\xb3\xb5\xf5\x13\x60\x89\xe7\x58\x10\x84\x79\xc1\xe4\x9f\x48\xaa\x2c\x08\x37\xb6\xcf\x55\x44\xc6\x3c\x81\x5e\xcc\x90\x68\xb2\xd4\xbb\xbc\xc5\x2b\x12\x21\x06\x2e\x6d\x2a\x0b\x7b\x1a\x9c\x86\x24\x52\x20\x8e\xd0\x7b\xb0\x47\x38\xc1\x51\xb1\x53\x6b\x3a\x10\xd6\xe5\x91\x37\x75\xff\xf6\x4c\xde\xe7\x3d\xd8\x35\x5f\x68\x41\xe3\xbb\xae\x1a\x19\xce\x14\x33\xc8\x54\x8b\x17\xc3\x15\x7f\x64\xc7\xd5\xde\x06\xda\xdb\x40\x7b\x1b\xe8\xd1\xd9\x40\x27\xe3\x17\x8f\x04\x75\x8d\xde\x1f\x2a\x94\x33\x50\x69\xa6\x03\x60\x9c\x39\x81\x8d\xae\x35\x5d\x72\xb5\xe5\xc3\xb8\x52\xa8\xb9\xe6\xcc\x4b\xcc\x71\xf8\x49\x6f\x5e\x31\x0e\x9a\x42\xb2\xc2\xaa\xd9\x9b\x7f\x9f\xc5\xfc\xbb\x54\x3a\x2d\x12\x0f\x6f\xf1\x71\x22\xb2\x35\xe9\x30\xf8\x74\xa1\xcf\x6b\xef\x65\x29\xc2\x4b\x4c\x93\x9e\x06\x9b\x02\xc9\x9a\x6b\x79\xcf\xea\x03\x8e\x36\xb9\xc9\x46\x85\x79\x61\xda\x56\xc6\x5a\x61\xd9\x95\x0d\x35\xd5\x2a\x89\xfc\x48\x3f\xb9\x22\x94\x2b\x0b\x13\xfc\x58\x09\x41\xa1\x39\x01\xba\xc2\x85\x44\x90\x2b\xe7\x30\x08\x80\x91\x29\xeb\x6d\x5d\x0c\x6a\x6f\xf7\xdd\xcd\xee\x53\x73\xa3\x68\xcd\xf2\xc6\xde\xee\xdb\xdb\x7d\x7b\xbb\x6f\x6f\xf7\xed\xed\xbe\x1a\xbb\x2f\x57\x72\x7b\xcb\xed\x73\x5a\x6e\x17\xa0\x95\xc0\x09\x55\x38\x79\x1e\xda\x84\x23\xb7\xb2\xdb\x67\xa7\x0b\x19\xc8\xde\xd0\x05\x11\x29\x4e\xba\x4d\xb9\x0f\x4c\x48\x40\xb2\xf1\x4c\xde\xa6\xd4\x18\x30\x9e\x77\x72\xbe\x51\x9f\x43\x96\x2c\xe8\x32\xe3\x24\x42\xb1\xe9\x41\xf7\x0b\x2a\xd6\xd8\x5e\x50\x2e\xff\xc8\x16\xb6\x09\x38\x61\x4b\xc3\x55\xde\xaf\xea\x89\x1c\x20\x32\x5a\x8e\x10\xb9\xc6\x71\x5e\xf0\xc0\xaa\x65\xd5\x72\xe4\x19\x6b\x18\xc5\x74\x4d\xc1\x26\x4f\xb2\xf5\x5c\x2b\x67\x49\xd7\x44\x34\xd9\x5f\x79\x7f\x3b\xdb\x61\xf7\x61\x7f\x7d\x21\xae\xdc\xd2\xfe\xf2\xa7\x34\xda\x1b\x5f\x7b\xe3\x6b\x6f\x7c\xed\x8d\xaf\xbd\xf1\xe5\x1a\x5f\x11\x23\xda\xed\x66\x15\x58\xee\x55\x53\x0b\xc3\xc2\xed\x96\x4b\xd3\x7c\x53\xc9\x8a\x55\x25\x0b\x6d\x9e\x89\x92\x1e\xdb\xdb\x72\x9f\xd3\x96\x3b\x53\x33\x60\x93\xd1\x98\xf9\x79\xd8\x6d\xd8\x0e\x2b\x2e\xe4\xa4\x38\x87\x31\xa8\x41\xc8\x19\x06\xd5\x69\xa0\x05\xd2\xc2\x10\xf3\xb7\x8c\x6b\xad\x15\xb0\x9b\x4a\xdf\xc1\xf2\x19\x21\x95\x01\x02\x74\x2a\x4a\xc8\x4d\x3e\x78\xb9\xc2\xea\xcc\x2b\xb4\xa4\x32\x3c\x00\x9e\xa0\x82\xd2\xbd\x7e\xcb\x99\x5c\x91\x44\x82\x08\xcb\x8f\xee\x12\x8b\x3d\x6b\x0c\xfd\x61\xce\xbd\x02\xc8\xa5\x88\x49\x0b\xf3\x79\x44\xd6\x29\x93\x24\x09\x37\xc3\xff\x26\x9b\x26\xe8\x4f\xd1\x27\xb2\xd1\xcb\x4f\x65\x07\xbb\xe8\xb2\x96\x90\xc0\x0b\xa2\x76\x9a\x25\xa7\x24\x1a\xa1\x53\xf5\xa7\xa9\x95\x1b\xaa\xd0\x0e\x4c\xc7\x9c\x45\xaa\x6c\x1e\x55\x60\x67\x31\x6f\x55\xcd\x71\x3e\x8f\x2a\xdc\xae\x3c\x43\xed\x18\x2a\xd9\x4d\xf0\xbb\xc6\xb7\x6f\x48\xb2\x94\xab\x09\x3a\x7e\xfa\xb4\x16\x77\x0b\x1c\x0b\x8b\xbc\xee\xf3\x29\x5f\xf8\x5c\x8a\xb1\x8d\x3f\xe0\x4d\xcc\x70\x14\x0c\xfa\xc8\xbc\x8f\x97\x17\x64\x49\xab\xc2\xb6\x43\xda\xd9\x6a\x35\x22\x0f\x7e\xcf\x3e\xee\xd4\xea\xd9\xc7\x86\x56\x6b\x89\xf9\x2b\x71\x0f\xb7\x6b\x9c\xd2\xd4\x31\xf1\xc0\x67\x6b\x4e\xc3\x90\xa4\x5f\xeb\x39\x75\x9b\xfb\x67\x57\x54\x55\x9b\xd8\x9f\x7e\xd9\x9f\x7e\xf9\x4c\xa7\x5f\xf2\x66\xdf\xe2\xdb\x53\x48\x78\x4b\xa2\x73\x73\xb2\xf2\x42\x67\x61\xbb\x43\x7f\x5d\x6d\xd6\x02\x72\x45\xf8\x5a\xbc\x63\xd2\xca\x80\x3b\xf4\xdf\xd0\x54\x23\x91\xa8\xa5\xe8\x82\xf1\x39\x8d\x22\x58\x4d\x50\x95\xaf\x6e\x4e\x42\x9c\x09\x7d\x20\x5b\x99\x6a\x54\xf4\x5a\xaf\x22\xe6\xd7\xad\xae\x47\x8a\xfc\xb5\xca\x2e\x34\x46\x8a\x67\x55\x50\xa1\x76\x34\x2b\xb9\xf1\x46\xfb\xd5\xb0\xbf\x1a\xbe\x2a\xac\x3d\x12\xe5\x07\x94\xd5\x62\x32\xf9\x16\xd6\x92\x54\xc8\xc7\xb8\x0c\xee\xc2\xd9\x8b\x77\x78\x4d\x5e\xb2\x64\x11\xd3\xd0\xea\xcd\x1d\xf0\x57\xd7\x4c\x23\x2e\x4f\x81\x86\x54\xc9\x82\xee\x22\x22\x75\xa8\x86\x71\x23\x86\x46\x45\x01\x1d\xab\x9c\x42\x16\xe5\x79\xa3\xc1\xc9\xf1\xf1\x23\x41\x72\x85\x52\x4a\x6b\x0a\x35\x4c\x1c\xeb\x30\x07\xe5\x49\xc8\x84\x59\x74\x61\x4b\x55\x7a\x91\x80\x51\x44\x17\x0b\xa2\x92\x2c\xc3\xfa\x60\xef\x4d\xf0\xbd\x09\xa7\x09\xca\x9a\x1c\x0a\x26\x04\x59\x53\x8e\xc9\x2c\x60\xec\x42\x8b\xe4\x9d\x7c\x0e\xc5\x52\xbb\xae\x35\xe7\x78\x56\x25\x54\x1c\x36\x3b\x74\xc2\xdc\x52\x4d\x31\xa8\x19\x9b\x89\x52\xb6\x51\xe6\x4c\x10\xeb\x26\x30\x02\x1c\x73\xed\x23\xc8\x57\x84\x75\x1b\x1b\x4a\x9c\xf7\x5a\xdc\x37\x9c\x26\x13\xdb\x20\xa9\xcf\x6e\x89\x3f\x81\x5d\x28\x79\x50\xda\xf6\x97\x0d\x3b\x9d\xd8\x72\xea\xee\x4a\xf7\x8d\x2d\x05\xcd\x0b\x14\x0f\xa9\x3f\xe2\xc8\xa2\xf1\x4b\x60\x71\x4b\x09\x71\xae\xad\xe3\x7f\x42\xe6\xb4\x5d\x51\x76\x32\x1e\xd7\x34\x13\x34\x2f\x4b\xb6\xb0\xd6\xff\x34\x6b\x98\xfd\x0e\xd1\xae\x3b\x44\x65\x65\xbc\x95\xc7\xfb\x4f\xa3\xbd\xeb\xbd\xc7\x75\x8d\x14\x25\x0f\x53\xbc\x24\x41\xff\xe2\x82\xfe\xbe\x4d\x71\xc6\x23\xc2\x7f\xdc\x6c\xd3\x01\xc1\x3c\x5c\x6d\x51\x01\x06\x70\x05\x11\x71\x35\x5b\x08\x31\xcb\xa2\x69\xca\xd9\x35\x2d\xf6\xe2\xdb\x0c\x08\x37\xe7\xbe\xc8\xd2\x94\x71\xa0\x2a\xd5\x0c\xca\x9b\x69\x52\xe7\x50\xea\x43\xa9\xd0\xe7\x51\xea\x1a\x5c\x12\xf5\x86\xf5\x41\x59\xc0\x43\x84\xaf\xe3\xf7\x6a\xa2\x8f\x9a\xd8\x4b\xbb\xc7\x26\xed\x5a\xc5\x8a\x3d\x35\x00\x9b\x0a\x3b\xcb\x18\x53\xdd\xae\x2a\x9a\x18\xba\x8f\xec\xd1\xdb\x1b\x8f\x44\x02\xd9\x81\x7d\x09\xea\x54\x82\x48\x63\x63\x2f\x86\xf6\x62\xe8\x11\x89\x21\x1a\x05\xfd\x0b\x7f\x5e\x0b\xcd\x3a\xad\xa7\xb0\x83\xdd\x24\xeb\x70\x18\xb2\x2c\x91\x5b\x4a\x37\x55\x17\xd9\xba\xe0\x2e\x0a\x57\x68\x4e\x62\x06\xce\x22\x1d\x52\xfa\xad\x30\xf1\x17\xbf\x2b\x8a\x68\x13\x6f\xa7\xa6\x9d\x3e\x72\x0d\xfd\x09\x04\x9b\xc5\xc7\x5e\xb4\xed\x45\xdb\xfd\x8b\x36\x5f\x0a\xdc\x90\xf9\x8a\xb1\x4f\xfd\x62\xb1\x7e\xd6\x85\x07\x35\xa8\x2d\xa2\xe8\x41\x2d\xc3\x89\x41\x70\xf3\x9a\xd6\xf3\xcb\x30\x73\x07\xea\x8e\xfe\x56\x73\xdd\xe4\xda\x5c\x37\xf9\xe1\xfd\xe5\x55\xc1\xa6\x18\x29\xee\x51\x79\x98\x20\x86\x5a\x48\x9e\x85\x52\x45\xe8\xff\xd7\xe5\xfb\x77\x68\xcd\x22\x62\x53\xd4\xe6\x00\xdd\xac\x48\x42\xae\xa1\xe3\xdc\x8d\xca\x16\x55\x10\xe1\xf6\x03\xb3\x37\x79\x00\x09\x36\xb8\xe3\x65\x55\x72\x03\xa2\xfe\xd5\x09\xcd\x39\x09\x19\x24\xea\x30\x47\x91\x21\xfb\x97\x50\x91\x91\xf9\x91\x09\xdb\x80\x73\xac\x13\x9a\x9f\xb3\x4c\x02\x78\x4e\x30\x65\x44\xf2\xb6\x4d\x30\x65\xd1\xab\x09\xb1\x84\x60\x7f\xc8\xb8\x0b\xc7\x40\x17\x08\x36\x82\x2d\xb2\x54\x9f\x74\x09\x12\x4f\x6d\x88\xbc\x7e\x7b\xfa\x72\x78\xf9\xfa\xf4\xf8\xe9\x33\x94\x09\xeb\xd6\x17\x24\xe4\x24\xbf\xd0\xc1\xcc\x97\x49\x0b\xb2\x22\x68\x45\x6e\x11\x49\x42\xe6\x46\xc0\x0b\xba\x4c\xb0\xcc\xf4\xd5\xa7\xc2\x20\x1b\x26\x70\xf6\x7f\x86\x6a\x7e\x86\xe6\x3a\xd0\xe1\xa5\x2d\x39\xb3\x31\xec\x58\xa0\x99\x58\xe1\xe3\xa7\xcf\xbe\xff\x7b\xde\xce\x0f\xb3\x11\xd2\xb7\x31\x41\x50\x3b\xbd\x26\x9c\x42\x3c\x1e\x27\x36\xfe\x2b\xef\x5a\x0d\x84\xdc\x6a\x56\xa2\x70\x18\x03\x87\x9f\xd8\x62\x61\x1d\xf1\xdd\x31\x56\x86\x84\xbf\x54\x8c\x95\xe9\xde\x38\xa8\x77\x8a\x50\xf2\x95\xc0\x83\x89\x2b\x7f\x00\xae\xdc\xaa\xc5\x2f\x37\x52\x80\x44\xcd\x9e\xf7\x2f\x27\x6a\xf7\xb1\x39\xfb\xd8\x9c\x7b\x8c\xcd\xb9\x6f\x27\xf8\x1f\xde\x06\xa9\x47\x5c\x87\x75\xd6\xcb\xdf\xe1\x2c\x5b\x9a\xcd\x90\xf2\x5a\xa7\xbc\x2a\x31\x42\x4c\x0c\x6a\xa0\x2c\xed\x2d\xe7\x3a\x53\xd4\xda\x0d\xe6\x5d\xff\xad\xe4\x3e\xcb\x9a\x2f\x24\xf3\xfd\xe5\x48\xe3\x6e\xb4\xc5\xc7\x5e\x78\xee\x85\xe7\x5e\x78\x7e\xad\xc2\xb3\x9f\x7c\x6b\x5c\x4e\x3a\x77\xfa\x76\xde\x97\x67\xc4\x4b\xd3\xf9\xec\xfb\x4c\x26\x53\x23\x9a\x8b\x43\x78\x06\xf6\x11\xfa\xa0\x93\x23\xba\x8b\x11\xb3\x6c\x34\x45\xd4\xda\x24\xe2\x2c\x4d\x49\xd4\x2e\xb8\xfd\x80\x4f\x6f\x5c\x66\xd8\x76\x21\xb7\x17\x97\x7b\x71\xf9\x10\xe2\x72\x1f\x84\x0c\x41\xc8\xef\x98\x65\xf7\x1e\x47\x71\xf7\x1a\xe6\xfe\x35\x4c\x71\x8f\x97\x9d\x87\x7b\x38\x66\xfa\x0d\xfc\x0f\x1c\x62\x3a\x37\x6e\x6e\x7a\x0f\x17\x38\x04\x87\x17\x27\xb1\xb2\xbd\xed\x3a\x40\x98\x3a\xbe\x0e\xb3\xa1\xa2\x4a\x87\x1d\xae\x89\xe4\x34\x14\x87\xea\x98\xe6\x94\x43\x4e\xb6\xee\xbd\x12\x53\xc9\x1c\x57\x84\xa4\x1c\x5a\x8d\xa8\xea\xfa\xa6\x53\x88\x3c\x35\xf6\xb5\x1d\x77\x75\x21\xf2\x56\xb7\xf3\xe3\xe6\x02\x2a\xfe\xd3\x39\x29\xda\x0b\xdb\x7d\x16\x13\xf5\x7b\x24\xca\x61\x8a\x39\xc7\xca\xad\xf8\x81\xb3\x35\x91\x2b\x92\x15\x23\x63\xf3\x5f\x49\x28\x05\x5a\x70\xb6\x46\x6c\x0e\x27\x29\xe0\x12\x5a\x9a\xad\xbf\x04\xa3\x18\x3c\x15\x58\xda\xef\x0a\xef\x77\x85\xbf\xd6\x5d\xe1\x28\xd3\xa6\xee\x16\x55\x68\x22\x81\x01\xe3\x2d\xaa\x2c\x68\x0c\xff\x06\xdb\x88\xbf\x2d\x05\x9f\xde\x80\x96\xbb\xc8\x3b\x7d\x0c\x4d\xee\x25\x5e\x87\xc4\x73\xf1\xb4\x97\x79\x7b\x99\xf7\xb5\xca\xbc\x2d\xa5\xd1\x82\x44\x60\x28\x91\x6e\x81\x84\xe3\x38\xe7\x60\xd8\x13\x0e\x39\x4e\x09\x9e\xc7\x04\x1c\xb0\x6b\x2c\xcd\xd1\x31\x7d\x6f\x6f\xbb\x7c\xb2\x9d\x1a\xd6\x7b\x18\xb1\x64\x41\x72\xc6\x80\x5d\xe9\x24\xc9\xad\x34\x43\xe9\xa2\x4a\x28\x7a\x98\xc6\x98\xf6\xa6\xc7\xda\xd4\x17\x7f\xa4\x03\x34\x6f\xa9\x80\x9d\xf0\x0f\x96\x10\x77\x65\x99\x93\xf1\xb8\xa1\xa9\xbd\x40\xde\x4e\x20\x97\xdd\x13\x1e\x92\x0a\xfe\x54\xe7\xba\x17\x70\xcf\xf0\x57\x81\xa3\x7b\x75\x65\xec\x95\xd6\xe7\x55\x5a\x83\xe2\x13\x80\x61\xc6\x02\x7f\x22\xf4\x5e\x2d\x7b\x2f\x88\x3a\x58\x1c\xe6\x60\x6a\x41\xa9\x2d\x44\xf3\x2a\xe5\xb0\x98\x97\xd4\x1d\x27\x35\x89\x4b\x5b\xa4\xeb\x27\x9a\x74\x17\x5a\xc1\x20\xda\x0a\x81\x29\x38\x19\x94\x42\x4b\xf2\x0a\x43\xd5\x8b\xf3\x08\x71\xa8\xce\x23\xc4\xc6\x3b\x8f\x92\xc9\x3c\xff\x16\xe8\x7d\x2a\xc9\x5a\x6c\x37\xf0\x5e\xa3\x02\x28\xaa\x85\x60\x69\xb3\x74\x92\x4d\x01\x70\xdd\xa5\x14\xcc\xed\xc5\x14\x13\xdb\x22\x38\x8e\xdf\x2f\xba\xe8\xc4\x52\x75\x89\x08\x0a\xfa\x1e\xd6\xe1\xa3\x09\x27\xf0\x03\x81\x55\xfe\x9b\x06\xdc\xc0\x2f\x27\xb8\x86\x2d\x1b\x8b\xe7\xb6\xcb\x94\x46\x9d\x95\x14\x32\x5c\xaa\xd9\x0a\x21\xfe\xca\x63\x6b\x2c\x28\x82\xaa\x07\x51\x2d\xc8\x4a\x5f\x6a\x8b\xf7\x96\x43\xf6\x4e\x7b\x77\xb0\x35\xf0\xe2\x28\xa2\x20\x0a\x71\xfc\xa1\x06\xea\x0a\xfe\x6c\xab\x10\xd8\x45\xb9\xbe\xfd\xb3\xa5\xf5\x3a\x4c\x18\xab\xc9\x79\xd3\x3e\x26\x77\x20\x05\xf2\x55\x4e\xe0\x3b\xb4\xe1\x9f\xa0\xde\x89\x1a\xb6\x67\x8f\xaa\x88\x82\x9f\x21\x5a\x67\xb1\xa4\x53\xfc\x7b\x0f\x1a\xd2\x77\x68\xf8\xef\x4a\x9a\x31\xf8\x17\x8e\x33\x22\x26\xe8\x3f\x45\x28\x67\xca\x49\x8a\x61\x16\x0f\x50\x1e\x6a\xa9\x9e\x4c\xf8\xa6\x09\xda\x54\xaf\x9c\x00\xce\x22\x72\x13\xe2\x3b\xa1\x21\x27\x54\xf3\xc0\xa4\xe6\x4d\x96\xbf\xa0\x62\xf0\x0d\x84\x63\x7f\xfc\x93\x47\xed\xe3\x80\x0c\x21\xe0\x96\x55\xe1\xae\xe0\xe0\x56\x5b\xa0\x11\x49\x63\xb6\x19\xa1\x9f\x18\xb7\x2a\x16\x9d\xfe\x7c\xb9\x25\x04\x26\xa6\xbf\x46\x66\xf8\x30\xe8\xbe\x4d\xa4\x3a\x3a\x7f\xd5\xbb\x1b\x3b\xa7\xe5\xe6\x9b\x12\x11\x22\x13\x8e\xdf\x0e\x8e\x9e\x5a\x74\x43\xe3\x18\xd2\x07\x3a\x67\xae\xcc\xce\x4e\x58\x0a\xf2\xf7\xf0\x34\x41\x99\x18\x12\x2c\xe4\xf0\x08\xd6\x52\x5b\xa1\x0d\xd2\x48\xf0\x49\xdf\xd2\x2a\x51\x62\xdf\xc2\x66\xed\xfb\xf1\xfc\xe3\xc5\x9b\x6d\x2b\xbd\xc2\x12\x6f\x55\x4d\xa5\xe6\x88\xa6\x38\xe7\x79\xfb\xa3\x17\x97\x13\x88\x98\x25\x43\x48\xcc\xda\xb7\x49\x7d\x53\xc8\xbd\x36\xa9\xb9\x6d\xba\xa5\x26\x34\xd7\x7f\xf7\x2e\xef\x1d\x9c\xe9\x5d\x0b\xae\xcb\x69\x27\x52\x88\xd4\x4e\x0c\xef\xc2\xd6\x14\x98\x32\xea\x96\x1d\xfb\xc2\x08\xdf\xde\xb4\xa7\x43\xc5\x45\x0d\x86\x4b\x1d\xd3\x35\x41\x78\x21\x09\x77\x32\x6e\x9a\xce\x60\xb9\x99\xc7\x92\xab\xe0\x36\x41\x94\x47\xa1\x94\xad\xbe\x25\x4b\xfd\x28\xb8\xaf\xf9\x45\x28\xc9\xe2\x18\xdc\x33\x5e\x20\xb4\x55\x6d\x3a\x4b\xed\x34\x4f\xb3\xdf\x21\xef\xdf\xf9\xf9\x84\x2b\xa9\x6e\x5d\x2c\x54\x33\x13\xcf\x37\xea\xde\x26\x08\xdd\x13\xf5\x33\x52\x36\x3a\x4d\x72\xf8\x58\xae\xa6\x21\x4b\xb4\xfd\xd0\x01\xe2\x2b\x22\x15\x49\x9b\x7a\x16\xaa\x42\xab\x96\xe0\x3c\x80\x7c\xfc\x9c\x98\x73\x4b\x06\xc4\xea\x6d\x4d\xc1\xe7\x34\xa6\x0c\x28\xaf\x15\xc4\x2f\xed\x40\xdd\x2e\xad\x58\x1d\x74\x35\x69\x0a\x8a\x43\xdf\xec\xf0\x56\x72\xfe\xa7\xcf\x6d\xa3\xd6\x82\xae\x96\x2f\x28\xa8\x42\xe2\x0e\xda\x2c\x60\x50\x70\xe4\xbf\x05\x2e\xaf\xbe\xd5\x0b\x94\xca\x6b\x98\x8e\xc9\xa0\x7b\x2e\xfa\x20\xae\xdd\x60\xba\x1f\xa3\xbb\x34\x05\x08\xf5\x9b\x0c\x1f\x6a\x1f\x05\x09\xb9\x95\x53\x40\xe5\x54\x45\x9a\xb7\xf2\x8f\xca\x0e\x51\x4e\x25\x0c\x0d\xa8\xb9\xb0\xc9\x84\x8d\x65\x5d\xa4\x44\x9b\x15\xcd\xcf\x0a\x3f\xc1\x08\x9d\xad\x53\x09\xd7\xa9\x69\x49\x81\x85\x6e\x66\x34\xf0\x00\xa8\x4a\xaf\x7a\x86\x98\x0c\x6a\x00\x0e\x4e\x2d\xa7\xe7\x12\x02\x38\x1c\x17\x1c\xef\x5d\x85\x6c\x31\x53\x43\xac\x75\xcb\x7a\x28\xe6\x3c\x6a\x9b\x78\xd0\x4c\x07\x65\xfd\xd6\x60\x2e\x1b\x60\x2e\xb4\x49\x6c\x2e\xb6\xf3\x9f\x5e\xfd\x68\x9e\xcf\xd4\x35\x77\x1f\xe0\x56\x63\xf3\xe6\x03\x8b\x84\x96\x15\x50\x5c\x32\x8e\x97\xc4\x7c\xd2\xe7\x84\xa2\xbc\xf2\x2b\x4e\x17\x92\x44\xbf\x04\x83\x16\x6c\xd7\x5b\xfb\x0d\xa0\x5f\xf1\x8c\x1c\xa0\x9f\x20\x0d\xf3\x01\xfa\x98\x7c\x4a\xd8\x4d\xd2\xdd\x7c\xd5\xbe\xf0\x9b\x7f\x8b\xc3\x15\x4d\xc8\x10\x16\x09\xa0\xac\x4c\x05\x2b\xab\x35\x74\x07\x88\x59\x6d\x4a\xad\x54\xb7\x53\x6e\x12\x53\x2e\xb2\x78\x41\xe3\x98\x44\x9d\x10\xad\x89\x10\x25\xf7\x88\x0f\xd2\xeb\x6c\x8d\x93\x02\xa0\x48\xe9\x95\x5c\x7b\x68\x88\x3a\x7b\x89\xb1\x90\x53\xc9\x71\x22\x14\x98\x53\xb0\xf6\x9a\xbb\xd4\x56\x85\x74\x38\xcf\xbf\xc8\xb0\x18\xae\xbe\xca\x30\x52\x2c\xe5\x02\xd1\x66\x27\x54\x00\x34\x54\xa8\x8e\xf7\x4d\x06\x75\x00\x9d\x26\x08\xca\x6c\x2c\x00\xea\x6e\x71\xb4\xa2\x42\x32\xbe\xb9\x0b\x5f\xd1\xa8\x99\xc9\x4c\x12\xd0\x29\x96\x2d\x8c\xe6\xaf\xa6\x6a\x71\xdf\x93\x17\x35\x8a\xa7\x1a\xa3\x07\xa0\xf5\x43\xe5\x6d\xd0\x2b\xd1\x0c\x0e\x0e\xda\x8b\x61\x0e\xb4\xc9\xb6\x99\xde\x60\x9e\xa8\x15\x6c\xd5\x82\xea\xe6\x05\xd8\xee\x9e\x76\xf1\xdb\x65\xed\x0d\x96\x73\xb2\x60\x26\x0a\x4b\x4d\x45\x67\x5f\x92\xed\xd8\x93\xb6\x6d\xfb\x77\x84\x43\xc9\x78\x73\x27\x57\xf6\xc8\x06\xe3\x8e\x60\xb6\x57\xf5\xe8\x05\xb7\xed\x6b\x84\xde\x31\x99\xdb\xcc\xe6\x3a\x7b\x53\x68\xbe\x41\x4c\x65\xa6\x85\xc6\x20\x9a\x1a\x27\xee\x65\x4a\xde\x18\x8c\x61\x8d\xa3\x35\x4d\xa8\x90\x1c\xa2\xa9\xc4\x9d\x25\xd5\xcf\xab\x4d\x01\x2a\x5a\xe1\x34\x25\x09\x89\x7c\x98\x0d\xe1\x08\x87\x9a\xf4\xf5\x8e\x66\x30\xa0\x5e\xe1\x6c\x2a\x4e\xe0\xb2\x01\xc6\x3b\x81\x2a\x58\x62\x72\x1f\xcc\xfe\xb9\x4d\xbe\xc7\x6c\x21\x9d\x15\xe4\x6c\xde\x98\xb0\x71\x63\x3a\x4d\x06\x75\xb3\x7e\xa9\x1a\x29\x1f\x31\x02\x27\x91\x3d\x96\x59\x73\x28\x6a\x4b\x99\x98\x71\x77\x4f\x40\x1f\x23\x1e\x34\x23\xb4\xec\xf8\xa8\x25\x9c\x8c\xc7\xcd\xa4\x7c\xe5\x1e\xdb\xce\x69\xba\x2c\x0a\xf2\x67\x3f\xfe\x9f\x13\x75\x58\x1c\x18\x97\x05\x5d\x60\xe8\xc1\xb4\x43\x62\xce\x4d\x03\x1c\x73\x16\x51\x92\xf7\x6b\x90\x9d\x1f\x19\xc8\x41\x86\x33\x03\xce\x79\xec\x11\x3a\x57\x17\x8c\xe8\xd3\xe7\xdc\xec\xf7\x8f\x5a\x81\xf3\x49\x60\x32\xa8\x03\xee\xb4\x32\xb1\x80\x11\x9c\x78\x08\xd9\x72\xae\x69\xd4\x38\xf1\xf7\xa5\xfe\x76\xa1\x8f\xcf\x2c\x7c\x0c\x9a\xff\xcc\xe2\xc7\xa0\xc0\x13\x40\x97\x29\x09\x27\xcd\xf4\x53\x37\x1c\x9b\x71\xda\x83\xaf\xcf\xbe\x84\xbb\x9d\xa2\x81\x30\xeb\x8d\x1d\x80\xc0\x09\x8e\x37\xbf\xfb\xbe\xda\x9a\xaa\x4d\xd5\x1b\xc7\xb1\xfb\x58\xec\x7f\x22\xc4\x31\x4d\x96\xe5\x46\x1b\x80\x6b\x03\xd0\xdc\xee\xc8\x2e\xeb\x5b\xac\xa5\x76\xf7\x87\x13\x15\x2c\x21\x9a\x2b\xd6\xf9\xbc\x7c\x16\xa3\x89\x7c\x72\x5c\xf3\x1d\xec\x9a\x75\xb6\x9e\xa0\xa3\xca\xc7\x35\x4d\x2e\xbe\x50\xcf\xf8\xf6\x81\x7b\x8e\xe6\x93\x41\xe7\x1c\x3f\x10\x01\x9a\x3b\x29\xdf\x12\x89\xc1\x87\x38\x19\xd4\xca\x8c\xfb\xde\xed\x6b\x73\xb1\x9d\x7e\x38\x37\x40\xf9\x2c\x42\xe1\xe3\x75\xc9\x59\xa6\xc2\x20\x50\xe0\x05\x0c\xfa\x25\x42\x16\xc7\x24\xac\xf5\xc3\x0e\x41\x2b\xa1\xc0\x6c\x97\x94\x38\xb2\xa9\xf5\xc3\xe6\xe2\xbe\x8f\xd0\x97\xfd\xcd\x13\xda\x02\xe0\x43\x89\xfa\xda\x09\xbc\xd4\xc7\xc6\x2e\xbd\xd5\x98\x67\x68\x94\x6c\x4c\x73\xce\xcc\xf8\x3e\xf2\x38\x6c\x37\xf5\x7e\x65\xde\x2d\x32\x8b\x37\x8a\x21\xa7\x21\x4e\x71\x48\xe5\x66\x6a\xae\xd0\xf0\xf6\x02\x6a\x88\xaa\x0e\xb9\x75\x6d\x7b\xf0\x83\x11\x77\xf1\xfa\xf4\xe5\x65\xce\x54\x08\xa7\xd4\xc0\xef\x54\x6a\x20\xe2\xc6\x2d\xeb\x1a\xf8\x7b\xd0\x41\xed\xb0\xbd\x12\x25\xf0\xcf\x93\x48\xe5\xa4\x87\xdd\x19\x38\x9a\xc3\xf3\x5b\x4b\xec\x4c\xd8\xe6\x8a\xad\x8d\x2a\x38\xf5\x1b\xaf\xbe\x77\xd6\xdc\x07\x36\x19\xd4\x40\xd1\xb0\xd0\x80\x49\xd7\x99\x81\x24\x43\x39\xcf\x28\x0b\x7c\xd0\x84\xbe\xa1\xba\x44\x6e\xd0\x88\xf4\xda\x49\x6e\xdc\x34\xf7\xa0\x84\xa9\x2e\xa5\x78\xbb\x59\x11\xe3\x99\x30\x83\x75\xd7\xf9\x66\x13\xd9\x58\x92\x88\x26\x83\x0e\xf5\xd9\xb6\x75\xde\x00\x89\x29\x0c\x37\x7f\xda\xab\x06\x63\x9a\x7c\x52\x0b\x14\x05\x17\x50\xa6\xdd\x88\xec\xea\xbf\x6e\x4f\xdd\xeb\xf7\x52\x2d\x55\xa8\x5e\x94\xf0\x4c\xa5\xc1\xca\x2f\xb0\x6e\x40\x83\x64\xe0\x3b\x57\x4d\x9f\xfe\x7b\xd0\x46\x2f\x75\xf6\x7b\xfb\xce\x67\xa5\x37\xb5\x1a\x5a\x67\x70\x01\x20\x4b\x84\xc9\x24\x01\x97\x1c\xf1\x61\x88\xe1\x34\x5f\x9c\xae\x70\x92\xad\x09\xa7\x21\x0a\x57\x98\xe3\x10\x82\xcc\x21\x27\xd6\xb7\xc3\x6f\x4d\x46\x2d\x73\xf1\x47\xa2\x4b\xcf\x89\x74\xcb\xea\x9c\x56\x24\x31\xd9\xb0\x70\xd2\xd0\xa6\x2e\x07\x3b\x07\x10\x62\x3a\x27\x08\x92\x1a\x2a\xe7\x12\x4e\xd0\x93\xe3\xa2\xa0\x68\x5f\xab\xd5\x47\x2e\x78\x68\x01\xac\xe8\x22\xad\xf4\x68\xf6\xf4\x76\xa1\x4b\xdd\x96\x0b\x40\x9b\x26\x30\x5d\x83\x6d\x5d\x0c\x4d\x68\x83\xbb\x6f\x1b\x8e\x7d\x1e\x0c\x9a\xb6\xc6\x2b\x58\xe8\xb3\x2b\xae\x33\x9d\x45\x64\x81\xb3\x58\xea\x02\xfa\xce\xa5\x08\xd1\x85\x72\xa7\x0b\x22\x47\x6d\x28\x31\x0d\x7d\x54\x71\x09\x6d\x0e\x94\xad\xc4\x9a\x3a\x27\x85\x3e\x9c\x5e\xbd\x7c\xbd\x9d\xf4\xba\x17\xac\xb4\x8d\xf7\xb1\x90\x40\x25\x6d\xf8\x64\x50\x6b\xb1\xdc\xcb\x72\xba\xcd\xba\xac\x00\xf2\x08\xf6\x6f\x5d\x90\xbe\x9a\xed\x5b\x17\x68\x67\x8e\x8b\x8c\xcc\x93\x41\x6d\x07\x0f\x33\xc3\x05\x18\x8f\x64\x7e\x1b\x2f\x3b\x7d\xbc\xb3\xab\x41\xae\xe1\xdf\xc9\xa0\x46\x5a\x05\x2f\x3d\xf3\x2a\xd7\x8c\x7d\x62\xc1\xfd\x86\x0a\xbb\x16\x84\x1c\x50\x40\x7e\x6f\x99\x26\x84\x11\xfa\xd9\xe8\xc1\x6f\x3d\xb8\xbe\x55\xf6\x53\xb7\x4e\x6e\xb1\xce\x82\x8f\x09\xfd\x2d\x23\x88\x46\x70\xe3\xd3\x82\x16\x7b\x34\xa6\xeb\xce\xc6\x23\x2a\xd2\x18\x6f\xa6\xed\xd6\x90\x8d\xdf\x94\x55\xbb\x14\x5c\xf6\xa6\x11\x94\x66\x3c\x65\x82\xf4\xb0\x33\xda\xbb\x53\x5b\xc3\x68\xc1\x29\x49\xa2\x78\x53\x33\x3a\x1f\x86\x03\xa5\xf7\x0c\x01\xa3\x19\xbe\x11\xb3\x6e\x08\x48\x02\xfb\xe0\x2d\xa8\xfd\xd9\xac\x52\x6a\xc6\x4c\x85\xad\xae\x7a\xd6\x71\xac\x90\xae\x01\x27\xe8\xfd\xe5\x2b\x1b\xd3\x34\x0a\x3a\x8c\xd0\xba\x35\x85\x69\xb8\x2c\xa2\x26\x83\x3a\x18\x5f\x15\x4f\x30\x3d\xd8\x1a\x67\xea\x6f\x1f\xe8\x87\xa4\x70\x0d\xf2\xb7\xdd\x93\xf0\xc8\x48\xdb\x60\xaf\x8e\xa4\x4b\x34\xf6\x6e\x84\xfe\x45\xf9\x92\x26\x14\xdf\x37\xad\x19\x20\xee\x8b\xc6\xe0\xc7\x98\xa0\xfe\xed\xde\xa8\x48\x27\x3e\xb5\xcb\x36\x15\x3f\x2a\x9a\xe1\xbc\xaa\x35\xf7\x6d\x6d\x35\xc7\xc2\xc9\x52\x6e\xaf\x19\xd5\x43\xaa\x01\xb5\xac\x1a\x6a\xd4\x42\x0d\x42\xbb\xd8\xc6\x6c\xf0\x35\x8c\x4e\x35\xf2\x8d\x97\x65\xc5\x1e\x55\xb5\xd9\x56\x20\xc3\x0a\x42\xb5\x29\x3a\x26\x83\x5a\x4d\xb5\x93\xea\xaf\xed\xa0\xc6\x8b\x78\x94\xcc\xd3\xcb\xef\xc6\xaf\xa3\xec\x03\x39\x89\xc7\x92\x3d\xff\xf5\x72\x79\xfc\xf2\xcd\xef\x8b\x2c\x18\x74\x6a\xd5\x56\x65\x5f\x01\x61\x0b\x95\x5f\x16\x1a\x0d\xb3\x95\x0f\xa4\x77\xd1\x2f\x69\x4a\x14\x98\x30\x87\x6f\xf2\x67\xdb\x56\xcd\x44\xd7\x61\x48\xd3\xd4\x64\x50\x1e\x42\x85\x42\xda\xcf\xed\x34\x62\xea\x5a\x9d\x1f\x98\x0c\xba\x50\x54\x83\x9e\xb6\xf1\xeb\x66\x83\x41\xb5\x8b\x9e\xe3\x86\xad\x4a\x21\xf1\x3a\xad\x82\x56\xdd\x95\x70\x76\x23\x9e\x9d\xe4\xef\x55\xbf\xd5\xea\xfa\x72\xe3\x9a\xda\x11\xcb\xe6\x31\x69\x11\x0e\xaa\x41\x97\xa7\xcb\x49\x28\x26\x83\x5a\xa2\xb9\x0b\x57\x37\xe7\xb9\x78\x40\xbe\x76\x81\xf8\xb3\x73\xb6\x8b\x8b\xc0\x25\x86\x9f\x74\x96\x04\xca\x92\x0b\x22\x40\x4d\x0e\x1a\x86\xe1\xb6\xb0\x25\x57\x7c\x6e\x69\xf0\xb8\xb9\xae\x72\x47\xc8\x64\xd0\x88\x84\x3a\xec\x85\x6e\xfd\x2a\x88\x3d\x44\x5e\x2d\xcd\x0c\x7b\x5f\x6c\xe2\xac\x2a\xcd\x9b\x3b\x8c\xe0\xdc\x63\x98\xda\xe9\x0c\xdd\x75\x62\x4b\xf9\x41\xf5\xd0\x78\xc1\x8e\x6a\x8d\x55\x44\xc2\x78\x96\x1c\x18\x72\xe7\xaf\x60\xcd\xc0\x49\xc8\x78\x34\xa8\x3f\x31\x5f\x03\x1c\x4d\x26\x28\xc5\x72\x55\x9e\xf8\x62\xc7\x8b\x2e\xde\x62\x19\xae\x7c\x30\xce\x17\x43\xf5\xd6\xbc\x84\x56\xf4\x05\x0b\x75\xd0\xa9\x43\x3d\x29\xe1\xa0\x1e\x94\x61\x9e\x9f\x0c\xb6\x81\xc9\xd6\x08\x15\x12\x96\xd6\xb0\x5f\xc4\x92\xdc\x8c\xd7\xa9\x51\xce\xae\xf0\x52\x98\xd3\x27\x26\x35\xc9\x7c\x83\xfe\x71\x76\x65\xbd\xa3\x42\xdf\xe1\x60\x52\x3f\x9d\x1c\x1d\xa3\x0f\x9c\x14\x21\xc0\xe6\x7e\x07\x15\x0e\x79\x43\x05\x19\xf5\xc7\x51\x81\x94\xc2\xe0\xb6\x39\xb2\x7c\xb4\xd8\xb7\xe6\x25\xa0\xe5\x37\x27\x83\x54\x65\xce\x62\x92\x2c\xcd\xa1\x1b\x88\x65\xa6\x09\x84\x0a\x64\xe0\x7c\x80\xe5\x89\x89\x6b\x66\x66\xc4\x0a\x19\xc6\xb6\xad\x40\xe6\x6c\x50\xd6\x8f\xa8\x2c\x37\xea\xa5\x46\xbe\xb4\x78\x3a\x68\x09\x21\x30\x3b\x7d\x13\x74\xf2\xe4\x78\x3c\xf0\x74\xa8\xc3\x24\x65\x14\x15\x52\xc9\xb4\x6e\x93\x86\x95\x28\xdc\xbc\xed\x8b\x43\xdb\x0a\x9c\xad\x10\x6a\xc2\xe1\x20\x95\xbc\x81\x0d\x47\x08\x2a\x40\x79\xa6\xc5\xcf\x8b\xb1\x27\xe3\x5e\x28\x3b\x1a\x3f\x1f\x37\xe3\xac\x8c\x12\x07\x67\xa6\x7d\x93\xa8\xc8\x16\xd0\x38\x33\x2f\xfb\xa0\xec\x8d\xd9\xdb\xb2\x8b\x24\xc9\xd0\x82\xc8\x70\x35\x42\x3f\xc1\x3f\x5e\xbe\x22\xb8\x9b\x06\x91\x75\x2a\x37\x23\x5d\x0f\xd8\xd4\x5e\x8d\x62\x79\x56\x81\x9c\xe4\x19\x82\xd4\xa6\x81\x68\xe7\x2e\x5f\xc2\x57\xe4\x7b\x0d\x0b\x3a\x78\x36\x39\x8d\xdc\x64\x0d\xd0\xe5\xc4\x4d\x22\xd1\x8a\x81\x0f\x70\x32\x87\x26\x11\xb9\xad\xd0\x84\xbb\xa0\xee\x21\x18\xaa\xf3\x57\x4e\x21\x61\xe6\xce\x7a\x71\xdd\xdc\x11\x1a\x68\x27\xd5\x45\x2b\xd0\xc5\x51\x42\x85\x2e\x20\x76\xd8\x4c\x77\x07\x7d\x8f\xc3\x28\xe7\xb8\xc8\x87\x31\x1e\x07\x39\xf6\xaf\xdc\xd3\x51\xc5\x14\xe8\x63\x4d\x7d\xc6\xa4\x1a\xb0\x52\x1e\xaa\x16\xb2\xce\x17\xf4\xf6\xd4\x54\xe9\x64\xd6\x2c\xaf\xcb\xc9\x35\x65\x99\x50\xd8\x18\xa1\x53\xf5\xaf\xd5\x0b\xf6\xfa\x21\x6c\xb2\x1b\xd9\xbb\x8f\xe8\x72\x25\xcd\x99\xd4\xfc\xb4\x15\xe0\xb6\xb6\xd1\x03\x24\x20\x01\x37\x96\x28\x61\x66\x06\x80\x07\xc4\x27\x0a\x29\xb8\x61\x17\x98\x93\x54\xef\xd6\x2b\xa6\x29\x8a\xd8\xcd\x52\xe5\xf4\x51\xa7\x5c\x61\xee\x8c\x80\xd2\x5b\x8a\x33\x7d\x31\xf1\x4c\x6d\xfc\xce\xcc\xbd\xc6\xce\xb9\x30\xa1\x37\xa6\xe7\xa4\x48\x2e\x8e\x45\xbe\x39\x58\x82\x53\x1d\x2d\x9b\xc1\x76\x3e\x5d\x26\x8c\xbb\xf9\xc1\x77\x22\x0f\x03\xce\xa4\x6e\x02\xff\x67\x98\xd7\xbb\x34\xf9\x8b\x6d\x9e\x73\xb8\x6b\x69\xbe\x41\x21\xa7\x92\x70\x8a\xf5\x40\xc5\x26\x91\xf8\x36\xf7\x36\xe6\x03\x74\xaf\x95\x12\x74\x4d\x63\xcc\x6d\x14\x82\x5b\x85\xa0\x99\x6d\x78\x86\xc2\x18\x8e\x38\x98\xd0\xe2\xcb\x7f\xbe\x81\x1d\x78\xa9\x82\x1b\xed\x80\x11\x3a\x03\x0e\x51\x2c\x65\x4f\xe2\xa9\xfa\xda\x74\xc0\x49\x7e\x4e\x67\xc1\xe2\x98\xdd\x80\xc7\x77\x16\x7a\xa1\x27\x62\x86\x16\x94\xc4\x91\x98\x0c\xf2\x46\xff\x56\x8a\xfa\xf0\x3e\x28\x27\xde\xd4\x09\x57\xfe\x5b\x35\x40\x19\xa1\xbf\x15\x66\x9c\x7a\x70\x1d\x5a\xce\x7b\x1b\x55\xe1\xbc\x72\x42\x54\xa0\xa6\x1b\x52\xed\xf7\xaa\x4e\x78\x38\xcf\xda\x67\xe7\xbc\x28\xc5\x19\xfd\xcd\x39\x2d\x5f\x8c\xd5\x49\x51\x70\x50\x30\xa7\xd2\x11\x85\xf8\xd7\xc0\x0b\x17\xb7\x72\x45\x28\x57\x9a\xe0\x00\x3c\x73\x25\x24\xeb\x39\x75\x50\x3a\x9b\xcd\xc4\x6f\x45\x50\x37\xd4\x43\x58\x84\xee\xf7\xa2\xf0\xd5\x2e\x60\xa0\x29\x4e\xa2\x69\xce\x8c\xb0\xff\x7e\x17\xc8\x0e\x9c\x59\x6d\x86\xf4\xdc\x08\x12\x87\xcc\x93\x6f\xa5\x75\xe1\x47\x07\x20\x36\x8c\x01\xac\x04\x2c\x30\xad\xd2\xb6\x07\xf0\xae\x98\x2c\x68\x04\x12\x7e\xc4\x52\x8b\x14\x67\x84\x00\xd0\x08\x5d\x98\x8f\xca\xf2\x25\xbf\x65\x38\x36\xce\x1e\x4b\xe0\xda\x84\xd6\xa4\x5c\x6e\x82\xe6\x12\x82\xdc\xa6\x31\xe4\x03\x72\x4d\xa3\xaa\x6e\x28\x09\x04\x57\x3d\x58\xf4\x04\x0d\xd2\x1f\xbe\x4f\x6c\x03\x77\x13\x4b\x70\x04\x73\x03\xa7\xf4\xc1\x8e\x55\xc5\xb4\x10\xad\x97\x53\xe6\x25\x42\x97\xaa\x50\x21\x96\x8a\xc9\xea\x90\x4f\x1d\x72\x49\x85\xcf\xf8\x42\xa9\xe8\xd3\x13\x4e\xe8\x14\x88\x0d\xb6\x01\xf4\x6c\x18\xdd\xa6\xa1\x57\x73\x33\xf3\xe5\xcb\xec\x00\xcd\x0a\x6a\x83\x27\x4b\xeb\xca\xbd\x0f\x2f\x00\xaf\xf0\xaf\x62\x7a\xf8\x43\x73\xfb\xec\x20\x87\x61\xa6\xd9\x7d\xa6\x83\x8b\x66\x05\xaf\xcf\x0a\x80\xc0\xf1\x84\xf5\xb1\x2f\x0d\xc7\xdf\x7f\x80\xb6\xbe\x87\xff\x7b\x73\xfe\xdf\x67\xf0\xef\x79\xfe\xc7\xbb\x99\xa2\xdf\xd9\xbb\xf7\x57\xe8\xfc\xdd\x4c\x0b\x78\xf0\x5b\xd8\x81\xb9\x40\x43\xaf\x05\x2c\x4e\xef\x4a\x2e\xe3\x58\xa8\x50\x2f\x0d\x80\xd5\xd7\xb3\xbf\x43\x3f\x7f\x57\xdd\xff\x60\x3a\xfb\xe1\xfb\x99\x9a\x00\xeb\x1e\x81\xdd\x09\xc0\x9a\x40\xb3\xe3\xf1\xf1\xb3\xe1\xf8\x68\x38\x3e\x9a\x29\xb8\x8a\xe7\xab\xa3\xe3\xc9\x78\x3c\x19\x8f\xff\x3d\x2b\x54\x43\x7e\x4a\x5a\x58\xd5\x90\x90\x25\xce\x8d\x05\x18\x96\x83\x9a\x5f\x19\x4d\x0c\x52\x4e\xdf\xbd\x32\x8a\xfa\xfd\xc5\x6c\x84\x5e\xb3\x1b\x38\x3f\x73\x80\x36\x2c\x53\x2d\x81\x50\xc1\xd6\xdc\x07\x4a\x38\x1a\x9b\xea\x2a\x73\xa6\x99\x67\xc5\x15\x0e\xf5\x19\x6f\x9e\x98\xd4\xca\xb9\x8a\x94\x33\x79\xdd\x6d\x88\xce\x6c\xbd\x19\x1a\xc5\x35\xcb\x6f\x4e\x34\x1b\x4f\x6a\xff\xb4\xaf\xac\xcb\xff\x06\x8a\x42\xdf\xa3\xa2\x5d\xd5\xac\x4f\x98\xe8\x7b\x84\x6f\x0a\x0d\x32\x9b\xcd\xfe\x93\x0e\x7f\xd9\x66\x00\x58\x83\xaf\xec\x2a\x63\x96\xa9\x81\xcd\xd6\x9b\x1d\x41\x8e\xe9\x27\x82\xd6\x9b\xff\x7d\xfc\xb4\x5e\x24\x17\x30\xb9\x6e\x07\x0b\x95\xcd\x34\x02\xd4\x4f\x28\x78\x0a\xe0\x60\x35\xdc\x6c\xc9\x4d\x9e\x25\x85\x86\x1b\xe2\xd8\x75\x34\x41\x40\x6a\x50\x04\x76\x9b\xf8\xd6\x70\x9b\x80\x65\x9a\xa0\xbf\x38\x57\x76\x92\xe8\xaf\xaa\xaf\x82\x89\xd0\x0f\xdf\xa3\x82\xa8\xbd\xa6\xee\x4b\xe3\x28\x8d\x5a\x8b\x98\xbc\x07\x35\x57\xf9\x8d\x62\xe0\xa9\x49\x09\x5f\x43\xea\x51\xc8\x5a\xc0\x90\x20\xc4\x5e\x9c\xa9\x6c\x77\x87\xc6\xdf\x31\x49\x46\x16\x44\xc5\x00\x4e\xa6\x51\x10\x4c\x26\x5f\x24\x2d\x2c\xff\x36\xcd\x64\xd6\x4f\x8a\x9f\x1a\xf4\x4d\xbd\x6e\xa9\xaa\x34\x5f\x75\x54\x34\x5a\x2f\x3e\x08\x76\xd7\x5c\xb5\xe9\x7e\xac\x33\xc4\xf9\x54\xa7\xda\xdc\x2d\x5c\x5b\x58\x69\x4b\x98\x0c\xed\x16\xf0\xec\x87\xf9\xa6\x01\x57\x3d\xe0\xee\x8b\x4e\xc8\xcd\xe3\xef\xd2\xd6\xa1\x96\x78\xe9\xe2\x01\xf2\x08\xf3\xa8\xbb\x9e\x2d\x19\x0c\x8a\xdc\xc7\x2a\x5e\xd2\x82\x60\x92\x1f\x9b\xaa\x6a\x5c\x64\x82\xe6\xea\xad\x79\xa9\x1f\x7e\x32\x0e\x9d\xff\xfa\xf9\xca\xbc\x57\xb0\xa2\x95\x94\xe9\xa0\x3c\xb0\x8f\x97\x5e\x10\x95\x85\xac\xe4\x66\x37\x01\xb7\x28\xc8\xf3\x79\x15\x43\xf4\xa9\x66\x82\x02\x87\x6a\xec\x7c\x07\x26\x78\x1e\xa7\x54\xe6\x89\x49\xce\x3e\x6e\xd5\x35\xc9\x86\x37\xe4\x9e\xba\x7e\xe9\x2d\x87\xda\x01\x50\x9b\x60\xf8\x09\x7e\x11\x3e\x9d\xbf\x18\x8e\x8f\x9f\x3f\x19\x9e\x2c\x16\xcf\x87\x2f\xe6\x2f\xc8\x30\xc2\xc7\xc7\xe3\x17\x11\x3e\xfa\x2e\x7c\x12\x0c\x4a\x7b\x6c\x86\xb7\x82\x41\xaf\xa3\x2f\x87\xbd\xfa\x40\xdf\xa0\x94\xe3\xe5\x1a\x4f\x40\xaa\xb1\x1b\x75\xe9\xba\x77\x4a\x38\xcf\xe2\x81\x02\x25\x78\xfb\xa2\x2b\x0f\x76\x77\xa5\x51\xfb\xd4\x2b\xc3\x0c\xda\x49\xe9\xd4\x0c\x63\x6a\xd0\xdd\x32\x0d\xc5\x27\x53\x47\x27\x62\x43\x01\x10\xa8\x98\x1c\xea\x33\x47\xc3\x3e\xe8\x18\x19\x62\x1e\xa9\x2a\xa3\x90\xad\x83\x41\x43\xc2\xb6\x72\xf3\xe0\x43\xbd\x7b\x1f\xb9\x1a\x9b\x40\xe2\xf1\xe3\xf1\xf0\x68\x3c\x1c\x3f\x05\xd3\xec\xe9\xd1\xe4\xf8\x64\x34\x7e\xfa\xe4\xe8\xe4\xf8\xdf\x45\x8d\xc2\x48\xac\xd6\x78\x36\x79\xf2\x6c\xf4\xe4\xd9\xf1\xf1\xf8\xb9\x53\xc3\x66\x59\x43\xc1\xf1\xe8\xd9\xc8\x38\xaa\xaa\xf2\x35\x17\x35\xf9\x77\x88\x6a\x9e\x20\xb1\xc6\x71\x5c\x43\xf4\x7a\xe3\xe0\x25\x0c\x80\xb2\x44\x1f\x48\xfa\xc3\x32\x82\xb6\x72\xf6\x9c\xf0\x75\x73\x82\x9f\xa5\x10\x05\xd8\xe6\x07\x72\x6d\x3b\xe3\x1e\xd5\xac\x09\x3b\x64\xe5\xb9\xbf\x1b\xdb\xbc\xa1\x5d\xfa\xc2\xd0\x7c\xb5\x5a\x30\x68\x0e\x8d\xae\x86\x50\xd7\x04\x4a\x57\x76\x14\xcc\x59\xcb\x3e\x73\x57\xb4\xd2\xc6\x97\x0f\xc8\x9b\x6d\x8a\xaa\x9b\x45\x5b\xd8\xb4\x8b\x55\x3d\x76\x8d\x3d\x06\xed\x60\xd2\xcf\xce\xa8\x0f\xc5\xac\xbb\x31\xec\x6e\x4c\xdb\xaa\xc2\xba\xf8\xd1\x30\x51\x9e\xae\x66\x1b\xce\xcb\x2b\x15\xdd\x69\x2e\x2b\x76\x2f\x35\x1f\x3e\x29\xf3\xdc\x93\x4e\x8e\x0b\xa3\xf8\xbb\xe5\xc9\xaf\x47\xab\xe4\x39\x97\xcb\x17\xe1\x31\x49\x4b\xa3\xd2\x26\x77\xe0\xa5\x95\xf2\x4b\xb8\x09\xa0\x50\x50\xaa\x9d\x27\x6c\x42\x81\x4d\xaa\xec\x97\x50\x19\x83\xba\x14\x8e\x93\xc9\x28\x67\xf6\x62\xff\xe8\xee\xf4\xd0\x8c\x8d\x65\x2d\x36\xf2\x3c\x48\x6d\x98\xa8\x1f\xaf\x64\x5d\x25\x2c\x46\x16\x31\x21\x72\xb8\x56\x29\xf0\x78\x03\x2e\x04\x81\xe3\xeb\xb9\x37\xdd\xee\x70\x14\x89\x9a\xc0\x27\x8b\x25\x59\x6e\xb6\x45\xd2\xb3\xa3\xa7\xe3\xef\x9a\x90\xb4\x0a\xb9\x83\xa4\xdf\xee\x48\x32\x6e\x62\xed\x66\x64\xd5\x88\x56\x0f\x53\x62\x85\x79\x34\x14\x9b\x24\x6c\xc0\x55\x41\x37\x26\x5e\x5a\x6d\x61\xe2\x68\x0b\xd4\x54\x25\x83\x77\xc4\xa4\x1f\x57\xbb\x35\x8a\xae\x69\x54\x56\x0d\x46\x80\x7b\xef\xbc\x20\x7b\x14\x9c\xae\xf1\xef\x2c\x41\x3f\x93\xb9\x3d\x90\xef\x94\x35\x31\xda\x8e\x56\x71\x4e\x0b\xf4\x07\xd5\x3d\xe8\x93\x03\x5a\xa3\x8e\x4a\xa0\x7d\xbc\x44\x67\x58\xc8\x03\xe4\xc4\xee\xb7\xc1\xd6\x1a\x21\x8f\xfe\x13\x58\x71\x1a\x1c\x18\xd7\xc4\x2f\x6e\x50\x61\x25\xa2\xba\x61\x60\xd5\xc0\xc0\xa9\x3a\xb0\x30\x9d\x4e\xac\xc2\x52\xd2\x87\xf0\xe9\x9c\xb3\x4f\x84\x4b\x96\xd2\xd0\xc4\x5b\x4c\xe7\x1b\x49\xc4\x94\x26\x53\xff\xc6\x8b\x5c\xd7\x4d\x75\xe0\x14\xe3\x53\xca\xa6\x86\x15\xf3\x76\x87\x46\xaa\x39\xd5\x54\xe3\x13\x34\x85\x6c\xc0\x02\xce\x2e\x4f\xd9\x62\x21\x88\x14\x2d\x61\xc7\x43\x27\xf8\x10\x1d\x3d\x3b\x3a\x7a\xf6\xdd\xf8\xf8\xc9\x78\x9c\x07\xad\xb8\xe3\x46\xcf\x4f\x8e\x9e\x9e\x74\xd5\x7e\xd6\x58\xfb\xe9\xf3\xe7\xcf\xbb\x6a\xbf\x68\xac\xfd\xdd\xb3\xe3\x63\x77\x92\xdc\x80\xce\x3f\xd6\x34\x75\x4e\x49\x65\x3a\x1a\x63\x34\x4b\x98\x08\xdd\x72\xc5\x6b\x98\x49\xf7\x13\x5c\x3f\x16\xf8\x2f\x6a\xac\x50\x2b\x75\x8a\xd2\xc5\x1b\x5d\xfc\x64\x3c\x7e\x65\xb2\x43\xb6\xcf\x90\x92\x02\x47\xe3\xea\x12\xd9\xb9\x49\xa3\xd1\x08\x57\x7e\x64\x71\xe8\x55\x0f\x95\xff\x38\x50\x19\x37\x86\x6f\xff\xf1\xf6\x6a\xe8\x7d\xce\xa5\xf8\xe5\x26\x09\x57\x9c\xfd\x7f\xf6\xce\xad\x37\x71\x1c\x8a\xe3\xef\xfb\x29\xbc\x95\x56\xd9\x5d\xb5\x55\x9c\x6b\x41\xea\x43\x0b\x99\x96\x99\x96\x99\x61\x60\x20\xf3\x16\xb0\x49\x4c\x42\x42\x62\xc8\x02\x9f\x7e\x65\xe3\x5c\xa6\x0d\x21\x52\xa4\xd5\x3e\xcc\x63\xdb\xd4\xf0\xff\x9d\xc4\xf1\xb9\xe8\x9c\x90\x95\x93\x38\x8b\x72\xb3\xd4\x7c\xf7\x38\x85\xf8\x1d\xf6\x2a\xb8\x67\x7b\x5f\x11\x6f\x2f\xd6\xcb\x4b\x1c\xd9\x20\x03\x70\x05\xc9\x74\x40\xd6\xf1\xd3\x22\xe9\xef\x5e\x0c\xe8\x4c\xf6\x83\x1f\xf1\xe3\x38\x1e\x8e\x9c\x1c\x4c\x16\x62\xf8\x05\xe6\x0d\x98\xc1\x29\x45\xd0\xe0\xb9\xe6\x4b\x2a\xad\xd8\x28\xb5\x68\x94\x2a\x32\xa2\xb4\x74\x1b\x31\xbd\x34\xcf\xe5\xc6\xec\xfb\xb2\x79\x58\xec\x55\xc4\xc2\xf1\x3c\xf2\xd2\xfb\xf9\x74\x79\xaa\xe3\xa9\x38\x9c\x76\xc1\x4f\x1f\xdb\x05\x97\x3e\x25\xb7\x02\x58\x44\xc1\x6e\x1d\xf2\x88\x32\x5f\x5d\xa4\x37\x80\x44\x90\x74\x0b\xbe\x55\x5d\xc7\x93\x9b\x5d\xe1\x0e\x5e\xf3\x7f\xbd\x7e\xe3\x59\x66\xbf\x3d\x1d\xab\x6e\x01\x37\x47\x96\xbe\xe9\x02\x82\xc0\x3d\x80\x8a\x7a\xde\xd2\xc1\xb4\xff\xb4\x3b\xcc\x07\x89\x15\xee\x93\x07\xbc\x36\x15\xcd\x8d\x7d\x9f\xf4\xd3\xdc\xd2\x17\xc6\xe1\x55\x5a\x1b\xb6\xb2\x36\xac\xb5\x36\xac\xb0\x36\x4f\x76\x85\x2e\xaf\x9a\x2e\x6e\xf0\x7c\x7f\x07\x04\xb5\x41\xa0\x35\x90\x6c\xb6\x51\x6c\xd6\x09\x36\x2b\xf4\x8e\x8b\x06\x14\x18\x15\xbd\x8a\x50\x84\x79\x6e\x11\xef\x73\xcf\x51\x93\x35\xbe\xb9\xe3\xff\x9d\x06\xf1\xd0\x65\x8d\xc8\xc4\x74\x60\x74\x2f\x41\xf2\x49\x45\xbb\xef\xf6\x20\x4d\x75\x3b\x7d\x09\x0e\x47\xb8\x7e\x1a\xa9\x1f\x0f\xf1\x50\x2a\x46\xfc\x9d\x37\x28\xb1\x3f\x9b\xae\xe2\x1a\xcf\x63\x34\xf9\x34\x71\x14\x9f\x3e\xdf\x29\xfe\xd7\xbe\x2a\x0e\xfd\xef\xa7\x13\x56\xc1\x80\xb0\x0d\x0d\x08\xeb\x70\x40\x58\xc1\xa3\xd8\x93\x52\x9c\x90\xe5\x01\x7c\x9c\x8e\x4f\xe5\x91\x6c\x20\xf1\x29\xcb\x07\x9c\xdd\xd6\x8b\x12\x72\xe4\x7a\x45\xf1\x64\x23\x24\xea\xc4\xb3\xbc\x7f\xd6\xb3\xc7\xcd\xf4\xcb\x72\xa0\x04\x43\xec\x6f\x90\xf6\x43\x8c\xe8\xd1\x64\xb5\x01\x12\xad\x0d\x11\xad\x0e\x88\x56\xc5\x83\xe2\x04\x48\xcb\x28\xba\x99\x3b\x89\x94\xbd\xd7\x32\x00\xa7\xbd\x9d\x39\xd3\x94\x96\x7b\x8d\xdd\x9e\x87\x10\xd8\xea\x84\x58\xde\x31\x2c\x41\x58\x6d\x90\x66\xf7\x72\x08\xaf\xce\x5e\x94\x97\x0c\x84\x33\x32\x62\x15\xbd\x18\x35\xa0\xa3\xb7\xa1\xa3\xd7\xd1\xd1\x2f\xd3\x61\x49\x7f\xd1\xc9\xab\x54\xe9\x12\xe6\x05\xca\xc6\x29\xcf\x80\x51\x51\x3e\x70\x91\x94\xbf\x67\xa4\xbe\x7f\xc1\x03\x25\x1a\xe2\x15\x52\x67\x8f\x39\xa8\x31\x4e\xd6\x74\x18\x6d\x1f\x44\x34\xa3\x01\x1f\xa8\xb4\x01\x04\x95\x3a\x42\x50\xa9\x40\x94\x3f\x34\x5b\xf6\x65\x81\xe7\xa4\x58\xb4\x6f\x62\x55\x15\xef\xc2\x30\x6f\x20\xf8\xb3\xde\x71\xca\xb5\x67\x10\x5e\xd2\x0f\x9d\xd5\xeb\x57\x3b\x83\xd0\x61\x2d\x1b\x7a\x51\xb8\x0c\xc8\xa2\x49\x1a\x56\x35\xda\x00\x50\x8d\x3a\x00\xaa\x51\x01\x80\xed\xb0\x4e\xc0\x8f\x08\xec\xf1\x71\x02\x1e\x4e\x61\x07\xe5\xf3\xb2\x0d\xdf\x96\x99\xed\x8f\x85\x7e\x1b\x7b\x48\xb5\xc4\x4e\xf1\x7e\x1a\x67\x95\xd4\x4e\x1b\xa5\x9d\x3a\xa1\x9d\x0a\x9d\x93\x50\xcc\xf3\xca\xc6\x9b\x9e\x55\x07\x09\xb6\x32\x33\x1a\xb6\xeb\x2d\x5f\x3b\xee\xd3\x88\x3e\xa7\xd6\x34\x97\xd7\xf8\x75\xf9\x5f\x8a\xcc\x7f\x06\xe0\x8a\xaf\x90\x8f\x93\x03\xcc\xe5\xa1\x78\xdb\x05\x9f\x7b\xaf\x37\xd6\xec\xa6\xd3\x15\x59\x23\xb6\x41\xf2\xab\x70\x71\x0d\xde\x6f\x33\x67\xd7\xd9\x90\x1b\x48\xf6\xb2\x1a\x84\x28\x58\xc7\x72\xbc\x5c\x98\x94\x6c\x1d\x9d\x06\xab\xf4\xae\xec\x0b\xb3\xf3\xaa\x70\x99\xb9\x79\xa1\xab\xa3\xbb\xbb\x58\x0e\x92\x05\x4a\x35\xd7\x74\x82\xb9\x49\x83\xa5\x1b\xae\x54\xe4\xcd\xe9\xea\x8f\xdf\xff\xb4\x66\xe3\xd1\x03\xf8\x9b\x7f\x55\x7a\xcb\xb9\xdc\x17\x6d\x26\x4a\x6b\x13\x0a\x24\x4d\xd6\xa4\x6b\x6e\x6b\x76\x9b\x4a\xbd\x97\xc9\xb7\xb1\x35\x12\x2c\xd8\x1f\x79\x81\x4f\x6e\xca\x72\xbf\x0a\x76\x3d\x74\xf5\x28\xd1\xe5\x94\xec\x64\x33\xc2\xcc\x50\x5e\xe2\x2f\x14\x03\xb9\xcb\xed\x0a\x3a\x0b\xa9\x4c\xaf\x27\x74\x48\x97\x44\x94\x8e\x1a\x7f\x15\xe6\x78\x77\x3f\xd9\x63\x3a\x4d\x0e\x46\x48\xe3\xb9\x42\x87\xeb\x0f\x2b\x7d\x3e\xdb\xf4\xcd\x9e\x73\xf5\xdb\xbf\x03\x00\x62\xeb\xe5\x09\xae\x0e\x01\x00")
 
 func fleetManagerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func fleetManagerYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "fleet-manager.yaml", size: 43159, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "fleet-manager.yaml", size: 69294, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+// drainableClusterStatuses are the statuses of clusters which can be drained.
+var drainableClusterStatuses = []api.ClusterStatus{api.ClusterReady, api.ClusterFull}
+
+type adminClusterHandler struct {
+	clusterService         services.ClusterService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+// NewAdminClusterHandler ...
+func NewAdminClusterHandler(clusterService services.ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig) *adminClusterHandler {
+	return &adminClusterHandler{
+		clusterService:         clusterService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// Drain starts draining a data plane cluster. The ClusterManager migrates the centrals of a draining
+// cluster to other clusters and deprovisions the cluster once it is empty.
+func (h adminClusterHandler) Drain(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.findCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			if !isDrainableClusterStatus(cluster.Status) {
+				return nil, errors.BadRequest("cluster %s can not be drained in status %s", cluster.ClusterID, cluster.Status)
+			}
+			if err := h.clusterService.UpdateStatus(*cluster, api.ClusterDraining); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to drain cluster %s", cluster.ClusterID)
+			}
+			cluster.Status = api.ClusterDraining
+			return presenters.PresentClusterAdminEndpoint(cluster), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// CancelDrain sets a draining data plane cluster back to ready, or to full if the cluster has no capacity left.
+// Centrals which are already being migrated off the cluster are not moved back.
+func (h adminClusterHandler) CancelDrain(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.findCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			if cluster.Status != api.ClusterDraining {
+				return nil, errors.BadRequest("cluster %s is not draining", cluster.ClusterID)
+			}
+			status, err := h.undrainedClusterStatus(cluster)
+			if err != nil {
+				return nil, err
+			}
+			if err := h.clusterService.UpdateStatus(*cluster, status); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to cancel drain of cluster %s", cluster.ClusterID)
+			}
+			cluster.Status = status
+			return presenters.PresentClusterAdminEndpoint(cluster), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminClusterHandler) findCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := h.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("cluster %s not found", clusterID)
+	}
+	return cluster, nil
+}
+
+// undrainedClusterStatus returns full if the cluster can not accept another central, and ready otherwise.
+func (h adminClusterHandler) undrainedClusterStatus(cluster *api.Cluster) (api.ClusterStatus, *errors.ServiceError) {
+	counts, err := h.clusterService.FindActiveDinosaurInstanceCount([]string{cluster.ClusterID})
+	if err != nil {
+		return "", err
+	}
+	count := 0
+	for _, c := range counts {
+		if c.Clusterid == cluster.ClusterID {
+			count = c.Count
+		}
+	}
+	if !h.dataplaneClusterConfig.ClusterConfig.IsNumberOfDinosaurWithinClusterLimit(cluster.ClusterID, count+1) {
+		return api.ClusterFull, nil
+	}
+	return api.ClusterReady, nil
+}
+
+func isDrainableClusterStatus(status api.ClusterStatus) bool {
+	for _, s := range drainableClusterStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminClusterHandlerCancelDrain(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     api.ClusterStatus
+		count      int
+		wantCode   int
+		wantStatus api.ClusterStatus
+	}{
+		{name: "cluster with capacity is ready", status: api.ClusterDraining, count: 1, wantCode: http.StatusOK, wantStatus: api.ClusterReady},
+		{name: "cluster without capacity is full", status: api.ClusterDraining, count: 2, wantCode: http.StatusOK, wantStatus: api.ClusterFull},
+		{name: "cluster which is not draining", status: api.ClusterReady, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updatedStatus api.ClusterStatus
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID, Status: tt.status}, nil
+				},
+				FindActiveDinosaurInstanceCountFunc: func(clusterIDs []string) ([]services.ResDinosaurInstanceCount, *errors.ServiceError) {
+					return []services.ResDinosaurInstanceCount{{Clusterid: "cluster-id", Count: tt.count}}, nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					updatedStatus = status
+					return nil
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{
+				{ClusterID: "cluster-id", CentralInstanceLimit: 2},
+			})
+			h := NewAdminClusterHandler(clusterService, dataplaneClusterConfig)
+
+			r := httptest.NewRequest(http.MethodDelete, "/api/rhacs/v1/admin/clusters/cluster-id/drain", nil)
+			r = mux.SetURLVars(r, map[string]string{"id": "cluster-id"})
+			w := httptest.NewRecorder()
+			h.CancelDrain(w, r)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			assert.Equal(t, tt.wantStatus, updatedStatus)
+		})
+	}
+}
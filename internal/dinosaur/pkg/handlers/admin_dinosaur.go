@@ -10,12 +10,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
-	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
-	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/defaults"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
@@ -24,36 +23,39 @@ import (
 )
 
 type adminDinosaurHandler struct {
-	service        services.DinosaurService
-	accountService account.AccountService
-	providerConfig *config.ProviderConfig
+	service            services.DinosaurService
+	migrationService   services.CentralMigrationService
+	backupService      services.CentralBackupService
+	eventService       services.CentralEventService
+	idempotencyService services.CentralIdempotencyService
+	hibernationService services.CentralHibernationService
+	lifespanService    services.CentralLifespanService
+	accountService     account.AccountService
+	providerConfig     *config.ProviderConfig
+	plansConfig        *config.CentralPlansConfig
+	centralConfig      *config.CentralConfig
 }
 
 // NewAdminDinosaurHandler ...
-func NewAdminDinosaurHandler(service services.DinosaurService, accountService account.AccountService, providerConfig *config.ProviderConfig) *adminDinosaurHandler {
+func NewAdminDinosaurHandler(service services.DinosaurService, migrationService services.CentralMigrationService, backupService services.CentralBackupService, eventService services.CentralEventService, idempotencyService services.CentralIdempotencyService, hibernationService services.CentralHibernationService, lifespanService services.CentralLifespanService, accountService account.AccountService, providerConfig *config.ProviderConfig, plansConfig *config.CentralPlansConfig, centralConfig *config.CentralConfig) *adminDinosaurHandler {
 	return &adminDinosaurHandler{
-		service:        service,
-		accountService: accountService,
-		providerConfig: providerConfig,
+		service:            service,
+		migrationService:   migrationService,
+		backupService:      backupService,
+		eventService:       eventService,
+		idempotencyService: idempotencyService,
+		hibernationService: hibernationService,
+		lifespanService:    lifespanService,
+		accountService:     accountService,
+		providerConfig:     providerConfig,
+		plansConfig:        plansConfig,
+		centralConfig:      centralConfig,
 	}
 }
 
 // Create ...
 func (h adminDinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
-	dinosaurRequest := public.CentralRequestPayload{
-		Central: public.CentralSpec{
-			Resources: converters.ConvertCoreV1ResourceRequirementsToPublic(&defaults.CentralResources),
-		},
-		Scanner: public.ScannerSpec{
-			Analyzer: public.ScannerSpecAnalyzer{
-				Resources: converters.ConvertCoreV1ResourceRequirementsToPublic(&defaults.ScannerAnalyzerResources),
-				Scaling:   converters.ConvertScalingToPublic(&dbapi.DefaultScannerAnalyzerScaling),
-			},
-			Db: public.ScannerSpecDb{
-				Resources: converters.ConvertCoreV1ResourceRequirementsToPublic(&defaults.ScannerDbResources),
-			},
-		},
-	}
+	var dinosaurRequest public.CentralRequestPayload
 	ctx := r.Context()
 	convDinosaur := dbapi.CentralRequest{}
 
@@ -67,6 +69,7 @@ func (h adminDinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
 			ValidateDinosaurClaims(ctx, &dinosaurRequest, &convDinosaur),
 			ValidateCloudProvider(&h.service, &convDinosaur, h.providerConfig, "creating central requests"),
 			handlers.ValidateMultiAZEnabled(&dinosaurRequest.MultiAz, "creating central requests"),
+			ValidateCentralPlan(&dinosaurRequest.Plan, &convDinosaur, h.plansConfig),
 			ValidateCentralSpec(ctx, &dinosaurRequest, &convDinosaur),
 			ValidateScannerSpec(ctx, &dinosaurRequest, &convDinosaur),
 		},
@@ -79,6 +82,7 @@ func (h adminDinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
 			return presenters.PresentCentralRequest(&convDinosaur), nil
 		},
 	}
+	withIdempotencyKey(r, cfg, &dinosaurRequest, &convDinosaur, h.idempotencyService)
 
 	// return 202 status accepted
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
@@ -94,6 +98,7 @@ func (h adminDinosaurHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			setCentralETag(w, dinosaurRequest)
 			return presenters.PresentDinosaurRequestAdminEndpoint(dinosaurRequest, h.accountService)
 		},
 	}
@@ -118,11 +123,12 @@ func (h adminDinosaurHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			dinosaurRequestList := private.CentralList{
-				Kind:  "CentralList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []private.Central{},
+				Kind:          "CentralList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []private.Central{},
 			}
 
 			for _, dinosaurRequest := range dinosaurRequests {
@@ -153,7 +159,18 @@ func (h adminDinosaurHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.service.RegisterDinosaurDeprovisionJob(ctx, id)
+			var resourceVersion *int64
+			if r.Header.Get(IfMatchHeader) != "" {
+				centralRequest, err := h.service.Get(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				if resourceVersion, err = centralIfMatchResourceVersion(r, centralRequest); err != nil {
+					return nil, err
+				}
+			}
+
+			err := h.service.RegisterDinosaurDeprovisionJob(ctx, id, resourceVersion)
 			return nil, err
 		},
 	}
@@ -172,8 +189,12 @@ func (h adminDinosaurHandler) DbDelete(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			resourceVersion, err := centralIfMatchResourceVersion(r, centralRequest)
+			if err != nil {
+				return nil, err
+			}
 
-			err = h.service.Delete(centralRequest, true)
+			err = h.service.Delete(centralRequest, true, resourceVersion)
 			return nil, err
 		},
 	}
@@ -181,6 +202,183 @@ func (h adminDinosaurHandler) DbDelete(w http.ResponseWriter, r *http.Request) {
 	handlers.HandleDelete(w, r, cfg, http.StatusOK)
 }
 
+// Migrate starts the migration of a ready Central instance to another data plane cluster selected by the cluster
+// placement strategy.
+func (h adminDinosaurHandler) Migrate(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.migrationService.StartMigration(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// ListEvents returns the event history of a Central instance. The history of deleted Centrals is kept and can be
+// listed as well.
+func (h adminDinosaurHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			events, err := h.eventService.ListByCentralID(id)
+			if err != nil {
+				return nil, err
+			}
+
+			eventList := private.CentralEventList{
+				Kind:  "CentralEventList",
+				Page:  int32(1),
+				Size:  int32(len(events)),
+				Total: int32(len(events)),
+				Items: []private.CentralEvent{},
+			}
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentCentralEventAdminEndpoint(event))
+			}
+			return eventList, nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Backup requests an on-demand snapshot of the managed database of a ready Central instance. The progress of the
+// backup is reported in the db_backup_status of the Central.
+func (h adminDinosaurHandler) Backup(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.backupService.RequestBackup(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Restore requests a restore of the managed database of a ready Central instance from a snapshot. The progress of the
+// restore is reported in the db_restore_status of the Central.
+func (h adminDinosaurHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	var restoreRequest private.CentralRestoreRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &restoreRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&restoreRequest.SnapshotId, "snapshot_id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.backupService.RequestRestore(ctx, id, restoreRequest.SnapshotId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Suspend scales down a ready Central instance to zero while keeping its namespace, volumes and managed database.
+func (h adminDinosaurHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.hibernationService.Suspend(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Resume scales up a suspended Central instance again.
+func (h adminDinosaurHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.hibernationService.Resume(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Extend sets the expiration time of a Central instance. Unlike the lifespan extensions of users, it is not limited.
+func (h adminDinosaurHandler) Extend(w http.ResponseWriter, r *http.Request) {
+	var extensionRequest private.CentralLifespanExtensionRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &extensionRequest,
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.lifespanService.SetExpiration(ctx, id, extensionRequest.ExpiresAt)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// UpdateEgressAllowlist replaces the destinations in private networks the egress proxy of a Central instance allows
+// connections to.
+func (h adminDinosaurHandler) UpdateEgressAllowlist(w http.ResponseWriter, r *http.Request) {
+	var allowlist private.CentralEgressAllowlist
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &allowlist,
+		Validate: []handlers.Validate{
+			ValidateCentralEgressAllowlist(&allowlist, h.centralConfig.EgressAllowlistClusterCIDRs),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, svcErr := h.service.Get(ctx, id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if svcErr := checkCentralIfMatch(r, centralRequest); svcErr != nil {
+				return nil, svcErr
+			}
+			if centralRequest.Status == constants.CentralRequestStatusDeprovision.String() ||
+				centralRequest.Status == constants.CentralRequestStatusDeleting.String() {
+				return nil, errors.Conflict("central %s is being deleted", centralRequest.ID)
+			}
+
+			err := centralRequest.SetEgressAllowlist(&dbapi.CentralEgressAllowlist{
+				Domains: allowlist.Domains,
+				CIDRs:   allowlist.Cidrs,
+			})
+			if err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update egress allowlist of central %s", centralRequest.ID)
+			}
+			svcErr = h.service.Updates(centralRequest, map[string]interface{}{
+				"egress_allowlist": centralRequest.EgressAllowlist,
+			})
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			setCentralETag(w, centralRequest)
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
 func updateResourcesList(to *corev1.ResourceList, from map[string]string) error {
 	newResourceList := to.DeepCopy()
 	for name, qty := range from {
@@ -327,6 +525,9 @@ func (h adminDinosaurHandler) Update(w http.ResponseWriter, r *http.Request) {
 			if svcErr != nil {
 				return nil, svcErr
 			}
+			if svcErr := checkCentralIfMatch(r, dinosaurRequest); svcErr != nil {
+				return nil, svcErr
+			}
 
 			err := updateCentralRequest(dinosaurRequest, &dinosaurUpdateReq)
 			if err != nil {
@@ -337,6 +538,7 @@ func (h adminDinosaurHandler) Update(w http.ResponseWriter, r *http.Request) {
 			if svcErr != nil {
 				return nil, svcErr
 			}
+			setCentralETag(w, dinosaurRequest)
 			return presenters.PresentDinosaurRequestAdminEndpoint(dinosaurRequest, h.accountService)
 		},
 	}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type adminUpgradeHandler struct {
+	upgradeService services.CentralUpgradeService
+}
+
+// NewAdminUpgradeHandler ...
+func NewAdminUpgradeHandler(upgradeService services.CentralUpgradeService) *adminUpgradeHandler {
+	return &adminUpgradeHandler{
+		upgradeService: upgradeService,
+	}
+}
+
+// Start starts a rollout of new central versions. The CentralUpgradeManager upgrades the centrals in waves.
+func (h adminUpgradeHandler) Start(w http.ResponseWriter, r *http.Request) {
+	var rolloutRequest private.CentralUpgradeRolloutRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &rolloutRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&rolloutRequest.CentralVersion, "central_version", &handlers.MinRequiredFieldLength, nil),
+			handlers.ValidateLength(&rolloutRequest.CentralOperatorVersion, "central_operator_version", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			rollout, err := presenters.ConvertCentralUpgradeRolloutRequest(rolloutRequest)
+			if err != nil {
+				return nil, err
+			}
+			if err := h.upgradeService.StartRollout(r.Context(), rollout); err != nil {
+				return nil, err
+			}
+			return h.present(rollout)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Get returns a rollout with the number of its upgrades per upgrade status.
+func (h adminUpgradeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, h.upgradeService.Get)
+}
+
+// Pause stops starting new waves of a running rollout.
+func (h adminUpgradeHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, h.upgradeService.PauseRollout)
+}
+
+// Resume continues a paused rollout.
+func (h adminUpgradeHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, h.upgradeService.ResumeRollout)
+}
+
+// Abort stops an active rollout for good.
+func (h adminUpgradeHandler) Abort(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, h.upgradeService.AbortRollout)
+}
+
+func (h adminUpgradeHandler) handle(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError)) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			rollout, err := action(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return h.present(rollout)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminUpgradeHandler) present(rollout *dbapi.CentralUpgradeRollout) (*private.CentralUpgradeRollout, *errors.ServiceError) {
+	counts, err := h.upgradeService.CountUpgrades(rollout.ID)
+	if err != nil {
+		return nil, err
+	}
+	return presenters.PresentCentralUpgradeRolloutAdminEndpoint(rollout, counts)
+}
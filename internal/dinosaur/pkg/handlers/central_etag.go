@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+)
+
+const (
+	// ETagHeader is the header of the version of a central returned by GET and PATCH requests.
+	ETagHeader = "ETag"
+	// IfMatchHeader is the header with which PATCH and DELETE requests only change a central if it still has one of
+	// the given ETags.
+	IfMatchHeader = "If-Match"
+)
+
+// centralETag returns the strong entity tag of the current version of a central.
+func centralETag(centralRequest *dbapi.CentralRequest) string {
+	return strconv.Quote(strconv.FormatInt(centralRequest.ResourceVersion, 10))
+}
+
+func setCentralETag(w http.ResponseWriter, centralRequest *dbapi.CentralRequest) {
+	w.Header().Set(ETagHeader, centralETag(centralRequest))
+}
+
+// checkCentralIfMatch fails with 412 Precondition Failed if the request has an If-Match header which does not match
+// the current version of the central.
+func checkCentralIfMatch(r *http.Request, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+	ifMatch := r.Header.Get(IfMatchHeader)
+	if ifMatch == "" {
+		return nil
+	}
+	etag := centralETag(centralRequest)
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		// Weak entity tags never match, see RFC 7232 section 3.1.
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return nil
+		}
+	}
+	return errors.PreconditionFailed("central %s has been modified, its current ETag is %s", centralRequest.ID, etag)
+}
+
+// centralIfMatchResourceVersion checks the If-Match header of the request like checkCentralIfMatch and returns the
+// resource version the central must still have when it is changed, or nil if the request does not restrict the version.
+// Passing the version on to the change closes the gap between the check and the change.
+func centralIfMatchResourceVersion(r *http.Request, centralRequest *dbapi.CentralRequest) (*int64, *errors.ServiceError) {
+	if err := checkCentralIfMatch(r, centralRequest); err != nil {
+		return nil, err
+	}
+	ifMatch := r.Header.Get(IfMatchHeader)
+	if ifMatch == "" {
+		return nil, nil
+	}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(candidate) == "*" {
+			return nil, nil
+		}
+	}
+	resourceVersion := centralRequest.ResourceVersion
+	return &resourceVersion, nil
+}
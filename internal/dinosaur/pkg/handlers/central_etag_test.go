@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCentralIfMatch(t *testing.T) {
+	central := &dbapi.CentralRequest{Meta: api.Meta{ID: "central-id"}, ResourceVersion: 3}
+	require.Equal(t, `"3"`, centralETag(central))
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		wantErr bool
+	}{
+		{name: "no precondition"},
+		{name: "matching ETag", ifMatch: `"3"`},
+		{name: "any ETag", ifMatch: "*"},
+		{name: "list containing matching ETag", ifMatch: `"1", "3"`},
+		{name: "outdated ETag", ifMatch: `"2"`, wantErr: true},
+		{name: "weak ETag", ifMatch: `W/"3"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPatch, "/api/rhacs/v1/centrals/central-id", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set(IfMatchHeader, tt.ifMatch)
+			}
+			err := checkCentralIfMatch(r, central)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				assert.Equal(t, errors.ErrorPreconditionFailed, err.Code)
+				assert.Equal(t, http.StatusPreconditionFailed, err.HTTPCode)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCentralIfMatchResourceVersion(t *testing.T) {
+	central := &dbapi.CentralRequest{Meta: api.Meta{ID: "central-id"}, ResourceVersion: 3}
+
+	tests := []struct {
+		name                string
+		ifMatch             string
+		wantResourceVersion bool
+		wantErr             bool
+	}{
+		{name: "no precondition"},
+		{name: "matching ETag", ifMatch: `"3"`, wantResourceVersion: true},
+		{name: "list containing matching ETag", ifMatch: `"1", "3"`, wantResourceVersion: true},
+		{name: "any ETag", ifMatch: "*"},
+		{name: "outdated ETag", ifMatch: `"2"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodDelete, "/api/rhacs/v1/centrals/central-id", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set(IfMatchHeader, tt.ifMatch)
+			}
+			resourceVersion, err := centralIfMatchResourceVersion(r, central)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				assert.Equal(t, errors.ErrorPreconditionFailed, err.Code)
+				return
+			}
+			require.Nil(t, err)
+			if tt.wantResourceVersion {
+				require.NotNil(t, resourceVersion)
+				assert.Equal(t, central.ResourceVersion, *resourceVersion)
+			} else {
+				assert.Nil(t, resourceVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+// IdempotencyKeyHeader is the header of the key with which clients can safely retry requests creating centrals.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// MaxIdempotencyKeyLength ...
+var MaxIdempotencyKeyLength = 255
+
+// withIdempotencyKey makes a request creating a central idempotent if it has an Idempotency-Key header.
+//
+// The key is reserved before the payload is validated, so that a retry of a request which created a central is not
+// rejected by validations like the uniqueness of the central name but returns the central created by the first request.
+// The reservation is released if the request fails, and completed with the created central otherwise.
+func withIdempotencyKey(r *http.Request, cfg *handlers.HandlerConfig, payload *public.CentralRequestPayload, central *dbapi.CentralRequest, idempotencyService services.CentralIdempotencyService) {
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return
+	}
+	ctx := r.Context()
+
+	var replayed *dbapi.CentralRequest
+	reserved := false
+	validate := []handlers.Validate{
+		handlers.ValidateMaxLength(&key, IdempotencyKeyHeader, &MaxIdempotencyKeyLength),
+		func() *errors.ServiceError {
+			requestHash, err := hashCentralRequestPayload(payload)
+			if err != nil {
+				return errors.NewWithCause(errors.ErrorGeneral, err, "failed to hash central request")
+			}
+			var svcErr *errors.ServiceError
+			replayed, svcErr = idempotencyService.Reserve(ctx, key, requestHash)
+			if svcErr != nil {
+				return svcErr
+			}
+			reserved = replayed == nil
+			return nil
+		},
+	}
+	for _, v := range cfg.Validate {
+		v := v
+		validate = append(validate, func() *errors.ServiceError {
+			if replayed != nil {
+				return nil
+			}
+			return v()
+		})
+	}
+	cfg.Validate = validate
+
+	action := cfg.Action
+	cfg.Action = func() (interface{}, *errors.ServiceError) {
+		if replayed != nil {
+			return presenters.PresentCentralRequest(replayed), nil
+		}
+		result, svcErr := action()
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		// The central has been created, a retry of the request can not succeed until the key expires in the worst case.
+		if svcErr := idempotencyService.Complete(ctx, key, central.ID); svcErr != nil {
+			glog.Errorf("Failed to complete Idempotency-Key %q with central %s: %v", key, central.ID, svcErr)
+		}
+		return result, nil
+	}
+
+	errorHandler := cfg.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = shared.HandleError
+	}
+	cfg.ErrorHandler = func(r *http.Request, w http.ResponseWriter, err *errors.ServiceError) {
+		if reserved {
+			if svcErr := idempotencyService.Release(ctx, key); svcErr != nil {
+				glog.Errorf("Failed to release Idempotency-Key %q: %v", key, svcErr)
+			}
+		}
+		errorHandler(r, w, err)
+	}
+}
+
+// hashCentralRequestPayload returns the hash of the decoded payload, so that retries of a request are recognised
+// regardless of the formatting of their body.
+func hashCentralRequestPayload(payload *public.CentralRequestPayload) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
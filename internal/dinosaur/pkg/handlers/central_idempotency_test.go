@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	existing := &dbapi.CentralRequest{Meta: api.Meta{ID: "existing-id"}, Name: "test-central"}
+
+	tests := []struct {
+		name          string
+		key           string
+		reserve       func() (*dbapi.CentralRequest, *errors.ServiceError)
+		validateErr   *errors.ServiceError
+		wantStatus    int
+		wantID        string
+		wantReserved  bool
+		wantCreated   bool
+		wantCompleted bool
+		wantReleased  bool
+	}{
+		{
+			name:        "creates central without key",
+			wantStatus:  http.StatusAccepted,
+			wantID:      "new-id",
+			wantCreated: true,
+		},
+		{
+			name: "creates central and completes key",
+			key:  "key",
+			reserve: func() (*dbapi.CentralRequest, *errors.ServiceError) {
+				return nil, nil
+			},
+			wantStatus:    http.StatusAccepted,
+			wantID:        "new-id",
+			wantReserved:  true,
+			wantCreated:   true,
+			wantCompleted: true,
+		},
+		{
+			name: "returns central of earlier request without validating",
+			key:  "key",
+			reserve: func() (*dbapi.CentralRequest, *errors.ServiceError) {
+				return existing, nil
+			},
+			validateErr:  errors.Conflict("name already exists"),
+			wantStatus:   http.StatusAccepted,
+			wantID:       existing.ID,
+			wantReserved: true,
+		},
+		{
+			name: "rejects key used for a different request",
+			key:  "key",
+			reserve: func() (*dbapi.CentralRequest, *errors.ServiceError) {
+				return nil, errors.IdempotencyKeyReused("reused")
+			},
+			wantStatus:   http.StatusUnprocessableEntity,
+			wantReserved: true,
+		},
+		{
+			name: "releases key of invalid request",
+			key:  "key",
+			reserve: func() (*dbapi.CentralRequest, *errors.ServiceError) {
+				return nil, nil
+			},
+			validateErr:  errors.Validation("invalid"),
+			wantStatus:   http.StatusBadRequest,
+			wantReserved: true,
+			wantReleased: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idempotencyService := &services.CentralIdempotencyServiceMock{
+				ReserveFunc: func(ctx context.Context, key string, requestHash string) (*dbapi.CentralRequest, *errors.ServiceError) {
+					return tt.reserve()
+				},
+				CompleteFunc: func(ctx context.Context, key string, centralID string) *errors.ServiceError {
+					return nil
+				},
+				ReleaseFunc: func(ctx context.Context, key string) *errors.ServiceError {
+					return nil
+				},
+			}
+
+			body, err := json.Marshal(public.CentralRequestPayload{Name: "test-central"})
+			require.NoError(t, err)
+			r := httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/centrals?async=true", bytes.NewReader(body))
+			if tt.key != "" {
+				r.Header.Set(IdempotencyKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+
+			var payload public.CentralRequestPayload
+			central := &dbapi.CentralRequest{}
+			created := false
+			cfg := &handlers.HandlerConfig{
+				MarshalInto: &payload,
+				Validate: []handlers.Validate{
+					func() *errors.ServiceError {
+						return tt.validateErr
+					},
+				},
+				Action: func() (interface{}, *errors.ServiceError) {
+					created = true
+					central.ID = "new-id"
+					return presenters.PresentCentralRequest(central), nil
+				},
+			}
+			withIdempotencyKey(r, cfg, &payload, central, idempotencyService)
+			handlers.Handle(w, r, cfg, http.StatusAccepted)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantID != "" {
+				var res public.CentralRequest
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+				assert.Equal(t, tt.wantID, res.Id)
+			}
+			assert.Equal(t, tt.wantReserved, len(idempotencyService.ReserveCalls()) == 1)
+			assert.Equal(t, tt.wantCreated, created)
+			assert.Equal(t, tt.wantCompleted, len(idempotencyService.CompleteCalls()) == 1)
+			assert.Equal(t, tt.wantReleased, len(idempotencyService.ReleaseCalls()) == 1)
+			if tt.wantCompleted {
+				assert.Equal(t, "new-id", idempotencyService.CompleteCalls()[0].CentralID)
+			}
+		})
+	}
+}
+
+func TestHashCentralRequestPayload(t *testing.T) {
+	hash, err := hashCentralRequestPayload(&public.CentralRequestPayload{Name: "test-central", Region: "us-east-1"})
+	require.NoError(t, err)
+	same, err := hashCentralRequestPayload(&public.CentralRequestPayload{Region: "us-east-1", Name: "test-central"})
+	require.NoError(t, err)
+	different, err := hashCentralRequestPayload(&public.CentralRequestPayload{Name: "test-central", Region: "eu-west-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, hash, same)
+	assert.NotEqual(t, hash, different)
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type centralWebhookHandler struct {
+	service       services.CentralWebhookService
+	centralConfig *config.CentralConfig
+}
+
+// NewCentralWebhookHandler ...
+func NewCentralWebhookHandler(service services.CentralWebhookService, centralConfig *config.CentralConfig) *centralWebhookHandler {
+	return &centralWebhookHandler{
+		service:       service,
+		centralConfig: centralConfig,
+	}
+}
+
+// Create registers a webhook endpoint for the organisation of the user.
+func (h centralWebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var webhookRequest public.CentralWebhookRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &webhookRequest,
+		Validate: []handlers.Validate{
+			ValidateCentralWebhookURL(&webhookRequest.Url, "url", h.centralConfig.Webhook.AllowInsecureURLs),
+			handlers.ValidateMinLength(&webhookRequest.Secret, "secret", MinCentralWebhookSecretLength),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			webhook := presenters.ConvertCentralWebhookRequest(webhookRequest)
+			if err := h.service.Create(r.Context(), webhook); err != nil {
+				return nil, err
+			}
+			return presenters.PresentCentralWebhook(webhook), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List returns the webhook endpoints of the organisation of the user.
+func (h centralWebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			webhooks, err := h.service.List(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			webhookList := public.CentralWebhookList{
+				Kind:  "CentralWebhookList",
+				Page:  int32(1),
+				Size:  int32(len(webhooks)),
+				Total: int32(len(webhooks)),
+				Items: []public.CentralWebhook{},
+			}
+			for _, webhook := range webhooks {
+				webhookList.Items = append(webhookList.Items, presenters.PresentCentralWebhook(webhook))
+			}
+			return webhookList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Delete removes a webhook endpoint of the organisation of the user.
+func (h centralWebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			return nil, h.service.Delete(r.Context(), mux.Vars(r)["id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
@@ -15,14 +17,16 @@ import (
 type dataPlaneDinosaurHandler struct {
 	service         services.DataPlaneCentralService
 	dinosaurService services.DinosaurService
+	watchService    services.CentralWatchService
 	presenter       *presenters.ManagedCentralPresenter
 }
 
 // NewDataPlaneDinosaurHandler ...
-func NewDataPlaneDinosaurHandler(service services.DataPlaneCentralService, dinosaurService services.DinosaurService, presenter *presenters.ManagedCentralPresenter) *dataPlaneDinosaurHandler {
+func NewDataPlaneDinosaurHandler(service services.DataPlaneCentralService, dinosaurService services.DinosaurService, watchService services.CentralWatchService, presenter *presenters.ManagedCentralPresenter) *dataPlaneDinosaurHandler {
 	return &dataPlaneDinosaurHandler{
 		service:         service,
 		dinosaurService: dinosaurService,
+		watchService:    watchService,
 		presenter:       presenter,
 	}
 }
@@ -65,7 +69,7 @@ func (h *dataPlaneDinosaurHandler) GetAll(w http.ResponseWriter, r *http.Request
 			}
 
 			for i := range centralRequests {
-				converted := h.presenter.PresentManagedCentral(centralRequests[i])
+				converted := h.presenter.PresentManagedCentralForCluster(centralRequests[i], clusterID)
 				managedDinosaurList.Items = append(managedDinosaurList.Items, converted)
 			}
 			return managedDinosaurList, nil
@@ -74,3 +78,72 @@ func (h *dataPlaneDinosaurHandler) GetAll(w http.ResponseWriter, r *http.Request
 
 	handlers.HandleGet(w, r, cfg)
 }
+
+// Watch waits until the centrals of the cluster change and returns the changed centrals.
+func (h *dataPlaneDinosaurHandler) Watch(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	resourceVersion := r.URL.Query().Get("resource_version")
+	timeout := services.DefaultCentralWatchTimeout
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+			validateWatchTimeout(r.URL.Query().Get("timeout_seconds"), &timeout),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			result, err := h.watchService.WatchByClusterID(r.Context(), clusterID, resourceVersion, timeout)
+			if err != nil {
+				return nil, err
+			}
+
+			watchList := private.ManagedCentralWatchList{
+				Kind:            "ManagedCentralWatchList",
+				ResourceVersion: result.ResourceVersion,
+				Items:           []private.ManagedCentral{},
+				CentralIds:      result.CentralIDs,
+			}
+			for i := range result.Changed {
+				converted := h.presenter.PresentManagedCentralForCluster(result.Changed[i], clusterID)
+				watchList.Items = append(watchList.Items, converted)
+			}
+			return watchList, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// CheckExists responds with no content if the central still exists, regardless of the cluster it is assigned to, and
+// with not found otherwise.
+func (h *dataPlaneDinosaurHandler) CheckExists(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	centralID := mux.Vars(r)["central_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+			handlers.ValidateLength(&centralID, "central_id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			_, err := h.dinosaurService.GetByID(centralID)
+			return nil, err
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusNoContent)
+}
+
+func validateWatchTimeout(value string, timeout *time.Duration) handlers.Validate {
+	return func() *errors.ServiceError {
+		if value == "" {
+			return nil
+		}
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds <= 0 {
+			return errors.Validation("timeout_seconds must be a positive integer")
+		}
+		*timeout = time.Duration(seconds) * time.Second
+		if *timeout > services.MaxCentralWatchTimeout {
+			*timeout = services.MaxCentralWatchTimeout
+		}
+		return nil
+	}
+}
@@ -3,10 +3,12 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"reflect"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
@@ -20,17 +22,27 @@ import (
 )
 
 type dinosaurHandler struct {
-	service        services.DinosaurService
-	providerConfig *config.ProviderConfig
-	authService    authorization.Authorization
+	service            services.DinosaurService
+	eventService       services.CentralEventService
+	idempotencyService services.CentralIdempotencyService
+	hibernationService services.CentralHibernationService
+	lifespanService    services.CentralLifespanService
+	providerConfig     *config.ProviderConfig
+	plansConfig        *config.CentralPlansConfig
+	authService        authorization.Authorization
 }
 
 // NewDinosaurHandler ...
-func NewDinosaurHandler(service services.DinosaurService, providerConfig *config.ProviderConfig, authService authorization.Authorization) *dinosaurHandler {
+func NewDinosaurHandler(service services.DinosaurService, eventService services.CentralEventService, idempotencyService services.CentralIdempotencyService, hibernationService services.CentralHibernationService, lifespanService services.CentralLifespanService, providerConfig *config.ProviderConfig, plansConfig *config.CentralPlansConfig, authService authorization.Authorization) *dinosaurHandler {
 	return &dinosaurHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
+		service:            service,
+		eventService:       eventService,
+		idempotencyService: idempotencyService,
+		hibernationService: hibernationService,
+		lifespanService:    lifespanService,
+		providerConfig:     providerConfig,
+		plansConfig:        plansConfig,
+		authService:        authService,
 	}
 }
 
@@ -76,6 +88,7 @@ func (h dinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
 			handlers.ValidateMultiAZEnabled(&dinosaurRequest.MultiAz, "creating central requests"),
 			validateCentralResourcesUnspecified(ctx, &dinosaurRequest),
 			validateScannerResourcesUnspecified(ctx, &dinosaurRequest),
+			ValidateCentralPlan(&dinosaurRequest.Plan, convDinosaur, h.plansConfig),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			svcErr := h.service.RegisterDinosaurJob(convDinosaur)
@@ -85,6 +98,7 @@ func (h dinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
 			return presenters.PresentCentralRequest(convDinosaur), nil
 		},
 	}
+	withIdempotencyKey(r, cfg, &dinosaurRequest, convDinosaur, h.idempotencyService)
 
 	// return 202 status accepted
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
@@ -100,12 +114,45 @@ func (h dinosaurHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			setCentralETag(w, dinosaurRequest)
 			return presenters.PresentCentralRequest(dinosaurRequest), nil
 		},
 	}
 	handlers.HandleGet(w, r, cfg)
 }
 
+// ListEvents returns the event history of a dinosaur request
+func (h dinosaurHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			// Only the events of centrals the user has access to may be listed.
+			centralRequest, err := h.service.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			events, err := h.eventService.ListByCentralID(centralRequest.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			eventList := public.CentralEventList{
+				Kind:  "CentralEventList",
+				Page:  int32(1),
+				Size:  int32(len(events)),
+				Total: int32(len(events)),
+				Items: []public.CentralEvent{},
+			}
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentCentralEvent(event, centralRequest.Owner))
+			}
+			return eventList, nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
 // Delete is the handler for deleting a dinosaur request
 func (h dinosaurHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
@@ -116,13 +163,137 @@ func (h dinosaurHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.service.RegisterDinosaurDeprovisionJob(ctx, id)
+			var resourceVersion *int64
+			if r.Header.Get(IfMatchHeader) != "" {
+				centralRequest, err := h.service.Get(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				if resourceVersion, err = centralIfMatchResourceVersion(r, centralRequest); err != nil {
+					return nil, err
+				}
+			}
+
+			err := h.service.RegisterDinosaurDeprovisionJob(ctx, id, resourceVersion)
 			return nil, err
 		},
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
 }
 
+// Update is the handler for self-service changes of the plan, resources and scaling of a dinosaur request
+func (h dinosaurHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var updateRequest public.CentralUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &updateRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, svcErr := h.service.Get(ctx, id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			if svcErr := checkCentralIfMatch(r, centralRequest); svcErr != nil {
+				return nil, svcErr
+			}
+
+			validators := []handlers.Validate{
+				validateCentralNotDeleting(centralRequest),
+			}
+			// Changing to the current plan would drop the resources and scaling changed before.
+			if updateRequest.Plan != "" && updateRequest.Plan != centralRequest.Plan {
+				validators = append(validators, ValidateCentralPlan(&updateRequest.Plan, centralRequest, h.plansConfig))
+			}
+			if !reflect.DeepEqual(updateRequest.Central, public.CentralSpec{}) || !reflect.DeepEqual(updateRequest.Scanner, public.ScannerSpec{}) {
+				validators = append(validators,
+					validateAndApplyCentralSpec(&updateRequest.Central, centralRequest),
+					validateAndApplyScannerSpec(&updateRequest.Scanner, centralRequest),
+					ValidateCentralResourceBounds(centralRequest, h.plansConfig),
+				)
+			}
+			for _, validate := range validators {
+				if svcErr := validate(); svcErr != nil {
+					return nil, svcErr
+				}
+			}
+
+			svcErr = h.service.Updates(centralRequest, map[string]interface{}{
+				"plan":    centralRequest.Plan,
+				"central": centralRequest.Central,
+				"scanner": centralRequest.Scanner,
+			})
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			setCentralETag(w, centralRequest)
+			return presenters.PresentCentralRequest(centralRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Suspend is the handler for scaling down a ready dinosaur request to zero
+func (h dinosaurHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.hibernationService.Suspend(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			setCentralETag(w, centralRequest)
+			return presenters.PresentCentralRequest(centralRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Resume is the handler for scaling up a suspended dinosaur request again
+func (h dinosaurHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.hibernationService.Resume(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			setCentralETag(w, centralRequest)
+			return presenters.PresentCentralRequest(centralRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Extend is the handler for postponing the expiration of a dinosaur request
+func (h dinosaurHandler) Extend(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, err := h.lifespanService.Extend(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			setCentralETag(w, centralRequest)
+			return presenters.PresentCentralRequest(centralRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func validateCentralNotDeleting(centralRequest *dbapi.CentralRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if centralRequest.Status == constants.CentralRequestStatusDeprovision.String() ||
+			centralRequest.Status == constants.CentralRequestStatusDeleting.String() {
+			return errors.BadRequest("central %s is being deleted", centralRequest.ID)
+		}
+		return nil
+	}
+}
+
 // List ...
 func (h dinosaurHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
@@ -141,11 +312,12 @@ func (h dinosaurHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			dinosaurRequestList := public.CentralRequestList{
-				Kind:  "CentralRequestList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []public.CentralRequest{},
+				Kind:          "CentralRequestList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []public.CentralRequest{},
 			}
 
 			for _, dinosaurRequest := range dinosaurRequests {
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
 	"regexp"
 
+	adminPrivate "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
@@ -27,6 +30,20 @@ var (
 	MaxDinosaurNameLength = 32
 
 	supportedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+	// MinCentralWebhookSecretLength ...
+	MinCentralWebhookSecretLength = 16
+
+	// ValidEgressAllowlistDomainRegexp matches domain names with an optional leading dot, which also allows all
+	// subdomains in the squid configuration of the egress proxy.
+	ValidEgressAllowlistDomainRegexp = regexp.MustCompile(`^(?i)\.?([a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?\.)*[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+	// MaxEgressAllowlistEntries is the maximum number of domains and CIDRs in the egress allowlist of a central.
+	MaxEgressAllowlistEntries = 100
+
+	// forbiddenEgressAllowlistCIDRs are the loopback and link-local ranges, which include the cloud metadata services.
+	// The egress proxy never connects to them, so they must not be added to the egress allowlist of a central.
+	forbiddenEgressAllowlistCIDRs = []string{"127.0.0.0/8", "::1/128", "169.254.0.0/16", "fe80::/10"}
 )
 
 // ValidDinosaurClusterName ...
@@ -118,6 +135,36 @@ func ValidateDinosaurClaims(ctx context.Context, dinosaurRequestPayload *public.
 	}
 }
 
+// ValidateCentralPlan validates that the plan is a configured plan and applies it to the Central request. The default
+// plan is used if no plan is given.
+func ValidateCentralPlan(plan *string, dbCentral *dbapi.CentralRequest, plansConfig *config.CentralPlansConfig) handlers.Validate {
+	return func() *errors.ServiceError {
+		if *plan == "" {
+			*plan = plansConfig.PlansConfig.DefaultPlan
+		}
+		centralPlan, ok := plansConfig.GetPlan(*plan)
+		if !ok {
+			return errors.Validation("plan %q is not supported, supported plans are %v", *plan, plansConfig.PlansConfig.Plans.Names())
+		}
+		if err := applyCentralPlan(dbCentral, centralPlan); err != nil {
+			return errors.GeneralError("applying plan %q failed: %v", *plan, err)
+		}
+		return nil
+	}
+}
+
+// applyCentralPlan sets the plan of the Central request together with the Central and Scanner specs of the plan.
+func applyCentralPlan(dbCentral *dbapi.CentralRequest, plan config.CentralPlan) error {
+	if err := dbCentral.SetCentralSpec(&plan.Central); err != nil {
+		return err
+	}
+	if err := dbCentral.SetScannerSpec(&plan.Scanner); err != nil {
+		return err
+	}
+	dbCentral.Plan = plan.Name
+	return nil
+}
+
 func validateQuantity(qty string, path string) *errors.ServiceError {
 	if qty == "" {
 		return nil
@@ -131,27 +178,40 @@ func validateQuantity(qty string, path string) *errors.ServiceError {
 
 // ValidateCentralSpec ...
 func ValidateCentralSpec(ctx context.Context, centralRequestPayload *public.CentralRequestPayload, dbCentral *dbapi.CentralRequest) handlers.Validate {
+	return validateAndApplyCentralSpec(&centralRequestPayload.Central, dbCentral)
+}
+
+// validateAndApplyCentralSpec validates the given Central spec and overrides the values of the Central spec of the
+// Central request with its non-empty values.
+func validateAndApplyCentralSpec(apiCentralSpec *public.CentralSpec, dbCentral *dbapi.CentralRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		// Validate Central resources.
-		err := validateResourceList(centralRequestPayload.Central.Resources.Requests, "central.resources.requests")
+		err := validateResourceList(apiCentralSpec.Resources.Requests, "central.resources.requests")
 		if err != nil {
 			return errors.Validation("invalid resource requests for Central: %v", err)
 		}
-		err = validateResourceList(centralRequestPayload.Central.Resources.Limits, "central.resources.limits")
+		err = validateResourceList(apiCentralSpec.Resources.Limits, "central.resources.limits")
 		if err != nil {
 			return errors.Validation("invalid resource limits for Central: %v", err)
 		}
 
-		central, err := json.Marshal(centralRequestPayload.Central)
+		central, err := json.Marshal(apiCentralSpec)
 		if err != nil {
 			return errors.Validation("marshaling Central spec failed: %v", err)
 		}
 
-		if err := json.Unmarshal(central, &dbapi.CentralSpec{}); err != nil {
+		// The non-empty values of the payload override the current ones.
+		centralSpec, err := dbCentral.GetCentralSpec()
+		if err != nil {
+			return errors.GeneralError("retrieving Central spec failed: %v", err)
+		}
+		if err := json.Unmarshal(central, centralSpec); err != nil {
 			return errors.Validation("invalid value as Central spec: %v", err)
 		}
 
-		dbCentral.Central = central
+		if err := dbCentral.SetCentralSpec(centralSpec); err != nil {
+			return errors.GeneralError("setting Central spec failed: %v", err)
+		}
 		return nil
 	}
 }
@@ -175,48 +235,115 @@ func validateResourceList(resources map[string]string, path string) error {
 
 // ValidateScannerSpec ...
 func ValidateScannerSpec(ctx context.Context, centralRequestPayload *public.CentralRequestPayload, dbCentral *dbapi.CentralRequest) handlers.Validate {
+	return validateAndApplyScannerSpec(&centralRequestPayload.Scanner, dbCentral)
+}
+
+// validateAndApplyScannerSpec validates the given Scanner spec and overrides the values of the Scanner spec of the
+// Central request with its non-empty values.
+func validateAndApplyScannerSpec(apiScannerSpec *public.ScannerSpec, dbCentral *dbapi.CentralRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		// Validate Scanner Analyzer resources and scaling settings.
-		err := validateResourceList(centralRequestPayload.Scanner.Analyzer.Resources.Requests, "scanner.analyzer.resources.requests")
+		err := validateResourceList(apiScannerSpec.Analyzer.Resources.Requests, "scanner.analyzer.resources.requests")
 		if err != nil {
 			return errors.Validation("invalid resource requests for Scanner Analyzer: %v", err)
 		}
-		err = validateResourceList(centralRequestPayload.Scanner.Analyzer.Resources.Limits, "scanner.analyzer.resources.limits")
+		err = validateResourceList(apiScannerSpec.Analyzer.Resources.Limits, "scanner.analyzer.resources.limits")
 		if err != nil {
 			return errors.Validation("invalid resource limits for Scanner Analyzer: %v", err)
 		}
 
-		if centralRequestPayload.Scanner.Analyzer.Scaling.AutoScaling != "" &&
-			centralRequestPayload.Scanner.Analyzer.Scaling.AutoScaling != "Enabled" &&
-			centralRequestPayload.Scanner.Analyzer.Scaling.AutoScaling != "Disabled" {
+		if apiScannerSpec.Analyzer.Scaling.AutoScaling != "" &&
+			apiScannerSpec.Analyzer.Scaling.AutoScaling != "Enabled" &&
+			apiScannerSpec.Analyzer.Scaling.AutoScaling != "Disabled" {
 			return errors.Validation("invalid AutoScaling setting at Scanner.Analyzer.Scaling.AutoScaling, expected 'Enabled' or 'Disabled'")
 		}
 
 		// Validate Scanner DB resources.
-		err = validateResourceList(centralRequestPayload.Scanner.Db.Resources.Requests, "scanner.db.resources.requests")
+		err = validateResourceList(apiScannerSpec.Db.Resources.Requests, "scanner.db.resources.requests")
 		if err != nil {
 			return errors.Validation("invalid resource requests for Scanner DB: %v", err)
 		}
-		err = validateResourceList(centralRequestPayload.Scanner.Analyzer.Resources.Limits, "scanner.db.resources.limits")
+		err = validateResourceList(apiScannerSpec.Db.Resources.Limits, "scanner.db.resources.limits")
 		if err != nil {
 			return errors.Validation("invalid resource limits for Scanner DB: %v", err)
 		}
 
 		// Marshal ScannerSpec into byte string.
-		scanner, err := json.Marshal(centralRequestPayload.Scanner)
+		scanner, err := json.Marshal(apiScannerSpec)
 		if err != nil {
 			return errors.Validation("marshaling Scanner spec failed: %v", err)
 		}
 
-		if err := json.Unmarshal(scanner, &dbapi.ScannerSpec{}); err != nil {
+		// The non-empty values of the payload override the current ones.
+		scannerSpec, err := dbCentral.GetScannerSpec()
+		if err != nil {
+			return errors.GeneralError("retrieving Scanner spec failed: %v", err)
+		}
+		if err := json.Unmarshal(scanner, scannerSpec); err != nil {
 			return errors.Validation("invalid value as Scanner spec: %v", err)
 		}
 
-		dbCentral.Scanner = scanner
+		if err := dbCentral.SetScannerSpec(scannerSpec); err != nil {
+			return errors.GeneralError("setting Scanner spec failed: %v", err)
+		}
+		return nil
+	}
+}
+
+// ValidateCentralResourceBounds validates that the resources and the scanner analyzer scaling of the Central request
+// are within the bounds of its instance type.
+func ValidateCentralResourceBounds(dbCentral *dbapi.CentralRequest, plansConfig *config.CentralPlansConfig) handlers.Validate {
+	return func() *errors.ServiceError {
+		bounds, ok := plansConfig.GetInstanceTypeBounds(dbCentral.InstanceType)
+		if !ok {
+			return errors.Forbidden("not allowed to change the resources of centrals of instance type %q", dbCentral.InstanceType)
+		}
+		centralSpec, err := dbCentral.GetCentralSpec()
+		if err != nil {
+			return errors.GeneralError("retrieving Central spec failed: %v", err)
+		}
+		scannerSpec, err := dbCentral.GetScannerSpec()
+		if err != nil {
+			return errors.GeneralError("retrieving Scanner spec failed: %v", err)
+		}
+
+		if err := validateResourceBounds(centralSpec.Resources, bounds.Central, "central"); err != nil {
+			return errors.Validation("%v", err)
+		}
+		if err := validateResourceBounds(scannerSpec.Analyzer.Resources, bounds.ScannerAnalyzer, "scanner.analyzer"); err != nil {
+			return errors.Validation("%v", err)
+		}
+		if err := validateResourceBounds(scannerSpec.Db.Resources, bounds.ScannerDb, "scanner.db"); err != nil {
+			return errors.Validation("%v", err)
+		}
+		if err := ValidateScannerAnalyzerScaling(&scannerSpec.Analyzer.Scaling); err != nil {
+			return errors.Validation("%v", err)
+		}
+		if scannerSpec.Analyzer.Scaling.MaxReplicas > bounds.MaxAnalyzerReplicas {
+			return errors.Validation("scanner analyzer MaxReplicas (%v) exceeds the maximum of %v for instance type %q",
+				scannerSpec.Analyzer.Scaling.MaxReplicas, bounds.MaxAnalyzerReplicas, dbCentral.InstanceType)
+		}
 		return nil
 	}
 }
 
+func validateResourceBounds(resources corev1.ResourceRequirements, max corev1.ResourceList, path string) error {
+	for name, limit := range resources.Limits {
+		if bound, ok := max[name]; !ok || limit.Cmp(bound) > 0 {
+			return fmt.Errorf("%s.resources.limits.%s (%s) exceeds the maximum of %s", path, name, limit.String(), bound.String())
+		}
+	}
+	for name, request := range resources.Requests {
+		if bound, ok := max[name]; !ok || request.Cmp(bound) > 0 {
+			return fmt.Errorf("%s.resources.requests.%s (%s) exceeds the maximum of %s", path, name, request.String(), bound.String())
+		}
+		if limit, ok := resources.Limits[name]; ok && request.Cmp(limit) > 0 {
+			return fmt.Errorf("%s.resources.requests.%s (%s) exceeds the limit of %s", path, name, request.String(), limit.String())
+		}
+	}
+	return nil
+}
+
 // ValidateScannerAnalyzerScaling validates the provided Scanner Analyzer Scaling configuration.
 func ValidateScannerAnalyzerScaling(scaling *dbapi.ScannerAnalyzerScaling) error {
 	if scaling == nil {
@@ -255,3 +382,84 @@ func ValidateResourceName(name string) (corev1.ResourceName, bool) {
 	}
 	return corev1.ResourceName(""), false
 }
+
+// ValidateCentralWebhookURL validates that the URL of a webhook is an absolute HTTPS URL. Plain HTTP is only accepted
+// if insecure URLs are allowed.
+func ValidateCentralWebhookURL(value *string, field string, allowInsecure bool) handlers.Validate {
+	return func() *errors.ServiceError {
+		webhookURL, err := url.Parse(*value)
+		if err != nil || webhookURL.Host == "" {
+			return errors.Validation("%s is not a valid URL", field)
+		}
+		switch webhookURL.Scheme {
+		case "https":
+			return nil
+		case "http":
+			if allowInsecure {
+				return nil
+			}
+		}
+		return errors.Validation("%s must use https", field)
+	}
+}
+
+// ValidateCentralEgressAllowlist validates that the egress allowlist only contains domain names, IP addresses and CIDR
+// ranges, since the entries are written into the configuration of the egress proxy. CIDR ranges must neither allow all
+// destinations nor overlap with the loopback and link-local ranges or the given cluster networks.
+func ValidateCentralEgressAllowlist(allowlist *adminPrivate.CentralEgressAllowlist, clusterCIDRs []string) handlers.Validate {
+	return func() *errors.ServiceError {
+		if len(allowlist.Domains)+len(allowlist.Cidrs) > MaxEgressAllowlistEntries {
+			return errors.Validation("egress allowlist must not contain more than %d entries", MaxEgressAllowlistEntries)
+		}
+		if len(allowlist.Domains) > 0 && len(allowlist.Cidrs) == 0 {
+			// The egress proxy only allows domains which resolve into an allowlisted CIDR range, so that a domain
+			// cannot open access to arbitrary private destinations. Domains without CIDR ranges would have no effect.
+			return errors.Validation("egress allowlist domains only allow destinations within the allowlisted CIDR ranges, add the CIDR ranges the domains resolve to")
+		}
+		for _, domain := range allowlist.Domains {
+			if len(domain) > 253 || !ValidEgressAllowlistDomainRegexp.MatchString(domain) {
+				return errors.Validation("domain %q is not a valid domain name", domain)
+			}
+		}
+		for _, cidr := range allowlist.Cidrs {
+			network, err := parseEgressAllowlistCIDR(cidr)
+			if err != nil {
+				return errors.Validation("%q is neither a valid IP address nor a valid CIDR range", cidr)
+			}
+			if ones, _ := network.Mask.Size(); ones == 0 {
+				return errors.Validation("%q must not allow all destinations", cidr)
+			}
+			for _, forbidden := range forbiddenEgressAllowlistCIDRs {
+				if forbiddenNetwork, err := parseEgressAllowlistCIDR(forbidden); err == nil && networksOverlap(network, forbiddenNetwork) {
+					return errors.Validation("%q overlaps with the loopback or link-local range %s", cidr, forbidden)
+				}
+			}
+			for _, clusterCIDR := range clusterCIDRs {
+				if clusterNetwork, err := parseEgressAllowlistCIDR(clusterCIDR); err == nil && networksOverlap(network, clusterNetwork) {
+					return errors.Validation("%q overlaps with the cluster network %s", cidr, clusterCIDR)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// parseEgressAllowlistCIDR parses a CIDR range or a single IP address, which is a range of one address.
+func parseEgressAllowlistCIDR(cidr string) (*net.IPNet, error) {
+	if ip := net.ParseIP(cidr); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CIDR range %q: %w", cidr, err)
+	}
+	return network, nil
+}
+
+// networksOverlap returns true if the networks share at least one address, i.e. if one contains the other.
+func networksOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
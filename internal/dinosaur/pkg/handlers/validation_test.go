@@ -8,6 +8,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
 
 	"github.com/onsi/gomega"
+	adminPrivate "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
@@ -15,6 +16,8 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func Test_Validation_validateDinosaurClusterNameIsUnique(t *testing.T) {
@@ -335,3 +338,360 @@ func Test_Validation_validateCloudProvider(t *testing.T) {
 		})
 	}
 }
+
+func Test_Validation_validateCentralPlan(t *testing.T) {
+	plansConfig := &config.CentralPlansConfig{
+		PlansConfig: config.CentralPlansConfiguration{
+			DefaultPlan: "small",
+			Plans: config.CentralPlanList{
+				{
+					Name:    "small",
+					Central: dbapi.CentralSpec{DB: dbapi.CentralDBSpec{MinCapacity: 1, MaxCapacity: 2}},
+				},
+				{
+					Name:    "large",
+					Central: dbapi.CentralSpec{DB: dbapi.CentralDBSpec{MinCapacity: 4, MaxCapacity: 8}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		description     string
+		plan            string
+		wantPlan        string
+		wantMaxCapacity float64
+		expectError     bool
+	}{
+		{
+			description:     "default plan is used if no plan is given",
+			plan:            "",
+			wantPlan:        "small",
+			wantMaxCapacity: 2,
+		},
+		{
+			description:     "given plan is applied",
+			plan:            "large",
+			wantPlan:        "large",
+			wantMaxCapacity: 8,
+		},
+		{
+			description: "unknown plan is rejected",
+			plan:        "huge",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			central := &dbapi.CentralRequest{}
+			err := ValidateCentralPlan(&tt.plan, central, plansConfig)()
+			if tt.expectError {
+				gomega.Expect(err).Should(gomega.HaveOccurred())
+				return
+			}
+			gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			gomega.Expect(central.Plan).To(gomega.Equal(tt.wantPlan))
+			centralSpec, specErr := central.GetCentralSpec()
+			gomega.Expect(specErr).ShouldNot(gomega.HaveOccurred())
+			gomega.Expect(centralSpec.DB.MaxCapacity).To(gomega.Equal(tt.wantMaxCapacity))
+		})
+	}
+}
+
+func Test_Validation_validateCentralSpecOverridesPlan(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	plansConfig := &config.CentralPlansConfig{
+		PlansConfig: config.CentralPlansConfiguration{
+			DefaultPlan: "small",
+			Plans: config.CentralPlanList{{
+				Name: "small",
+				Central: dbapi.CentralSpec{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			}},
+		},
+	}
+	payload := &public.CentralRequestPayload{
+		Central: public.CentralSpec{
+			Resources: public.ResourceRequirements{Requests: map[string]string{"cpu": "2"}},
+		},
+	}
+	central := &dbapi.CentralRequest{}
+
+	gomega.Expect(ValidateCentralPlan(&payload.Plan, central, plansConfig)()).ShouldNot(gomega.HaveOccurred())
+	gomega.Expect(ValidateCentralSpec(context.TODO(), payload, central)()).ShouldNot(gomega.HaveOccurred())
+
+	centralSpec, err := central.GetCentralSpec()
+	gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+	gomega.Expect(centralSpec.Resources.Requests.Cpu().String()).To(gomega.Equal("2"))
+	gomega.Expect(centralSpec.Resources.Requests.Memory().String()).To(gomega.Equal("1Gi"))
+}
+
+func Test_Validation_validateCentralResourceBounds(t *testing.T) {
+	plansConfig := &config.CentralPlansConfig{
+		PlansConfig: config.CentralPlansConfiguration{
+			InstanceTypeBounds: map[string]config.CentralResourceBounds{
+				types.STANDARD.String(): {
+					Central: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+					ScannerAnalyzer: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+					ScannerDb: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+					MaxAnalyzerReplicas: 5,
+				},
+			},
+		},
+	}
+	resources := func(request, limit string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(request), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(limit), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}
+	}
+	scaling := func(maxReplicas int32) dbapi.ScannerAnalyzerScaling {
+		return dbapi.ScannerAnalyzerScaling{AutoScaling: "Enabled", Replicas: 1, MinReplicas: 1, MaxReplicas: maxReplicas}
+	}
+
+	tests := []struct {
+		description  string
+		instanceType string
+		central      dbapi.CentralSpec
+		scanner      dbapi.ScannerSpec
+		expectError  bool
+	}{
+		{
+			description:  "resources and scaling within bounds",
+			instanceType: types.STANDARD.String(),
+			central:      dbapi.CentralSpec{Resources: resources("1", "2")},
+			scanner: dbapi.ScannerSpec{
+				Analyzer: dbapi.ScannerAnalyzerSpec{Resources: resources("500m", "1"), Scaling: scaling(5)},
+				Db:       dbapi.ScannerDbSpec{Resources: resources("500m", "1")},
+			},
+		},
+		{
+			description:  "instance type without bounds",
+			instanceType: types.EVAL.String(),
+			central:      dbapi.CentralSpec{Resources: resources("1", "2")},
+			scanner:      dbapi.ScannerSpec{Analyzer: dbapi.ScannerAnalyzerSpec{Resources: resources("500m", "1"), Scaling: scaling(1)}, Db: dbapi.ScannerDbSpec{Resources: resources("500m", "1")}},
+			expectError:  true,
+		},
+		{
+			description:  "central limit above bound",
+			instanceType: types.STANDARD.String(),
+			central:      dbapi.CentralSpec{Resources: resources("1", "3")},
+			scanner:      dbapi.ScannerSpec{Analyzer: dbapi.ScannerAnalyzerSpec{Resources: resources("500m", "1"), Scaling: scaling(1)}, Db: dbapi.ScannerDbSpec{Resources: resources("500m", "1")}},
+			expectError:  true,
+		},
+		{
+			description:  "central request above limit",
+			instanceType: types.STANDARD.String(),
+			central:      dbapi.CentralSpec{Resources: resources("2", "1")},
+			scanner:      dbapi.ScannerSpec{Analyzer: dbapi.ScannerAnalyzerSpec{Resources: resources("500m", "1"), Scaling: scaling(1)}, Db: dbapi.ScannerDbSpec{Resources: resources("500m", "1")}},
+			expectError:  true,
+		},
+		{
+			description:  "scanner db limit above bound",
+			instanceType: types.STANDARD.String(),
+			central:      dbapi.CentralSpec{Resources: resources("1", "2")},
+			scanner: dbapi.ScannerSpec{
+				Analyzer: dbapi.ScannerAnalyzerSpec{Resources: resources("500m", "1"), Scaling: scaling(1)},
+				Db:       dbapi.ScannerDbSpec{Resources: resources("1", "2")},
+			},
+			expectError: true,
+		},
+		{
+			description:  "scanner analyzer replicas above bound",
+			instanceType: types.STANDARD.String(),
+			central:      dbapi.CentralSpec{Resources: resources("1", "2")},
+			scanner:      dbapi.ScannerSpec{Analyzer: dbapi.ScannerAnalyzerSpec{Resources: resources("500m", "1"), Scaling: scaling(6)}, Db: dbapi.ScannerDbSpec{Resources: resources("500m", "1")}},
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			central := &dbapi.CentralRequest{InstanceType: tt.instanceType}
+			gomega.Expect(central.SetCentralSpec(&tt.central)).To(gomega.Succeed())
+			gomega.Expect(central.SetScannerSpec(&tt.scanner)).To(gomega.Succeed())
+			err := ValidateCentralResourceBounds(central, plansConfig)()
+			if tt.expectError {
+				gomega.Expect(err).Should(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			}
+		})
+	}
+}
+
+func Test_Validation_validateCentralWebhookURL(t *testing.T) {
+	tests := []struct {
+		description   string
+		url           string
+		allowInsecure bool
+		expectError   bool
+	}{
+		{
+			description: "valid https URL",
+			url:         "https://example.com/hooks/central",
+		},
+		{
+			description: "http URL when insecure URLs are not allowed",
+			url:         "http://localhost:9000/hook",
+			expectError: true,
+		},
+		{
+			description:   "http URL when insecure URLs are allowed",
+			url:           "http://localhost:9000/hook",
+			allowInsecure: true,
+		},
+		{
+			description: "URL without host",
+			url:         "https:///hook",
+			expectError: true,
+		},
+		{
+			description:   "URL with unsupported scheme",
+			url:           "ftp://example.com/hook",
+			allowInsecure: true,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			err := ValidateCentralWebhookURL(&tt.url, "url", tt.allowInsecure)()
+			if tt.expectError {
+				gomega.Expect(err).Should(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			}
+		})
+	}
+}
+
+func Test_Validation_validateCentralEgressAllowlist(t *testing.T) {
+	tooManyDomains := make([]string, MaxEgressAllowlistEntries+1)
+	for i := range tooManyDomains {
+		tooManyDomains[i] = "example.com"
+	}
+
+	tests := []struct {
+		description string
+		allowlist   adminPrivate.CentralEgressAllowlist
+		expectError bool
+	}{
+		{
+			description: "empty allowlist",
+		},
+		{
+			description: "valid domains, IP addresses and CIDR ranges",
+			allowlist: adminPrivate.CentralEgressAllowlist{
+				Domains: []string{"jira.example.com", ".registry.example.com", "Splunk.Internal"},
+				Cidrs:   []string{"10.0.0.1", "192.168.0.0/16", "fd00::/8"},
+			},
+		},
+		{
+			description: "domain with whitespace",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Domains: []string{"example.com all"}, Cidrs: []string{"10.0.0.1"}},
+			expectError: true,
+		},
+		{
+			description: "domain with newline",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Domains: []string{"example.com\nhttp_access allow all"}, Cidrs: []string{"10.0.0.1"}},
+			expectError: true,
+		},
+		{
+			description: "domain with URL scheme",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Domains: []string{"https://example.com"}, Cidrs: []string{"10.0.0.1"}},
+			expectError: true,
+		},
+		{
+			description: "domains without CIDR ranges",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Domains: []string{"jira.corp.internal"}},
+			expectError: true,
+		},
+		{
+			description: "invalid CIDR range",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"10.0.0.0/33"}},
+			expectError: true,
+		},
+		{
+			description: "domain as CIDR range",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"example.com"}},
+			expectError: true,
+		},
+		{
+			description: "too many entries",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Domains: tooManyDomains},
+			expectError: true,
+		},
+		{
+			description: "CIDR range allowing all IPv4 destinations",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"0.0.0.0/0"}},
+			expectError: true,
+		},
+		{
+			description: "CIDR range allowing all IPv6 destinations",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"::/0"}},
+			expectError: true,
+		},
+		{
+			description: "link-local metadata service address",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"169.254.169.254"}},
+			expectError: true,
+		},
+		{
+			description: "CIDR range containing the link-local range",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"160.0.0.0/4"}},
+			expectError: true,
+		},
+		{
+			description: "IPv6 link-local range",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"fe80::/10"}},
+			expectError: true,
+		},
+		{
+			description: "loopback address",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"127.0.0.1"}},
+			expectError: true,
+		},
+		{
+			description: "CIDR range within the pod network",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"10.128.4.0/24"}},
+			expectError: true,
+		},
+		{
+			description: "CIDR range containing the service network",
+			allowlist:   adminPrivate.CentralEgressAllowlist{Cidrs: []string{"172.16.0.0/12"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			err := ValidateCentralEgressAllowlist(&tt.allowlist, []string{"10.128.0.0/14", "172.30.0.0/16"})()
+			if tt.expectError {
+				gomega.Expect(err).Should(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+const centralMigrationLeaseType = "central_migration"
+
+// addCentralMigrationLease adds a leader lease value for the central_migration worker.
+// It is similar to addCentralAuthLease.
+func addCentralMigrationLease() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202211071100",
+		Migrate: func(tx *gorm.DB) error {
+			// Set an initial already expired lease for central_migration.
+			return tx.Create(&api.LeaderLease{
+				Expires:   &db.DinosaurAdditionalLeasesExpireTime,
+				LeaseType: centralMigrationLeaseType,
+				Leader:    api.NewID(),
+			}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Where("lease_type = ?", centralMigrationLeaseType).Delete(&api.LeaderLease{}).Error
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addPlanToCentralRequest() *gormigrate.Migration {
+	type AuthConfig struct {
+		ClientID     string `json:"idp_client_id"`
+		ClientSecret string `json:"idp_client_secret"`
+		Issuer       string `json:"idp_issuer"`
+		ClientOrigin string `json:"client_origin"`
+	}
+
+	type CentralRequest struct {
+		api.Meta
+		Region         string   `json:"region"`
+		ClusterID      string   `json:"cluster_id" gorm:"index"`
+		CloudProvider  string   `json:"cloud_provider"`
+		CloudAccountID string   `json:"cloud_account_id"`
+		MultiAZ        bool     `json:"multi_az"`
+		Name           string   `json:"name" gorm:"index"`
+		Status         string   `json:"status" gorm:"index"`
+		SubscriptionID string   `json:"subscription_id"`
+		Owner          string   `json:"owner" gorm:"index"`
+		OwnerAccountID string   `json:"owner_account_id"`
+		OwnerUserID    string   `json:"owner_user_id"`
+		Host           string   `json:"host"`
+		OrganisationID string   `json:"organisation_id" gorm:"index"`
+		FailedReason   string   `json:"failed_reason"`
+		PlacementID    string   `json:"placement_id"`
+		Central        api.JSON `json:"central"`
+		Scanner        api.JSON `json:"scanner"`
+		Plan           string   `json:"plan"`
+
+		DesiredCentralVersion         string     `json:"desired_central_version"`
+		ActualCentralVersion          string     `json:"actual_central_version"`
+		DesiredCentralOperatorVersion string     `json:"desired_central_operator_version"`
+		ActualCentralOperatorVersion  string     `json:"actual_central_operator_version"`
+		CentralUpgrading              bool       `json:"central_upgrading"`
+		CentralOperatorUpgrading      bool       `json:"central_operator_upgrading"`
+		InstanceType                  string     `json:"instance_type"`
+		QuotaType                     string     `json:"quota_type"`
+		Routes                        api.JSON   `json:"routes"`
+		RoutesCreated                 bool       `json:"routes_created"`
+		Namespace                     string     `json:"namespace"`
+		RoutesCreationID              string     `json:"routes_creation_id"`
+		DeletionTimestamp             *time.Time `json:"deletionTimestamp"`
+		MigrationStatus               string     `json:"migration_status" gorm:"index"`
+		MigrationSourceClusterID      string     `json:"migration_source_cluster_id"`
+		MigrationTargetClusterID      string     `json:"migration_target_cluster_id"`
+		MigrationStartedAt            *time.Time `json:"migration_started_at"`
+		DBBackupID                    string     `json:"db_backup_id"`
+		DBBackupStatus                string     `json:"db_backup_status"`
+		DBRestoreID                   string     `json:"db_restore_id"`
+		DBRestoreSnapshotID           string     `json:"db_restore_snapshot_id"`
+		DBRestoreStatus               string     `json:"db_restore_status"`
+		DBFailedReason                string     `json:"db_failed_reason"`
+		DBSnapshots                   api.JSON   `json:"db_snapshots"`
+		UpgradeRolloutID              string     `json:"upgrade_rollout_id" gorm:"index"`
+		UpgradeStatus                 string     `json:"upgrade_status"`
+		UpgradeStartedAt              *time.Time `json:"upgrade_started_at"`
+		AuthConfig
+	}
+
+	migrationID := "202211280000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&CentralRequest{}, "Plan"); err != nil {
+				return fmt.Errorf("adding new column Plan in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&CentralRequest{}, "Plan"); err != nil {
+				return fmt.Errorf("rolling back new column Plan in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addCentralEvents() *gormigrate.Migration {
+	type CentralEvent struct {
+		api.Meta
+		CentralID  string `json:"central_id" gorm:"index"`
+		Type       string `json:"type"`
+		FromStatus string `json:"from_status"`
+		ToStatus   string `json:"to_status"`
+		ClusterID  string `json:"cluster_id"`
+		Actor      string `json:"actor"`
+		Reason     string `json:"reason"`
+	}
+
+	migrationID := "202211290000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralEvent{}); err != nil {
+				return fmt.Errorf("creating central events table in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralEvent{}); err != nil {
+				return fmt.Errorf("rolling back central events table in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+const centralWebhookLeaseType = "central_webhook"
+
+func addCentralWebhooks() *gormigrate.Migration {
+	type CentralWebhook struct {
+		api.Meta
+		OrganisationID string `json:"organisation_id" gorm:"index"`
+		Owner          string `json:"owner"`
+		Name           string `json:"name"`
+		URL            string `json:"url"`
+		Secret         string `json:"-"`
+	}
+
+	type CentralWebhookDelivery struct {
+		api.Meta
+		WebhookID     string     `json:"webhook_id" gorm:"index"`
+		CentralID     string     `json:"central_id"`
+		EventID       string     `json:"event_id"`
+		EventType     string     `json:"event_type"`
+		Payload       api.JSON   `json:"payload"`
+		Status        string     `json:"status" gorm:"index"`
+		Attempts      int        `json:"attempts"`
+		NextAttemptAt time.Time  `json:"next_attempt_at" gorm:"index"`
+		LastError     string     `json:"last_error"`
+		DeliveredAt   *time.Time `json:"delivered_at"`
+	}
+
+	migrationID := "202212010000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralWebhook{}, &CentralWebhookDelivery{}); err != nil {
+				return fmt.Errorf("creating central webhook tables in migration %s: %w", migrationID, err)
+			}
+			// Set an initial already expired lease for the central_webhook worker.
+			if err := tx.Create(&api.LeaderLease{
+				Expires:   &db.DinosaurAdditionalLeasesExpireTime,
+				LeaseType: centralWebhookLeaseType,
+				Leader:    api.NewID(),
+			}).Error; err != nil {
+				return fmt.Errorf("adding leader lease %s in migration %s: %w", centralWebhookLeaseType, migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Where("lease_type = ?", centralWebhookLeaseType).Delete(&api.LeaderLease{}).Error; err != nil {
+				return fmt.Errorf("rolling back leader lease %s in migration %s: %w", centralWebhookLeaseType, migrationID, err)
+			}
+			if err := tx.Migrator().DropTable(&CentralWebhookDelivery{}, &CentralWebhook{}); err != nil {
+				return fmt.Errorf("rolling back central webhook tables in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addCentralIdempotencyKeys() *gormigrate.Migration {
+	type CentralIdempotencyKey struct {
+		api.Meta
+		OrganisationID string    `json:"organisation_id" gorm:"uniqueIndex:idx_central_idempotency_keys_scope_key"`
+		Owner          string    `json:"owner" gorm:"uniqueIndex:idx_central_idempotency_keys_scope_key"`
+		Key            string    `json:"key" gorm:"uniqueIndex:idx_central_idempotency_keys_scope_key"`
+		RequestHash    string    `json:"request_hash"`
+		CentralID      string    `json:"central_id"`
+		ExpiresAt      time.Time `json:"expires_at" gorm:"index"`
+	}
+
+	migrationID := "202212020000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralIdempotencyKey{}); err != nil {
+				return fmt.Errorf("creating central idempotency keys table in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralIdempotencyKey{}); err != nil {
+				return fmt.Errorf("rolling back central idempotency keys table in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addCentralRequestChangeNotifications adds a trigger which publishes the IDs of the data plane clusters whose
+// centrals changed to the central_request_changes channel. Watches of the centrals of a cluster listen on this channel
+// instead of polling the central_requests table.
+func addCentralRequestChangeNotifications() *gormigrate.Migration {
+	migrationID := "202212130000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec(`
+CREATE OR REPLACE FUNCTION notify_central_request_change() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP <> 'DELETE' THEN
+		PERFORM pg_notify('central_request_changes', cluster_id)
+		FROM unnest(ARRAY[NEW.cluster_id, NEW.migration_source_cluster_id, NEW.migration_target_cluster_id]) AS cluster_id
+		WHERE cluster_id <> '';
+	END IF;
+	IF TG_OP <> 'INSERT' THEN
+		PERFORM pg_notify('central_request_changes', cluster_id)
+		FROM unnest(ARRAY[OLD.cluster_id, OLD.migration_source_cluster_id, OLD.migration_target_cluster_id]) AS cluster_id
+		WHERE cluster_id <> '';
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql`).Error; err != nil {
+				return fmt.Errorf("creating function notify_central_request_change in migration %s: %w", migrationID, err)
+			}
+			if err := tx.Exec(`
+CREATE TRIGGER central_request_change_notification
+AFTER INSERT OR UPDATE OR DELETE ON central_requests
+FOR EACH ROW EXECUTE PROCEDURE notify_central_request_change()`).Error; err != nil {
+				return fmt.Errorf("creating trigger central_request_change_notification in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("DROP TRIGGER IF EXISTS central_request_change_notification ON central_requests").Error; err != nil {
+				return fmt.Errorf("dropping trigger central_request_change_notification in migration %s: %w", migrationID, err)
+			}
+			if err := tx.Exec("DROP FUNCTION IF EXISTS notify_central_request_change()").Error; err != nil {
+				return fmt.Errorf("dropping function notify_central_request_change in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -37,6 +37,19 @@ var migrations = []*gormigrate.Migration{
 	addClientOriginToCentralRequest(),
 	changeCentralClientOrigin(),
 	addCloudAccountIDToCentralRequest(),
+	addMigrationToCentralRequest(),
+	addCentralMigrationLease(),
+	addDBBackupToCentralRequest(),
+	addCentralUpgradeRollouts(),
+	addPlanToCentralRequest(),
+	addCentralEvents(),
+	addCentralWebhooks(),
+	addCentralIdempotencyKeys(),
+	addResourceVersionToCentralRequest(),
+	addExpirationToCentralRequest(),
+	addEgressAllowlistToCentralRequest(),
+	addHealthConditionsToCentralRequest(),
+	addCentralRequestChangeNotifications(),
 }
 
 // New ...
@@ -0,0 +1,20 @@
+package presenters
+
+import (
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	admin "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
+)
+
+// PresentClusterAdminEndpoint presents an api.Cluster as an admin.Cluster.
+func PresentClusterAdminEndpoint(cluster *api.Cluster) admin.Cluster {
+	return admin.Cluster{
+		Id:             cluster.ClusterID,
+		Status:         cluster.Status.String(),
+		CloudProvider:  cluster.CloudProvider,
+		Region:         cluster.Region,
+		MultiAz:        cluster.MultiAZ,
+		SkipScheduling: cluster.SkipScheduling,
+		CreatedAt:      cluster.CreatedAt,
+		UpdatedAt:      cluster.UpdatedAt,
+	}
+}
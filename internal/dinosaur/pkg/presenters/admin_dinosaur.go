@@ -4,6 +4,7 @@ package presenters
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	admin "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
@@ -46,23 +47,80 @@ func PresentDinosaurRequestAdminEndpoint(request *dbapi.CentralRequest, _ accoun
 		}
 	}
 
+	var migrationStartedAt time.Time
+	if request.MigrationStartedAt != nil {
+		migrationStartedAt = *request.MigrationStartedAt
+	}
+
+	snapshots, err := request.GetDBSnapshots()
+	if err != nil {
+		// Assuming here that what is in the DB is guaranteed to conform to the expected schema.
+		glog.Errorf("Failed to unmarshal DB snapshots %q: %v", request.DBSnapshots, err)
+	}
+	var dbSnapshots []admin.CentralAllOfDbSnapshots
+	for _, snapshot := range snapshots {
+		dbSnapshot := admin.CentralAllOfDbSnapshots{
+			Id:     snapshot.ID,
+			Status: snapshot.Status,
+		}
+		if snapshot.CreatedAt != nil {
+			dbSnapshot.CreatedAt = *snapshot.CreatedAt
+		}
+		dbSnapshots = append(dbSnapshots, dbSnapshot)
+	}
+
+	egressAllowlist, err := request.GetEgressAllowlist()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal egress allowlist %q: %v", request.EgressAllowlist, err)
+		egressAllowlist = &dbapi.CentralEgressAllowlist{}
+	}
+
+	healthConditions, err := request.GetHealthConditions()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal health conditions %q: %v", request.HealthConditions, err)
+	}
+	var adminHealthConditions []admin.CentralHealthCondition
+	for _, c := range healthConditions {
+		adminHealthConditions = append(adminHealthConditions, admin.CentralHealthCondition(c))
+	}
+
 	return &admin.Central{
-		Id:                   request.ID,
-		Kind:                 "CentralRequest",
-		Href:                 fmt.Sprintf("/api/rhacs/v1/centrals/%s", request.ID),
-		Status:               request.Status,
-		CloudProvider:        request.CloudProvider,
-		MultiAz:              request.MultiAZ,
-		Region:               request.Region,
-		Owner:                request.Owner,
-		Name:                 request.Name,
-		Host:                 request.GetUIHost(), // TODO(ROX-11990): Split the Host in Fleet Manager Public API to UI and Data hosts
-		CreatedAt:            request.CreatedAt,
-		UpdatedAt:            request.UpdatedAt,
-		FailedReason:         request.FailedReason,
-		ActualCentralVersion: request.ActualCentralVersion,
-		InstanceType:         request.InstanceType,
-		Central:              adminCentral,
-		Scanner:              adminScanner,
+		Id:                       request.ID,
+		Kind:                     "CentralRequest",
+		Href:                     fmt.Sprintf("/api/rhacs/v1/centrals/%s", request.ID),
+		Status:                   request.Status,
+		CloudProvider:            request.CloudProvider,
+		MultiAz:                  request.MultiAZ,
+		Region:                   request.Region,
+		Owner:                    request.Owner,
+		Name:                     request.Name,
+		Host:                     request.GetUIHost(), // TODO(ROX-11990): Split the Host in Fleet Manager Public API to UI and Data hosts
+		CreatedAt:                request.CreatedAt,
+		UpdatedAt:                request.UpdatedAt,
+		FailedReason:             request.FailedReason,
+		ActualCentralVersion:     request.ActualCentralVersion,
+		InstanceType:             request.InstanceType,
+		ClusterId:                request.ClusterID,
+		MigrationStatus:          request.MigrationStatus,
+		MigrationSourceClusterId: request.MigrationSourceClusterID,
+		MigrationTargetClusterId: request.MigrationTargetClusterID,
+		MigrationStartedAt:       migrationStartedAt,
+		DbBackupId:               request.DBBackupID,
+		DbBackupStatus:           request.DBBackupStatus,
+		DbRestoreId:              request.DBRestoreID,
+		DbRestoreSnapshotId:      request.DBRestoreSnapshotID,
+		DbRestoreStatus:          request.DBRestoreStatus,
+		DbFailedReason:           request.DBFailedReason,
+		DbSnapshots:              dbSnapshots,
+		Central:                  adminCentral,
+		Scanner:                  adminScanner,
+		Plan:                     request.Plan,
+		ExpiresAt:                request.ExpiresAt,
+		LifespanExtensions:       int32(request.LifespanExtensions),
+		EgressAllowlist: admin.CentralEgressAllowlist{
+			Domains: egressAllowlist.Domains,
+			Cidrs:   egressAllowlist.CIDRs,
+		},
+		HealthConditions: adminHealthConditions,
 	}, nil
 }
@@ -0,0 +1,65 @@
+package presenters
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	admin "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+)
+
+// ConvertCentralUpgradeRolloutRequest converts an admin.CentralUpgradeRolloutRequest to a dbapi.CentralUpgradeRollout.
+func ConvertCentralUpgradeRolloutRequest(request admin.CentralUpgradeRolloutRequest) (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	rollout := &dbapi.CentralUpgradeRollout{
+		CentralVersion:         request.CentralVersion,
+		CentralOperatorVersion: request.CentralOperatorVersion,
+		FailureThreshold:       request.FailureThreshold,
+	}
+	if len(request.Waves) > 0 {
+		waves := make([]int, 0, len(request.Waves))
+		for _, wave := range request.Waves {
+			waves = append(waves, int(wave))
+		}
+		if err := rollout.SetWaves(waves); err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to convert waves")
+		}
+	}
+	return rollout, nil
+}
+
+// PresentCentralUpgradeRolloutAdminEndpoint presents a dbapi.CentralUpgradeRollout and the number of its upgrades per
+// upgrade status as an admin.CentralUpgradeRollout.
+func PresentCentralUpgradeRolloutAdminEndpoint(rollout *dbapi.CentralUpgradeRollout, counts map[constants.CentralUpgradeStatus]int) (*admin.CentralUpgradeRollout, *errors.ServiceError) {
+	waves, err := rollout.GetWaves()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get waves of rollout %s", rollout.ID)
+	}
+	presentedWaves := make([]int32, 0, len(waves))
+	for _, wave := range waves {
+		presentedWaves = append(presentedWaves, int32(wave))
+	}
+
+	result := &admin.CentralUpgradeRollout{
+		Id:                     rollout.ID,
+		Status:                 rollout.Status,
+		CentralVersion:         rollout.CentralVersion,
+		CentralOperatorVersion: rollout.CentralOperatorVersion,
+		Waves:                  presentedWaves,
+		CurrentWave:            int32(rollout.CurrentWave),
+		PausedReason:           rollout.PausedReason,
+		Upgrading:              int32(counts[constants.CentralUpgradeStatusUpgrading]),
+		Succeeded:              int32(counts[constants.CentralUpgradeStatusSucceeded]),
+		Failed:                 int32(counts[constants.CentralUpgradeStatusFailed]),
+		CreatedAt:              rollout.CreatedAt,
+		UpdatedAt:              rollout.UpdatedAt,
+	}
+	if rollout.FailureThreshold != nil {
+		result.FailureThreshold = *rollout.FailureThreshold
+	}
+	if rollout.WaveStartedAt != nil {
+		result.WaveStartedAt = *rollout.WaveStartedAt
+	}
+	if rollout.CompletedAt != nil {
+		result.CompletedAt = *rollout.CompletedAt
+	}
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	admin "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+)
+
+// PresentCentralEvent presents a dbapi.CentralEvent to the owners of the central. The cluster the central was assigned
+// to is internal and not presented, and neither are actors other than the owner and internal reasons.
+func PresentCentralEvent(event *dbapi.CentralEvent, owner string) public.CentralEvent {
+	return public.CentralEvent{
+		Id:         event.ID,
+		Type:       event.Type,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		Actor:      event.PublicActor(owner),
+		Reason:     event.PublicReason(owner),
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+// PresentCentralEventAdminEndpoint presents a dbapi.CentralEvent as an admin.CentralEvent.
+func PresentCentralEventAdminEndpoint(event *dbapi.CentralEvent) admin.CentralEvent {
+	return admin.CentralEvent{
+		Id:         event.ID,
+		CentralId:  event.CentralID,
+		Type:       event.Type,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		ClusterId:  event.ClusterID,
+		Actor:      event.Actor,
+		Reason:     event.Reason,
+		CreatedAt:  event.CreatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package presenters
+
+import (
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+)
+
+// ConvertCentralWebhookRequest converts a public.CentralWebhookRequest to a dbapi.CentralWebhook.
+func ConvertCentralWebhookRequest(request public.CentralWebhookRequest) *dbapi.CentralWebhook {
+	return &dbapi.CentralWebhook{
+		Name:   request.Name,
+		URL:    request.Url,
+		Secret: request.Secret,
+	}
+}
+
+// PresentCentralWebhook presents a dbapi.CentralWebhook without its secret.
+func PresentCentralWebhook(webhook *dbapi.CentralWebhook) public.CentralWebhook {
+	return public.CentralWebhook{
+		Id:        webhook.ID,
+		Name:      webhook.Name,
+		Url:       webhook.URL,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
@@ -35,8 +35,39 @@ func ConvertDataPlaneDinosaurStatus(status map[string]private.DataPlaneCentralSt
 			Routes:                 routes,
 			CentralVersion:         v.Versions.Central,
 			CentralOperatorVersion: v.Versions.CentralOperator,
+			DB:                     convertDataPlaneCentralDBStatus(v.Db),
 		})
 	}
 
 	return res
 }
+
+func convertDataPlaneCentralDBStatus(status private.DataPlaneCentralStatusDb) *dbapi.DataPlaneCentralDBStatus {
+	if status.Backup.Id == "" && status.Restore.Id == "" && status.Snapshots == nil {
+		return nil
+	}
+	res := &dbapi.DataPlaneCentralDBStatus{
+		Backup: dbapi.DataPlaneCentralDBOperation{
+			ID:      status.Backup.Id,
+			Status:  status.Backup.Status,
+			Message: status.Backup.Message,
+		},
+		Restore: dbapi.DataPlaneCentralDBOperation{
+			ID:      status.Restore.Id,
+			Status:  status.Restore.Status,
+			Message: status.Restore.Message,
+		},
+	}
+	if status.Snapshots != nil {
+		res.Snapshots = make([]dbapi.CentralDBSnapshot, 0, len(status.Snapshots))
+		for _, snapshot := range status.Snapshots {
+			createdAt := snapshot.CreatedAt
+			res.Snapshots = append(res.Snapshots, dbapi.CentralDBSnapshot{
+				ID:        snapshot.Id,
+				Status:    snapshot.Status,
+				CreatedAt: &createdAt,
+			})
+		}
+	}
+	return res
+}
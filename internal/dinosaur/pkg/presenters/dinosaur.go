@@ -3,6 +3,7 @@ package presenters
 import (
 	"fmt"
 
+	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 )
@@ -27,21 +28,32 @@ func ConvertDinosaurRequest(dinosaurRequestPayload public.CentralRequestPayload,
 // PresentCentralRequest - create CentralRequest in an appropriate format ready to be returned by the API
 func PresentCentralRequest(request *dbapi.CentralRequest) public.CentralRequest {
 	outputRequest := public.CentralRequest{
-		Id:             request.ID,
-		Kind:           "CentralRequest",
-		Href:           fmt.Sprintf("/api/rhacs/v1/centrals/%s", request.ID),
-		Status:         request.Status,
-		CloudProvider:  request.CloudProvider,
-		CloudAccountId: request.CloudAccountID,
-		MultiAz:        request.MultiAZ,
-		Region:         request.Region,
-		Owner:          request.Owner,
-		Name:           request.Name,
-		CreatedAt:      request.CreatedAt,
-		UpdatedAt:      request.UpdatedAt,
-		FailedReason:   request.FailedReason,
-		Version:        request.ActualCentralVersion,
-		InstanceType:   request.InstanceType,
+		Id:                 request.ID,
+		Kind:               "CentralRequest",
+		Href:               fmt.Sprintf("/api/rhacs/v1/centrals/%s", request.ID),
+		Status:             request.Status,
+		CloudProvider:      request.CloudProvider,
+		CloudAccountId:     request.CloudAccountID,
+		MultiAz:            request.MultiAZ,
+		Region:             request.Region,
+		Owner:              request.Owner,
+		Name:               request.Name,
+		CreatedAt:          request.CreatedAt,
+		UpdatedAt:          request.UpdatedAt,
+		FailedReason:       request.FailedReason,
+		Version:            request.ActualCentralVersion,
+		InstanceType:       request.InstanceType,
+		Plan:               request.Plan,
+		ExpiresAt:          request.ExpiresAt,
+		LifespanExtensions: int32(request.LifespanExtensions),
+	}
+
+	healthConditions, err := request.GetHealthConditions()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal health conditions %q: %v", request.HealthConditions, err)
+	}
+	for _, c := range healthConditions {
+		outputRequest.HealthConditions = append(outputRequest.HealthConditions, public.CentralHealthCondition(c))
 	}
 
 	if request.RoutesCreated {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
@@ -97,6 +98,12 @@ func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralReque
 						corev1.ResourceMemory.String(): orDefaultQty(central.Resources.Limits[corev1.ResourceMemory], defaults.Central.MemoryLimit).String(),
 					},
 				},
+				Db: private.ManagedCentralAllOfSpecCentralDb{
+					EngineVersion:       defaults.CentralDB.EngineVersion,
+					MinCapacity:         orDefaultFloat64(central.DB.MinCapacity, defaults.CentralDB.MinCapacity),
+					MaxCapacity:         orDefaultFloat64(central.DB.MaxCapacity, defaults.CentralDB.MaxCapacity),
+					BackupRetentionDays: defaults.CentralDB.BackupRetentionDays,
+				},
 			},
 			Scanner: private.ManagedCentralAllOfSpecScanner{
 				Analyzer: private.ManagedCentralAllOfSpecScannerAnalyzer{
@@ -138,10 +145,62 @@ func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralReque
 	if from.DeletionTimestamp != nil {
 		res.Metadata.DeletionTimestamp = from.DeletionTimestamp.Format(time.RFC3339)
 	}
+	if egressAllowlist, err := from.GetEgressAllowlist(); err != nil {
+		glog.Errorf("Failed to unmarshal egress allowlist for Central request %q/%s: %v", from.Name, from.ClusterID, err)
+	} else {
+		res.Spec.EgressAllowlist = private.ManagedCentralAllOfSpecEgressAllowlist{
+			Domains: egressAllowlist.Domains,
+			Cidrs:   egressAllowlist.CIDRs,
+		}
+	}
+	if from.HasActiveDBBackup() {
+		res.Spec.Central.Db.BackupId = from.DBBackupID
+	}
+	if from.HasActiveDBRestore() {
+		res.Spec.Central.Db.RestoreId = from.DBRestoreID
+		res.Spec.Central.Db.RestoreSnapshotId = from.DBRestoreSnapshotID
+	}
 
 	return res
 }
 
+// PresentManagedCentralForCluster converts DB representation of Central to the private API representation for the given
+// data plane cluster. While a Central is migrated, the migration target cluster sees it as provisioning and the cluster
+// it is removed from sees it as deleted.
+func (c *ManagedCentralPresenter) PresentManagedCentralForCluster(from *dbapi.CentralRequest, clusterID string) private.ManagedCentral {
+	res := c.PresentManagedCentral(from)
+	if !from.IsMigrating() || clusterID == "" {
+		return res
+	}
+
+	switch clusterID {
+	case from.MigrationSourceClusterID:
+		res.Metadata.Annotations.MasMigration = "source"
+	case from.MigrationTargetClusterID:
+		res.Metadata.Annotations.MasMigration = "target"
+	default:
+		return res
+	}
+
+	if from.DeletionTimestamp != nil {
+		// The Central has been deleted while it was migrated, the managed database must not be kept.
+		res.Metadata.Annotations.MasMigration = ""
+		return res
+	}
+	if clusterID == from.MigrationTeardownClusterID() {
+		deletionTimestamp := from.UpdatedAt
+		if from.MigrationStartedAt != nil {
+			deletionTimestamp = *from.MigrationStartedAt
+		}
+		res.Metadata.DeletionTimestamp = deletionTimestamp.Format(time.RFC3339)
+		return res
+	}
+	if clusterID != from.ClusterID {
+		res.RequestStatus = constants.CentralRequestStatusProvisioning.String()
+	}
+	return res
+}
+
 func orDefaultQty(qty resource.Quantity, def resource.Quantity) *resource.Quantity {
 	if qty != (resource.Quantity{}) {
 		return &qty
@@ -162,3 +221,10 @@ func orDefaultInt32(i int32, def int32) int32 {
 	}
 	return def
 }
+
+func orDefaultFloat64(f float64, def float64) float64 {
+	if f != 0 {
+		return f
+	}
+	return def
+}
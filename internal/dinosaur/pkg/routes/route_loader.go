@@ -42,8 +42,12 @@ type options struct {
 	ServerConfig   *server.ServerConfig
 	OCMConfig      *ocm.OCMConfig
 	ProviderConfig *config.ProviderConfig
+	PlansConfig    *config.CentralPlansConfig
+	CentralConfig  *config.CentralConfig
 	IAMConfig      *iam.IAMConfig
 
+	DataplaneClusterConfig *config.DataplaneClusterConfig
+
 	AMSClient                ocm.AMSClient
 	Dinosaur                 services.DinosaurService
 	CloudProviders           services.CloudProvidersService
@@ -51,6 +55,16 @@ type options struct {
 	IAM                      sso.IAMService
 	DataPlaneCluster         services.DataPlaneClusterService
 	DataPlaneDinosaurService services.DataPlaneCentralService
+	CentralMigration         services.CentralMigrationService
+	CentralBackup            services.CentralBackupService
+	CentralHibernation       services.CentralHibernationService
+	CentralLifespan          services.CentralLifespanService
+	CentralUpgrade           services.CentralUpgradeService
+	CentralWatch             services.CentralWatchService
+	CentralEvent             services.CentralEventService
+	CentralWebhook           services.CentralWebhookService
+	CentralIdempotency       services.CentralIdempotencyService
+	Cluster                  services.ClusterService
 	AccountService           account.AccountService
 	AuthService              authorization.Authorization
 	DB                       *db.ConnectionFactory
@@ -85,12 +99,13 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
 
-	dinosaurHandler := handlers.NewDinosaurHandler(s.Dinosaur, s.ProviderConfig, s.AuthService)
+	dinosaurHandler := handlers.NewDinosaurHandler(s.Dinosaur, s.CentralEvent, s.CentralIdempotency, s.CentralHibernation, s.CentralLifespan, s.ProviderConfig, s.PlansConfig, s.AuthService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	metricsHandler := handlers.NewMetricsHandler(s.Observatorium)
 	serviceStatusHandler := handlers.NewServiceStatusHandler(s.Dinosaur, s.AccessControlListConfig)
 	cloudAccountsHandler := handlers.NewCloudAccountsHandler(s.AMSClient)
+	webhookHandler := handlers.NewCentralWebhookHandler(s.CentralWebhook, s.CentralConfig)
 
 	authorizeMiddleware := s.AccessControlListMiddleware.Authorize
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
@@ -131,6 +146,21 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1DinosaursRouter.HandleFunc("/{id}", dinosaurHandler.Delete).
 		Name(logger.NewLogEvent("delete-central", "delete a central instance").ToString()).
 		Methods(http.MethodDelete)
+	apiV1DinosaursRouter.HandleFunc("/{id}", dinosaurHandler.Update).
+		Name(logger.NewLogEvent("update-central", "update a central instance").ToString()).
+		Methods(http.MethodPatch)
+	apiV1DinosaursRouter.HandleFunc("/{id}/events", dinosaurHandler.ListEvents).
+		Name(logger.NewLogEvent("get-central-events", "list the events of a central instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1DinosaursRouter.HandleFunc("/{id}/suspend", dinosaurHandler.Suspend).
+		Name(logger.NewLogEvent("suspend-central", "suspend a central instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1DinosaursRouter.HandleFunc("/{id}/resume", dinosaurHandler.Resume).
+		Name(logger.NewLogEvent("resume-central", "resume a central instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1DinosaursRouter.HandleFunc("/{id}/extend", dinosaurHandler.Extend).
+		Name(logger.NewLogEvent("extend-central-lifespan", "extend the lifespan of a central instance").ToString()).
+		Methods(http.MethodPost)
 	apiV1DinosaursRouter.HandleFunc("", dinosaurHandler.List).
 		Name(logger.NewLogEvent("list-central", "list all central").ToString()).
 		Methods(http.MethodGet)
@@ -182,6 +212,21 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 		Name(logger.NewLogEvent("get-cloud-accounts", "list all cloud accounts belonging to user org").ToString()).
 		Methods(http.MethodGet)
 
+	//  /webhooks
+	apiV1WebhooksRouter := apiV1Router.PathPrefix("/webhooks").Subrouter()
+	apiV1WebhooksRouter.HandleFunc("", webhookHandler.Create).
+		Name(logger.NewLogEvent("create-webhook", "register a webhook endpoint").ToString()).
+		Methods(http.MethodPost)
+	apiV1WebhooksRouter.HandleFunc("", webhookHandler.List).
+		Name(logger.NewLogEvent("list-webhooks", "list all webhook endpoints of the user org").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhooksRouter.HandleFunc("/{id}", webhookHandler.Delete).
+		Name(logger.NewLogEvent("delete-webhook", "delete a webhook endpoint").ToString()).
+		Methods(http.MethodDelete)
+	apiV1WebhooksRouter.Use(requireIssuer)
+	apiV1WebhooksRouter.Use(requireOrgID)
+	apiV1WebhooksRouter.Use(authorizeMiddleware)
+
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
 		Collections: v1Collections,
@@ -201,7 +246,7 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 
 	// /agent-clusters/{id}
 	dataPlaneClusterHandler := handlers.NewDataPlaneClusterHandler(s.DataPlaneCluster)
-	dataPlaneDinosaurHandler := handlers.NewDataPlaneDinosaurHandler(s.DataPlaneDinosaurService, s.Dinosaur, s.ManagedCentralPresenter)
+	dataPlaneDinosaurHandler := handlers.NewDataPlaneDinosaurHandler(s.DataPlaneDinosaurService, s.Dinosaur, s.CentralWatch, s.ManagedCentralPresenter)
 	apiV1DataPlaneRequestsRouter := apiV1Router.PathPrefix("/agent-clusters").Subrouter()
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}", dataPlaneClusterHandler.GetDataPlaneClusterConfig).
 		Name(logger.NewLogEvent("get-dataplane-cluster-config", "get dataplane cluster config by id").ToString()).
@@ -215,11 +260,17 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals", dataPlaneDinosaurHandler.GetAll).
 		Name(logger.NewLogEvent("list-dataplane-centrals", "list all dataplane centrals").ToString()).
 		Methods(http.MethodGet)
+	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals/watch", dataPlaneDinosaurHandler.Watch).
+		Name(logger.NewLogEvent("watch-dataplane-centrals", "watch dataplane centrals").ToString()).
+		Methods(http.MethodGet)
+	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals/{central_id}", dataPlaneDinosaurHandler.CheckExists).
+		Name(logger.NewLogEvent("check-dataplane-central-exists", "check whether a central exists").ToString()).
+		Methods(http.MethodGet)
 	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
 	auth.UseFleetShardAuthorizationMiddleware(apiV1DataPlaneRequestsRouter,
 		s.IAMConfig.RedhatSSORealm.ValidIssuerURI, s.FleetShardAuthZConfig)
 
-	adminCentralHandler := handlers.NewAdminDinosaurHandler(s.Dinosaur, s.AccountService, s.ProviderConfig)
+	adminCentralHandler := handlers.NewAdminDinosaurHandler(s.Dinosaur, s.CentralMigration, s.CentralBackup, s.CentralEvent, s.CentralIdempotency, s.CentralHibernation, s.CentralLifespan, s.AccountService, s.ProviderConfig, s.PlansConfig, s.CentralConfig)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer(
@@ -244,9 +295,61 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	adminCentralsRouter.HandleFunc("/{id}", adminCentralHandler.Update).
 		Name(logger.NewLogEvent("admin-update-central", "[admin] update central by id").ToString()).
 		Methods(http.MethodPatch)
+	adminCentralsRouter.HandleFunc("/{id}/events", adminCentralHandler.ListEvents).
+		Name(logger.NewLogEvent("admin-get-central-events", "[admin] list central events by id").ToString()).
+		Methods(http.MethodGet)
+	adminCentralsRouter.HandleFunc("/{id}/migrate", adminCentralHandler.Migrate).
+		Name(logger.NewLogEvent("admin-migrate-central", "[admin] migrate central by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/backups", adminCentralHandler.Backup).
+		Name(logger.NewLogEvent("admin-backup-central", "[admin] backup central db by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/restore", adminCentralHandler.Restore).
+		Name(logger.NewLogEvent("admin-restore-central", "[admin] restore central db by id").ToString()).
+		Methods(http.MethodPost)
+
+	adminCentralsRouter.HandleFunc("/{id}/suspend", adminCentralHandler.Suspend).
+		Name(logger.NewLogEvent("admin-suspend-central", "[admin] suspend central by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/resume", adminCentralHandler.Resume).
+		Name(logger.NewLogEvent("admin-resume-central", "[admin] resume central by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/extend", adminCentralHandler.Extend).
+		Name(logger.NewLogEvent("admin-extend-central-lifespan", "[admin] set the expiration of central by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/egress-allowlist", adminCentralHandler.UpdateEgressAllowlist).
+		Name(logger.NewLogEvent("admin-update-central-egress-allowlist", "[admin] update egress allowlist of central by id").ToString()).
+		Methods(http.MethodPut)
 
 	adminCreateRouter := adminCentralsRouter.NewRoute().Subrouter()
 	adminCreateRouter.HandleFunc("", adminCentralHandler.Create).Methods(http.MethodPost)
 
+	adminClusterHandler := handlers.NewAdminClusterHandler(s.Cluster, s.DataplaneClusterConfig)
+	adminClustersRouter := adminRouter.PathPrefix("/clusters").Subrouter()
+	adminClustersRouter.HandleFunc("/{id}/drain", adminClusterHandler.Drain).
+		Name(logger.NewLogEvent("admin-drain-cluster", "[admin] drain cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminClustersRouter.HandleFunc("/{id}/drain", adminClusterHandler.CancelDrain).
+		Name(logger.NewLogEvent("admin-cancel-drain-cluster", "[admin] cancel drain of cluster by id").ToString()).
+		Methods(http.MethodDelete)
+
+	adminUpgradeHandler := handlers.NewAdminUpgradeHandler(s.CentralUpgrade)
+	adminUpgradesRouter := adminRouter.PathPrefix("/upgrades").Subrouter()
+	adminUpgradesRouter.HandleFunc("", adminUpgradeHandler.Start).
+		Name(logger.NewLogEvent("admin-start-central-upgrade", "[admin] start central upgrade rollout").ToString()).
+		Methods(http.MethodPost)
+	adminUpgradesRouter.HandleFunc("/{id}", adminUpgradeHandler.Get).
+		Name(logger.NewLogEvent("admin-get-central-upgrade", "[admin] get central upgrade rollout by id").ToString()).
+		Methods(http.MethodGet)
+	adminUpgradesRouter.HandleFunc("/{id}/pause", adminUpgradeHandler.Pause).
+		Name(logger.NewLogEvent("admin-pause-central-upgrade", "[admin] pause central upgrade rollout by id").ToString()).
+		Methods(http.MethodPost)
+	adminUpgradesRouter.HandleFunc("/{id}/resume", adminUpgradeHandler.Resume).
+		Name(logger.NewLogEvent("admin-resume-central-upgrade", "[admin] resume central upgrade rollout by id").ToString()).
+		Methods(http.MethodPost)
+	adminUpgradesRouter.HandleFunc("/{id}/abort", adminUpgradeHandler.Abort).
+		Name(logger.NewLogEvent("admin-abort-central-upgrade", "[admin] abort central upgrade rollout by id").ToString()).
+		Methods(http.MethodPost)
+
 	return nil
 }
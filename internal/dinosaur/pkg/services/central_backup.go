@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+const (
+	dbBackupIDTimeFormat = "20060102150405"
+	// dbSnapshotStatusAvailable is the status of snapshots which can be restored, as reported by the data plane cluster
+	dbSnapshotStatusAvailable = "available"
+)
+
+// CentralBackupService requests on-demand snapshots and restores of the managed database of centrals.
+//
+// Requested operations are sent to the data plane cluster of the central with the managed central spec. A backup or
+// restore starts in status pending and goes through in_progress to completed or failed as reported by the data plane
+// cluster. Only the last requested backup and restore of a central are tracked.
+//
+//go:generate moq -out central_backup_moq.go . CentralBackupService
+type CentralBackupService interface {
+	// RequestBackup requests an on-demand snapshot of the managed database of a ready central.
+	RequestBackup(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// RequestRestore requests a restore of the managed database of a ready central from the given snapshot.
+	RequestRestore(ctx context.Context, id string, snapshotID string) (*dbapi.CentralRequest, *errors.ServiceError)
+}
+
+var _ CentralBackupService = &centralBackupService{}
+
+type centralBackupService struct {
+	dinosaurService DinosaurService
+}
+
+// NewCentralBackupService ...
+func NewCentralBackupService(dinosaurService DinosaurService) CentralBackupService {
+	return &centralBackupService{
+		dinosaurService: dinosaurService,
+	}
+}
+
+// RequestBackup ...
+func (b *centralBackupService) RequestBackup(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	central, svcErr := b.getReadyCentral(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if central.HasActiveDBBackup() {
+		return nil, errors.Conflict("a backup of central %s is already %s", central.ID, central.DBBackupStatus)
+	}
+	if central.HasActiveDBRestore() {
+		return nil, errors.Conflict("central %s can not be backed up while it is restored", central.ID)
+	}
+
+	backupID := getDBBackupID(central.ID, time.Now())
+	fields := map[string]interface{}{
+		"db_backup_id":     backupID,
+		"db_backup_status": constants.CentralDBOperationStatusPending.String(),
+		"db_failed_reason": "",
+	}
+	if svcErr := b.dinosaurService.Updates(central, fields); svcErr != nil {
+		return nil, errors.NewWithCause(svcErr.Code, svcErr, "failed to request backup of central %s", central.ID)
+	}
+	central.DBBackupID = backupID
+	central.DBBackupStatus = constants.CentralDBOperationStatusPending.String()
+	central.DBFailedReason = ""
+
+	glog.Infof("Requested backup %s of central %s", backupID, central.ID)
+	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationBackup)
+	return central, nil
+}
+
+// RequestRestore ...
+func (b *centralBackupService) RequestRestore(ctx context.Context, id string, snapshotID string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	if snapshotID == "" {
+		return nil, errors.BadRequest("snapshot id is required")
+	}
+	central, svcErr := b.getReadyCentral(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if central.HasActiveDBRestore() {
+		return nil, errors.Conflict("central %s is already restored from snapshot %s", central.ID, central.DBRestoreSnapshotID)
+	}
+	if central.IsMigrating() {
+		return nil, errors.Conflict("central %s can not be restored while it is migrated to cluster %s", central.ID, central.MigrationTargetClusterID)
+	}
+	if svcErr := validateRestoreSnapshot(central, snapshotID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	restoreID := api.NewID()
+	fields := map[string]interface{}{
+		"db_restore_id":          restoreID,
+		"db_restore_snapshot_id": snapshotID,
+		"db_restore_status":      constants.CentralDBOperationStatusPending.String(),
+		"db_failed_reason":       "",
+	}
+	if svcErr := b.dinosaurService.Updates(central, fields); svcErr != nil {
+		return nil, errors.NewWithCause(svcErr.Code, svcErr, "failed to request restore of central %s", central.ID)
+	}
+	central.DBRestoreID = restoreID
+	central.DBRestoreSnapshotID = snapshotID
+	central.DBRestoreStatus = constants.CentralDBOperationStatusPending.String()
+	central.DBFailedReason = ""
+
+	glog.Infof("Requested restore %s of central %s from snapshot %s", restoreID, central.ID, snapshotID)
+	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationRestore)
+	return central, nil
+}
+
+func (b *centralBackupService) getReadyCentral(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	if !auth.GetIsAdminFromContext(ctx) {
+		return nil, errors.New(errors.ErrorUnauthenticated, "User not authenticated")
+	}
+
+	central, svcErr := b.dinosaurService.GetByID(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if central.Status != constants.CentralRequestStatusReady.String() {
+		return nil, errors.BadRequest("managed database of central %s can not be backed up or restored in status %s", central.ID, central.Status)
+	}
+	return central, nil
+}
+
+// validateRestoreSnapshot makes sure that a central is only restored from an available snapshot of its own managed
+// database, as last reported by the data plane cluster.
+func validateRestoreSnapshot(central *dbapi.CentralRequest, snapshotID string) *errors.ServiceError {
+	snapshots, err := central.GetDBSnapshots()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get snapshots of central %s", central.ID)
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.ID != snapshotID {
+			continue
+		}
+		if snapshot.Status != dbSnapshotStatusAvailable {
+			return errors.BadRequest("snapshot %s of central %s is %s and can not be restored", snapshotID, central.ID, snapshot.Status)
+		}
+		return nil
+	}
+	return errors.BadRequest("snapshot %s is not a snapshot of central %s, request a backup to refresh the listed snapshots", snapshotID, central.ID)
+}
+
+// getDBBackupID returns the ID of an on-demand snapshot of the managed database of a central. The ID is a valid
+// snapshot identifier for cloud providers.
+func getDBBackupID(centralID string, now time.Time) string {
+	return fmt.Sprintf("rhacs-%s-%s", centralID, now.UTC().Format(dbBackupIDTimeFormat))
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralBackupServiceRequestRestore(t *testing.T) {
+	adminCtx := auth.SetIsAdminContext(context.Background(), true)
+	snapshots, err := json.Marshal([]dbapi.CentralDBSnapshot{
+		{ID: "snapshot", Status: "available"},
+		{ID: "creating-snapshot", Status: "creating"},
+	})
+	require.NoError(t, err)
+
+	tt := []struct {
+		description  string
+		ctx          context.Context
+		central      dbapi.CentralRequest
+		snapshotID   string
+		expectedCode serviceErrors.ServiceErrorCode
+	}{
+		{
+			description: "should request restore of a ready central",
+			ctx:         adminCtx,
+			central:     dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			snapshotID:  "snapshot",
+		},
+		{
+			description:  "should reject non admin users",
+			ctx:          context.Background(),
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			snapshotID:   "snapshot",
+			expectedCode: serviceErrors.ErrorUnauthenticated,
+		},
+		{
+			description:  "should require a snapshot",
+			ctx:          adminCtx,
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+		{
+			description:  "should reject centrals which are not ready",
+			ctx:          adminCtx,
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusProvisioning.String()},
+			snapshotID:   "snapshot",
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+		{
+			description: "should reject centrals which are restored already",
+			ctx:         adminCtx,
+			central: dbapi.CentralRequest{
+				Status:          constants.CentralRequestStatusReady.String(),
+				DBRestoreID:     "restore",
+				DBRestoreStatus: constants.CentralDBOperationStatusInProgress.String(),
+			},
+			snapshotID:   "snapshot",
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description:  "should reject snapshots which are not snapshots of the central",
+			ctx:          adminCtx,
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			snapshotID:   "other-central-snapshot",
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+		{
+			description:  "should reject snapshots which are not available",
+			ctx:          adminCtx,
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			snapshotID:   "creating-snapshot",
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+		{
+			description: "should reject migrating centrals",
+			ctx:         adminCtx,
+			central: dbapi.CentralRequest{
+				Status:          constants.CentralRequestStatusReady.String(),
+				MigrationStatus: constants.CentralMigrationStatusProvisioning.String(),
+			},
+			snapshotID:   "snapshot",
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			central := tc.central
+			central.ID = "central-id"
+			central.DBSnapshots = snapshots
+			var updates map[string]interface{}
+			dinosaurService := &DinosaurServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+					return &central, nil
+				},
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceErrors.ServiceError {
+					updates = values
+					return nil
+				},
+			}
+
+			res, err := NewCentralBackupService(dinosaurService).RequestRestore(tc.ctx, central.ID, tc.snapshotID)
+			if tc.expectedCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.expectedCode, err.Code)
+				assert.Nil(t, updates)
+				return
+			}
+			require.Nil(t, err)
+			assert.NotEmpty(t, res.DBRestoreID)
+			assert.Equal(t, tc.snapshotID, res.DBRestoreSnapshotID)
+			assert.True(t, res.HasActiveDBRestore())
+			assert.Equal(t, res.DBRestoreID, updates["db_restore_id"])
+			assert.Equal(t, constants.CentralDBOperationStatusPending.String(), updates["db_restore_status"])
+		})
+	}
+}
+
+func TestCentralBackupServiceRequestBackup(t *testing.T) {
+	central := &dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()}
+	central.ID = "central-id"
+	dinosaurService := &DinosaurServiceMock{
+		GetByIDFunc: func(id string) (*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+			return central, nil
+		},
+		UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceErrors.ServiceError {
+			return nil
+		},
+	}
+	service := NewCentralBackupService(dinosaurService)
+
+	res, err := service.RequestBackup(auth.SetIsAdminContext(context.Background(), true), central.ID)
+	require.Nil(t, err)
+	assert.Regexp(t, "^rhacs-central-id-[0-9]{14}$", res.DBBackupID)
+	assert.True(t, res.HasActiveDBBackup())
+
+	_, err = service.RequestBackup(auth.SetIsAdminContext(context.Background(), true), central.ID)
+	require.NotNil(t, err)
+	assert.Equal(t, serviceErrors.ErrorConflict, err.Code)
+}
+
+func TestGetDBBackupID(t *testing.T) {
+	now := time.Date(2022, 11, 15, 10, 30, 5, 0, time.FixedZone("CET", 3600))
+	assert.Equal(t, "rhacs-central-id-20221115093005", getDBBackupID("central-id", now))
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/lib/pq"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+)
+
+const (
+	// centralRequestChangesChannel is the PostgreSQL notification channel to which the central_requests trigger
+	// publishes the IDs of the data plane clusters whose centrals changed.
+	centralRequestChangesChannel = "central_request_changes"
+
+	centralChangeListenerMinReconnectInterval = 10 * time.Second
+	centralChangeListenerMaxReconnectInterval = time.Minute
+)
+
+// CentralChangeNotifier notifies subscribers about changes of the centrals assigned to a data plane cluster.
+//
+// Changes are published by a trigger on the central_requests table through PostgreSQL NOTIFY, so that writes of all
+// fleet-manager replicas reach the subscribers of this replica.
+type CentralChangeNotifier interface {
+	// Subscribe returns a channel which receives a value whenever a central of the cluster may have changed, and a
+	// function which ends the subscription.
+	Subscribe(clusterID string) (<-chan struct{}, func())
+}
+
+var _ CentralChangeNotifier = &centralChangeNotifier{}
+
+type centralChangeNotifier struct {
+	dbConfig *db.DatabaseConfig
+	listener *pq.Listener
+	stopCh   chan struct{}
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+// NewCentralChangeNotifier ...
+func NewCentralChangeNotifier(dbConfig *db.DatabaseConfig) *centralChangeNotifier {
+	return &centralChangeNotifier{
+		dbConfig:    dbConfig,
+		stopCh:      make(chan struct{}),
+		subscribers: map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+// Start listens for notifications about changed centrals.
+func (n *centralChangeNotifier) Start() {
+	n.listener = pq.NewListener(n.dbConfig.ConnectionString(), centralChangeListenerMinReconnectInterval,
+		centralChangeListenerMaxReconnectInterval, func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				glog.Errorf("Listening for changes of centrals: %v", err)
+			}
+		})
+	if err := n.listener.Listen(centralRequestChangesChannel); err != nil {
+		// The listener keeps reconnecting in the background and listens on the channel once it is connected.
+		glog.Errorf("Listening on channel %s: %v", centralRequestChangesChannel, err)
+	}
+	go n.run()
+}
+
+// Stop ...
+func (n *centralChangeNotifier) Stop() {
+	close(n.stopCh)
+	if err := n.listener.Close(); err != nil {
+		glog.Errorf("Closing listener for changes of centrals: %v", err)
+	}
+}
+
+func (n *centralChangeNotifier) run() {
+	for {
+		select {
+		case notification := <-n.listener.Notify:
+			// A nil notification is sent after the connection has been re-established. Notifications may have been
+			// lost in the meantime, so all subscribers are notified.
+			if notification == nil {
+				n.notifyAll()
+				continue
+			}
+			n.notify(notification.Extra)
+		case <-n.stopCh:
+			return
+		}
+	}
+}
+
+// Subscribe ...
+func (n *centralChangeNotifier) Subscribe(clusterID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.subscribers[clusterID] == nil {
+		n.subscribers[clusterID] = map[chan struct{}]struct{}{}
+	}
+	n.subscribers[clusterID][ch] = struct{}{}
+
+	return ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		delete(n.subscribers[clusterID], ch)
+		if len(n.subscribers[clusterID]) == 0 {
+			delete(n.subscribers, clusterID)
+		}
+	}
+}
+
+func (n *centralChangeNotifier) notify(clusterID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.subscribers[clusterID] {
+		wakeUp(ch)
+	}
+}
+
+func (n *centralChangeNotifier) notifyAll() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, subscribers := range n.subscribers {
+		for ch := range subscribers {
+			wakeUp(ch)
+		}
+	}
+}
+
+// wakeUp signals the channel without blocking. A pending signal already covers the new change.
+func wakeUp(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// CentralEventService keeps the append-only event history of centrals. The history records every change of the status
+// of a central, its placements on data plane clusters, its failures and its final deletion together with the actor and
+// the reason of the change.
+//
+//go:generate moq -out central_event_moq.go . CentralEventService
+type CentralEventService interface {
+	// Record appends an event to the history of its central and enqueues its delivery to the webhooks of the
+	// organisation of the central.
+	Record(event *dbapi.CentralEvent) *errors.ServiceError
+	// RecordInTransaction records an event like Record within the given transaction, so that the event and its webhook
+	// deliveries are persisted together with the change of the central the event records.
+	RecordInTransaction(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError
+	// ListByCentralID returns the history of a central, oldest event first. The caller has to make sure that the
+	// central may be accessed.
+	ListByCentralID(centralID string) (dbapi.CentralEventList, *errors.ServiceError)
+}
+
+var _ CentralEventService = &centralEventService{}
+
+type centralEventService struct {
+	connectionFactory *db.ConnectionFactory
+	webhookService    CentralWebhookService
+}
+
+// NewCentralEventService ...
+func NewCentralEventService(connectionFactory *db.ConnectionFactory, webhookService CentralWebhookService) CentralEventService {
+	return &centralEventService{
+		connectionFactory: connectionFactory,
+		webhookService:    webhookService,
+	}
+}
+
+// Record ...
+func (e *centralEventService) Record(event *dbapi.CentralEvent) *errors.ServiceError {
+	var svcErr *errors.ServiceError
+	if err := e.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if svcErr = e.RecordInTransaction(tx, event); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		if svcErr != nil {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record %s event of central %s", event.Type, event.CentralID)
+	}
+	return nil
+}
+
+// RecordInTransaction ...
+func (e *centralEventService) RecordInTransaction(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError {
+	if err := tx.Create(event).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record %s event of central %s", event.Type, event.CentralID)
+	}
+	return e.webhookService.EnqueueDeliveries(tx, event)
+}
+
+// ListByCentralID ...
+func (e *centralEventService) ListByCentralID(centralID string) (dbapi.CentralEventList, *errors.ServiceError) {
+	if centralID == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	dbConn := e.connectionFactory.New()
+	var events dbapi.CentralEventList
+	if err := dbConn.Where("central_id = ?", centralID).Order("created_at").Find(&events).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list events of central %s", centralID)
+	}
+	return events, nil
+}
+
+// RecordCentralEvent records an event in the history of a central. The recorded change has been persisted already,
+// hence failing to record it is only logged instead of failing the change.
+func RecordCentralEvent(eventService CentralEventService, event *dbapi.CentralEvent) {
+	if err := eventService.Record(event); err != nil {
+		glog.Errorf("Failed to record %s event of central %s: %v", event.Type, event.CentralID, err)
+	}
+}
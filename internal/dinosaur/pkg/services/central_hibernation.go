@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+// CentralHibernationService suspends and resumes centrals.
+//
+// A suspended central is scaled down to zero by its data plane cluster while its namespace, volumes and managed
+// database are kept, so that it does not use any capacity of the cluster. Suspending a ready central changes its
+// status to suspending, and to suspended once the data plane cluster reports that it has been scaled down. Resuming a
+// suspended central changes its status to resuming, and to ready once the data plane cluster reports it as ready.
+// A suspended central is only resumed if its cluster has the capacity to run it again.
+//
+//go:generate moq -out central_hibernation_moq.go . CentralHibernationService
+type CentralHibernationService interface {
+	// Suspend requests the data plane cluster to scale down a ready central.
+	Suspend(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// Resume requests the data plane cluster to scale up a suspended central again.
+	Resume(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError)
+}
+
+var _ CentralHibernationService = &centralHibernationService{}
+
+type centralHibernationService struct {
+	dinosaurService        DinosaurService
+	clusterService         ClusterService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+// NewCentralHibernationService ...
+func NewCentralHibernationService(dinosaurService DinosaurService, clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig) CentralHibernationService {
+	return &centralHibernationService{
+		dinosaurService:        dinosaurService,
+		clusterService:         clusterService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// Suspend ...
+func (h *centralHibernationService) Suspend(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	central, svcErr := h.dinosaurService.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	// A central which is still resuming can be suspended again right away.
+	if central.Status != constants.CentralRequestStatusReady.String() && central.Status != constants.CentralRequestStatusResuming.String() {
+		return nil, errors.Conflict("central %s can not be suspended in status %s", central.ID, central.Status)
+	}
+	if central.IsMigrating() {
+		return nil, errors.Conflict("central %s can not be suspended while it is migrated to cluster %s", central.ID, central.MigrationTargetClusterID)
+	}
+	if central.HasActiveDBBackup() || central.HasActiveDBRestore() {
+		return nil, errors.Conflict("central %s can not be suspended while its managed database is backed up or restored", central.ID)
+	}
+
+	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationSuspend)
+	if svcErr := h.updateStatus(ctx, central, constants.CentralRequestStatusSuspending, "suspension requested"); svcErr != nil {
+		return nil, svcErr
+	}
+	metrics.IncreaseCentralSuccessOperationsCountMetric(constants.CentralOperationSuspend)
+	return central, nil
+}
+
+// Resume ...
+func (h *centralHibernationService) Resume(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	central, svcErr := h.dinosaurService.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	// A central which is still suspending can be resumed again right away.
+	if central.Status != constants.CentralRequestStatusSuspended.String() && central.Status != constants.CentralRequestStatusSuspending.String() {
+		return nil, errors.Conflict("central %s can not be resumed in status %s", central.ID, central.Status)
+	}
+	// A suspending central still counts against the capacity of its cluster.
+	if central.Status == constants.CentralRequestStatusSuspended.String() {
+		if svcErr := h.checkClusterCapacity(central); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationResume)
+	if svcErr := h.updateStatus(ctx, central, constants.CentralRequestStatusResuming, "resumption requested"); svcErr != nil {
+		return nil, svcErr
+	}
+	metrics.IncreaseCentralSuccessOperationsCountMetric(constants.CentralOperationResume)
+	return central, nil
+}
+
+// checkClusterCapacity fails if the cluster of a suspended central can not run another central, like the placement of
+// new centrals, which does not count suspended centrals either.
+func (h *centralHibernationService) checkClusterCapacity(central *dbapi.CentralRequest) *errors.ServiceError {
+	counts, svcErr := h.clusterService.FindActiveDinosaurInstanceCount([]string{central.ClusterID})
+	if svcErr != nil {
+		return errors.NewWithCause(svcErr.Code, svcErr, "failed to count centrals of cluster %s", central.ClusterID)
+	}
+	count := 0
+	for _, c := range counts {
+		if c.Clusterid == central.ClusterID {
+			count = c.Count
+		}
+	}
+	if !h.dataplaneClusterConfig.ClusterConfig.IsNumberOfDinosaurWithinClusterLimit(central.ClusterID, count+1) {
+		return errors.TooManyDinosaurInstancesReached("central %s can not be resumed: capacity of cluster %s exhausted", central.ID, central.ClusterID)
+	}
+	return nil
+}
+
+func (h *centralHibernationService) updateStatus(ctx context.Context, central *dbapi.CentralRequest, status constants.CentralStatus, reason string) *errors.ServiceError {
+	previousStatus := constants.CentralStatus(central.Status)
+	var user string
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		user, _ = claims.GetUsername()
+	}
+	event := dbapi.NewCentralStatusEvent(central, previousStatus, status, user, reason)
+	if svcErr := h.dinosaurService.Updates(central, map[string]interface{}{"status": status.String()}, event); svcErr != nil {
+		return errors.NewWithCause(svcErr.Code, svcErr, "failed to update status %s for central %s", status, central.ID)
+	}
+	central.Status = status.String()
+	glog.Infof("Central %s changed from status %s to %s: %s", central.ID, previousStatus, status, reason)
+	return nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralHibernationService(t *testing.T) {
+	tt := []struct {
+		description    string
+		resume         bool
+		central        dbapi.CentralRequest
+		getErr         *serviceErrors.ServiceError
+		clusterCount   int
+		expectedStatus constants.CentralStatus
+		expectedCode   serviceErrors.ServiceErrorCode
+	}{
+		{
+			description:    "should suspend a ready central",
+			central:        dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			expectedStatus: constants.CentralRequestStatusSuspending,
+		},
+		{
+			description:    "should suspend a resuming central",
+			central:        dbapi.CentralRequest{Status: constants.CentralRequestStatusResuming.String()},
+			expectedStatus: constants.CentralRequestStatusSuspending,
+		},
+		{
+			description:  "should not suspend a provisioning central",
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusProvisioning.String()},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description:  "should not suspend a suspended central",
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusSuspended.String()},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description: "should not suspend a migrating central",
+			central: dbapi.CentralRequest{
+				Status:          constants.CentralRequestStatusReady.String(),
+				MigrationStatus: constants.CentralMigrationStatusProvisioning.String(),
+			},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description: "should not suspend a central whose managed database is restored",
+			central: dbapi.CentralRequest{
+				Status:          constants.CentralRequestStatusReady.String(),
+				DBRestoreID:     "restore",
+				DBRestoreStatus: constants.CentralDBOperationStatusPending.String(),
+			},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description:  "should not suspend a central the user can not access",
+			getErr:       serviceErrors.NotFound("not found"),
+			expectedCode: serviceErrors.ErrorNotFound,
+		},
+		{
+			description:    "should resume a suspended central",
+			resume:         true,
+			central:        dbapi.CentralRequest{Status: constants.CentralRequestStatusSuspended.String()},
+			expectedStatus: constants.CentralRequestStatusResuming,
+		},
+		{
+			description:    "should resume a suspended central if its cluster has capacity",
+			resume:         true,
+			central:        dbapi.CentralRequest{Status: constants.CentralRequestStatusSuspended.String()},
+			clusterCount:   1,
+			expectedStatus: constants.CentralRequestStatusResuming,
+		},
+		{
+			description:  "should not resume a suspended central if its cluster is full",
+			resume:       true,
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusSuspended.String()},
+			clusterCount: 2,
+			expectedCode: serviceErrors.ErrorTooManyDinosaurInstancesReached,
+		},
+		{
+			description:    "should resume a suspending central",
+			resume:         true,
+			central:        dbapi.CentralRequest{Status: constants.CentralRequestStatusSuspending.String()},
+			clusterCount:   2,
+			expectedStatus: constants.CentralRequestStatusResuming,
+		},
+		{
+			description:  "should not resume a ready central",
+			resume:       true,
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			central := tc.central
+			central.ID = "central-id"
+			central.ClusterID = "cluster-id"
+			var updates map[string]interface{}
+			var events []*dbapi.CentralEvent
+			dinosaurService := &DinosaurServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+					if tc.getErr != nil {
+						return nil, tc.getErr
+					}
+					return &central, nil
+				},
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, updateEvents ...*dbapi.CentralEvent) *serviceErrors.ServiceError {
+					updates = values
+					events = updateEvents
+					return nil
+				},
+			}
+			clusterService := &ClusterServiceMock{
+				FindActiveDinosaurInstanceCountFunc: func(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceErrors.ServiceError) {
+					return []ResDinosaurInstanceCount{{Clusterid: "cluster-id", Count: tc.clusterCount}}, nil
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{
+				{ClusterID: "cluster-id", CentralInstanceLimit: 2},
+			})
+			service := NewCentralHibernationService(dinosaurService, clusterService, dataplaneClusterConfig)
+
+			var res *dbapi.CentralRequest
+			var err *serviceErrors.ServiceError
+			if tc.resume {
+				res, err = service.Resume(context.Background(), central.ID)
+			} else {
+				res, err = service.Suspend(context.Background(), central.ID)
+			}
+			if tc.expectedCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.expectedCode, err.Code)
+				assert.Nil(t, updates)
+				assert.Empty(t, events)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.expectedStatus.String(), res.Status)
+			assert.Equal(t, map[string]interface{}{"status": tc.expectedStatus.String()}, updates)
+			require.Len(t, events, 1)
+			assert.Equal(t, tc.expectedStatus.String(), events[0].ToStatus)
+		})
+	}
+}
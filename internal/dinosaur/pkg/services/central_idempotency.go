@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// CentralIdempotencyService stores the Idempotency-Keys of requests creating centrals. Keys are scoped to the
+// organisation of the user authenticated for the request, or to the user if they are not part of an organisation, and
+// can be reused once they expired.
+//
+//go:generate moq -out central_idempotency_moq.go . CentralIdempotencyService
+type CentralIdempotencyService interface {
+	// Reserve reserves a key for a request with the given hash. If the key was used for a request with the same hash
+	// already, the central created by that request is returned instead. Reserving a key used for a request with a
+	// different hash fails, as does reserving a key of a request which is still in progress.
+	Reserve(ctx context.Context, key string, requestHash string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// Complete records the central created by the request a key has been reserved for.
+	Complete(ctx context.Context, key string, centralID string) *errors.ServiceError
+	// Release frees a key reserved for a request which failed, so that the request can be retried.
+	Release(ctx context.Context, key string) *errors.ServiceError
+	// DeleteExpiredKeys removes the keys whose TTL has passed.
+	DeleteExpiredKeys() *errors.ServiceError
+}
+
+var _ CentralIdempotencyService = &centralIdempotencyService{}
+
+type centralIdempotencyService struct {
+	connectionFactory *db.ConnectionFactory
+	centralConfig     *config.CentralConfig
+}
+
+// NewCentralIdempotencyService ...
+func NewCentralIdempotencyService(connectionFactory *db.ConnectionFactory, centralConfig *config.CentralConfig) CentralIdempotencyService {
+	return &centralIdempotencyService{
+		connectionFactory: connectionFactory,
+		centralConfig:     centralConfig,
+	}
+}
+
+// Reserve ...
+func (s *centralIdempotencyService) Reserve(ctx context.Context, key string, requestHash string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	orgID, owner, svcErr := getIdempotencyKeyScope(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	dbConn := s.connectionFactory.New()
+	var existing dbapi.CentralIdempotencyKey
+	err := scopeIdempotencyKey(dbConn, orgID, owner, key).First(&existing).Error
+	switch {
+	case err != nil && !services.IsRecordNotFoundError(err):
+		return nil, services.HandleGetError("CentralIdempotencyKey", "key", key, err)
+	case err == nil && existing.ExpiresAt.Before(time.Now()):
+		// Keys are deleted permanently so that the unique index does not stop them from being reserved again.
+		if err := dbConn.Unscoped().Delete(&existing).Error; err != nil {
+			return nil, services.HandleDeleteError("CentralIdempotencyKey", "key", key, err)
+		}
+	case err == nil:
+		return s.replay(&existing, requestHash)
+	}
+
+	reservation := &dbapi.CentralIdempotencyKey{
+		OrganisationID: orgID,
+		Owner:          owner,
+		Key:            key,
+		RequestHash:    requestHash,
+		ExpiresAt:      time.Now().Add(s.centralConfig.IdempotencyKeyTTL),
+	}
+	if err := dbConn.Create(reservation).Error; err != nil {
+		if strings.Contains(err.Error(), "violates unique constraint") {
+			// A concurrent request reserved the key first.
+			return nil, errors.Conflict("a request with Idempotency-Key %q is in progress", key)
+		}
+		return nil, services.HandleCreateError("CentralIdempotencyKey", err)
+	}
+	return nil, nil
+}
+
+// replay returns the central created by the request an unexpired key has been reserved for.
+func (s *centralIdempotencyService) replay(existing *dbapi.CentralIdempotencyKey, requestHash string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	if existing.RequestHash != requestHash {
+		return nil, errors.IdempotencyKeyReused("Idempotency-Key %q has already been used for a different request", existing.Key)
+	}
+	if existing.CentralID == "" {
+		return nil, errors.Conflict("a request with Idempotency-Key %q is in progress", existing.Key)
+	}
+
+	// The central may have been deleted since, it is returned in its latest state nevertheless.
+	dbConn := s.connectionFactory.New()
+	var central dbapi.CentralRequest
+	if err := dbConn.Unscoped().Where("id = ?", existing.CentralID).First(&central).Error; err != nil {
+		return nil, services.HandleGetError("CentralResource", "id", existing.CentralID, err)
+	}
+	return &central, nil
+}
+
+// Complete ...
+func (s *centralIdempotencyService) Complete(ctx context.Context, key string, centralID string) *errors.ServiceError {
+	orgID, owner, svcErr := getIdempotencyKeyScope(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	dbConn := s.connectionFactory.New()
+	if err := scopeIdempotencyKey(dbConn.Model(&dbapi.CentralIdempotencyKey{}), orgID, owner, key).
+		Update("central_id", centralID).Error; err != nil {
+		return services.HandleUpdateError("CentralIdempotencyKey", err)
+	}
+	return nil
+}
+
+// Release ...
+func (s *centralIdempotencyService) Release(ctx context.Context, key string) *errors.ServiceError {
+	orgID, owner, svcErr := getIdempotencyKeyScope(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	dbConn := s.connectionFactory.New()
+	if err := scopeIdempotencyKey(dbConn.Unscoped(), orgID, owner, key).Where("central_id = ''").
+		Delete(&dbapi.CentralIdempotencyKey{}).Error; err != nil {
+		return services.HandleDeleteError("CentralIdempotencyKey", "key", key, err)
+	}
+	return nil
+}
+
+// DeleteExpiredKeys ...
+func (s *centralIdempotencyService) DeleteExpiredKeys() *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+	if err := dbConn.Unscoped().Where("expires_at < ?", time.Now()).Delete(&dbapi.CentralIdempotencyKey{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete expired central idempotency keys")
+	}
+	return nil
+}
+
+func scopeIdempotencyKey(dbConn *gorm.DB, orgID string, owner string, key string) *gorm.DB {
+	return dbConn.Where("organisation_id = ? AND owner = ? AND key = ?", orgID, owner, key)
+}
+
+func getIdempotencyKeyScope(ctx context.Context) (string, string, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", "", errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	if orgID, _ := claims.GetOrgID(); orgID != "" {
+		return orgID, "", nil
+	}
+	owner, _ := claims.GetUsername()
+	if owner == "" {
+		return "", "", errors.Unauthenticated("user not authenticated")
+	}
+	return "", owner, nil
+}
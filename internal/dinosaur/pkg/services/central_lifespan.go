@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// CentralLifespanService postpones the expiration of centrals.
+//
+// Centrals of instance types with a lifespan, e.g. eval centrals, expire at the time stored in their ExpiresAt field
+// and are deprovisioned by the DinosaurManager afterwards. Users can extend the lifespan of their centrals a limited
+// number of times, while admins can set any expiration time in the future.
+//
+//go:generate moq -out central_lifespan_moq.go . CentralLifespanService
+type CentralLifespanService interface {
+	// Extend postpones the expiration of a central by the configured lifespan extension, unless the central has been
+	// extended the maximum number of times already.
+	Extend(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// SetExpiration sets the expiration of a central to the given time. It is meant for admins and not limited.
+	SetExpiration(ctx context.Context, id string, expiresAt time.Time) (*dbapi.CentralRequest, *errors.ServiceError)
+}
+
+var _ CentralLifespanService = &centralLifespanService{}
+
+type centralLifespanService struct {
+	dinosaurService DinosaurService
+	eventService    CentralEventService
+	lifespanConfig  *config.CentralLifespanConfig
+}
+
+// NewCentralLifespanService ...
+func NewCentralLifespanService(dinosaurService DinosaurService, eventService CentralEventService, centralConfig *config.CentralConfig) CentralLifespanService {
+	return &centralLifespanService{
+		dinosaurService: dinosaurService,
+		eventService:    eventService,
+		lifespanConfig:  centralConfig.CentralLifespan,
+	}
+}
+
+// Extend ...
+func (l *centralLifespanService) Extend(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	central, svcErr := l.getExpiringCentral(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if central.LifespanExtensions >= l.lifespanConfig.MaxCentralLifespanExtensions {
+		return nil, errors.Conflict("the lifespan of central %s has been extended the maximum number of %d times already", central.ID, l.lifespanConfig.MaxCentralLifespanExtensions)
+	}
+
+	expiresAt := central.ExpiresAt.Add(time.Duration(l.lifespanConfig.CentralLifespanExtensionInHours) * time.Hour)
+	if svcErr := l.updateExpiration(ctx, central, expiresAt, central.LifespanExtensions+1); svcErr != nil {
+		return nil, svcErr
+	}
+	return central, nil
+}
+
+// SetExpiration ...
+func (l *centralLifespanService) SetExpiration(ctx context.Context, id string, expiresAt time.Time) (*dbapi.CentralRequest, *errors.ServiceError) {
+	if !expiresAt.After(time.Now()) {
+		return nil, errors.BadRequest("expires_at must be in the future")
+	}
+	central, svcErr := l.getExpiringCentral(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if svcErr := l.updateExpiration(ctx, central, expiresAt, central.LifespanExtensions); svcErr != nil {
+		return nil, svcErr
+	}
+	return central, nil
+}
+
+func (l *centralLifespanService) getExpiringCentral(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	central, svcErr := l.dinosaurService.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if central.ExpiresAt == nil {
+		return nil, errors.Conflict("central %s of instance type %s does not expire", central.ID, central.InstanceType)
+	}
+	if arrays.Contains(dinosaurDeletionStatuses, central.Status) {
+		return nil, errors.Conflict("central %s is being deleted", central.ID)
+	}
+	return central, nil
+}
+
+func (l *centralLifespanService) updateExpiration(ctx context.Context, central *dbapi.CentralRequest, expiresAt time.Time, extensions int) *errors.ServiceError {
+	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationExtendLifespan)
+	previousExpiresAt := *central.ExpiresAt
+	// A new expiry warning is sent before the new expiration time.
+	err := l.dinosaurService.Updates(central, map[string]interface{}{
+		"expires_at":          expiresAt,
+		"lifespan_extensions": extensions,
+		"expiry_warning_sent": false,
+	})
+	if err != nil {
+		return errors.NewWithCause(err.Code, err, "failed to extend the lifespan of central %s", central.ID)
+	}
+	central.ExpiresAt = &expiresAt
+	central.LifespanExtensions = extensions
+	central.ExpiryWarningSent = false
+	metrics.IncreaseCentralSuccessOperationsCountMetric(constants.CentralOperationExtendLifespan)
+
+	var user string
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		user, _ = claims.GetUsername()
+	}
+	reason := fmt.Sprintf("expiration changed from %s to %s", previousExpiresAt.UTC().Format(time.RFC3339), expiresAt.UTC().Format(time.RFC3339))
+	glog.Infof("Lifespan of central %s extended: %s", central.ID, reason)
+	RecordCentralEvent(l.eventService, dbapi.NewCentralLifespanExtensionEvent(central, user, reason))
+	return nil
+}
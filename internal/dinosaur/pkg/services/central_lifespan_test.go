@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralLifespanService(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	adminExpiresAt := expiresAt.Add(7 * 24 * time.Hour)
+
+	tt := []struct {
+		description        string
+		central            dbapi.CentralRequest
+		setExpiration      *time.Time
+		expectedExpiresAt  time.Time
+		expectedExtensions int
+		expectedCode       serviceErrors.ServiceErrorCode
+	}{
+		{
+			description:        "should extend the lifespan of an expiring central",
+			central:            dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ExpiresAt: &expiresAt, ExpiryWarningSent: true},
+			expectedExpiresAt:  expiresAt.Add(24 * time.Hour),
+			expectedExtensions: 1,
+		},
+		{
+			description:  "should not extend the lifespan more than the maximum number of times",
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ExpiresAt: &expiresAt, LifespanExtensions: 2},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description:  "should not extend the lifespan of a central which does not expire",
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description:  "should not extend the lifespan of a deprovisioned central",
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusDeprovision.String(), ExpiresAt: &expiresAt},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description:        "should set the expiration without limit",
+			central:            dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ExpiresAt: &expiresAt, LifespanExtensions: 2},
+			setExpiration:      &adminExpiresAt,
+			expectedExpiresAt:  adminExpiresAt,
+			expectedExtensions: 2,
+		},
+		{
+			description:   "should not set an expiration in the past",
+			central:       dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ExpiresAt: &expiresAt},
+			setExpiration: &time.Time{},
+			expectedCode:  serviceErrors.ErrorBadRequest,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			central := tc.central
+			central.ID = "central-id"
+			var updates map[string]interface{}
+			dinosaurService := &DinosaurServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+					return &central, nil
+				},
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceErrors.ServiceError {
+					updates = values
+					return nil
+				},
+			}
+			eventService := &CentralEventServiceMock{
+				RecordFunc: func(event *dbapi.CentralEvent) *serviceErrors.ServiceError {
+					return nil
+				},
+			}
+			service := NewCentralLifespanService(dinosaurService, eventService, &config.CentralConfig{CentralLifespan: config.NewCentralLifespanConfig()})
+
+			var res *dbapi.CentralRequest
+			var err *serviceErrors.ServiceError
+			if tc.setExpiration != nil {
+				res, err = service.SetExpiration(context.Background(), central.ID, *tc.setExpiration)
+			} else {
+				res, err = service.Extend(context.Background(), central.ID)
+			}
+			if tc.expectedCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.expectedCode, err.Code)
+				assert.Nil(t, updates)
+				assert.Empty(t, eventService.RecordCalls())
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.expectedExpiresAt, *res.ExpiresAt)
+			assert.Equal(t, tc.expectedExtensions, res.LifespanExtensions)
+			assert.Equal(t, map[string]interface{}{
+				"expires_at":          tc.expectedExpiresAt,
+				"lifespan_extensions": tc.expectedExtensions,
+				"expiry_warning_sent": false,
+			}, updates)
+			require.Len(t, eventService.RecordCalls(), 1)
+			assert.Equal(t, constants.CentralEventTypeLifespanExtension.String(), eventService.RecordCalls()[0].Event.Type)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+// CentralMigrationService moves ready centrals between data plane clusters.
+//
+// A migration goes through the following migration statuses:
+//   - scaling_down_source: the central is scaled down on the source cluster, since the source and the target cluster
+//     share its managed DB and must not run the central against it at the same time.
+//   - provisioning: the central is provisioned on the target cluster, which takes over the managed DB.
+//   - switching_dns: the target cluster reported the central as ready and the CNAME records are switched to it.
+//   - deprovisioning_source: the central is assigned to the target cluster and removed from the source cluster.
+//   - completed: the central has been removed from the source cluster.
+//
+// If the target cluster fails to provision the central, the migration goes through deprovisioning_target to failed
+// and the central stays on the source cluster, which takes the managed DB back and scales the central up again.
+//
+//go:generate moq -out central_migration_moq.go . CentralMigrationService
+type CentralMigrationService interface {
+	// StartMigration selects a target cluster for a ready central and starts its migration.
+	StartMigration(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// MigrateCentral selects a target cluster for the given ready central and starts its migration.
+	MigrateCentral(central *dbapi.CentralRequest) *errors.ServiceError
+	// ListByMigrationStatus returns the centrals in one of the given migration statuses.
+	ListByMigrationStatus(status ...constants.CentralMigrationStatus) ([]*dbapi.CentralRequest, *errors.ServiceError)
+	// UpdateMigrationStatus changes the migration status of a central and updates the given additional fields.
+	UpdateMigrationStatus(central *dbapi.CentralRequest, status constants.CentralMigrationStatus, fields map[string]interface{}) *errors.ServiceError
+}
+
+var _ CentralMigrationService = &centralMigrationService{}
+
+type centralMigrationService struct {
+	connectionFactory        *db.ConnectionFactory
+	dinosaurService          DinosaurService
+	clusterPlacementStrategy ClusterPlacementStrategy
+}
+
+// NewCentralMigrationService ...
+func NewCentralMigrationService(connectionFactory *db.ConnectionFactory, dinosaurService DinosaurService, clusterPlacementStrategy ClusterPlacementStrategy) CentralMigrationService {
+	return &centralMigrationService{
+		connectionFactory:        connectionFactory,
+		dinosaurService:          dinosaurService,
+		clusterPlacementStrategy: clusterPlacementStrategy,
+	}
+}
+
+// StartMigration ...
+func (m *centralMigrationService) StartMigration(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	if !auth.GetIsAdminFromContext(ctx) {
+		return nil, errors.New(errors.ErrorUnauthenticated, "User not authenticated")
+	}
+
+	central, svcErr := m.dinosaurService.GetByID(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := m.MigrateCentral(central); svcErr != nil {
+		return nil, svcErr
+	}
+	return central, nil
+}
+
+// MigrateCentral ...
+func (m *centralMigrationService) MigrateCentral(central *dbapi.CentralRequest) *errors.ServiceError {
+	if central.Status != constants.CentralRequestStatusReady.String() {
+		return errors.BadRequest("central %s can not be migrated in status %s", central.ID, central.Status)
+	}
+	if central.IsMigrating() {
+		return errors.Conflict("central %s is already being migrated to cluster %s", central.ID, central.MigrationTargetClusterID)
+	}
+	// The managed DB is shared by the source and target cluster and must not be replaced while it is migrated.
+	if central.HasActiveDBBackup() || central.HasActiveDBRestore() {
+		return errors.Conflict("central %s can not be migrated while a backup or restore of its managed DB is running", central.ID)
+	}
+
+	candidate := *central
+	candidate.MigrationSourceClusterID = central.ClusterID
+	cluster, err := m.clusterPlacementStrategy.FindCluster(&candidate)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to find a target cluster for central %s", central.ID)
+	}
+	if cluster == nil {
+		return errors.GeneralError("no target cluster found for central %s", central.ID)
+	}
+
+	now := time.Now()
+	fields := map[string]interface{}{
+		"migration_source_cluster_id": central.ClusterID,
+		"migration_target_cluster_id": cluster.ClusterID,
+		"migration_started_at":        &now,
+	}
+	if svcErr := m.UpdateMigrationStatus(central, constants.CentralMigrationStatusScalingDownSource, fields); svcErr != nil {
+		return svcErr
+	}
+	central.MigrationSourceClusterID = central.ClusterID
+	central.MigrationTargetClusterID = cluster.ClusterID
+	central.MigrationStartedAt = &now
+
+	glog.Infof("Started migration of central %s from cluster %s to cluster %s", central.ID, central.MigrationSourceClusterID, central.MigrationTargetClusterID)
+	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationMigrate)
+	return nil
+}
+
+// ListByMigrationStatus ...
+func (m *centralMigrationService) ListByMigrationStatus(status ...constants.CentralMigrationStatus) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	if len(status) == 0 {
+		return nil, errors.GeneralError("no migration status provided")
+	}
+	dbConn := m.connectionFactory.New()
+
+	var centrals []*dbapi.CentralRequest
+	if err := dbConn.Model(&dbapi.CentralRequest{}).Where("migration_status IN (?)", status).Scan(&centrals).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list by migration status")
+	}
+
+	return centrals, nil
+}
+
+// UpdateMigrationStatus ...
+func (m *centralMigrationService) UpdateMigrationStatus(central *dbapi.CentralRequest, status constants.CentralMigrationStatus, fields map[string]interface{}) *errors.ServiceError {
+	values := map[string]interface{}{"migration_status": status.String()}
+	for k, v := range fields {
+		values[k] = v
+	}
+	if err := m.dinosaurService.Updates(central, values); err != nil {
+		return errors.NewWithCause(err.Code, err, "failed to update migration status %s for central %s", status, central.ID)
+	}
+	central.MigrationStatus = status.String()
+
+	metrics.IncreaseCentralMigrationTransitionsCountMetric(status)
+	if status == constants.CentralMigrationStatusCompleted || status == constants.CentralMigrationStatusFailed {
+		if central.MigrationStartedAt != nil {
+			metrics.UpdateCentralMigrationDurationMetric(status, time.Since(*central.MigrationStartedAt))
+		}
+		if status == constants.CentralMigrationStatusCompleted {
+			metrics.IncreaseCentralSuccessOperationsCountMetric(constants.CentralOperationMigrate)
+		}
+	}
+	return nil
+}
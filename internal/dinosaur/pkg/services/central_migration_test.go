@@ -0,0 +1,95 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralMigrationServiceMigrateCentral(t *testing.T) {
+	tt := []struct {
+		description  string
+		central      dbapi.CentralRequest
+		expectedCode serviceErrors.ServiceErrorCode
+	}{
+		{
+			description: "should migrate a ready central",
+			central:     dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String()},
+		},
+		{
+			description:  "should reject centrals which are not ready",
+			central:      dbapi.CentralRequest{Status: constants.CentralRequestStatusProvisioning.String()},
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+		{
+			description: "should reject migrating centrals",
+			central: dbapi.CentralRequest{
+				Status:          constants.CentralRequestStatusReady.String(),
+				MigrationStatus: constants.CentralMigrationStatusProvisioning.String(),
+			},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description: "should reject centrals with a running backup",
+			central: dbapi.CentralRequest{
+				Status:         constants.CentralRequestStatusReady.String(),
+				DBBackupID:     "backup",
+				DBBackupStatus: constants.CentralDBOperationStatusInProgress.String(),
+			},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description: "should reject centrals with a pending restore",
+			central: dbapi.CentralRequest{
+				Status:          constants.CentralRequestStatusReady.String(),
+				DBRestoreID:     "restore",
+				DBRestoreStatus: constants.CentralDBOperationStatusPending.String(),
+			},
+			expectedCode: serviceErrors.ErrorConflict,
+		},
+		{
+			description: "should migrate centrals with a completed backup",
+			central: dbapi.CentralRequest{
+				Status:         constants.CentralRequestStatusReady.String(),
+				DBBackupID:     "backup",
+				DBBackupStatus: constants.CentralDBOperationStatusCompleted.String(),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			central := tc.central
+			central.ID = "central-id"
+			central.ClusterID = "source"
+			var updates map[string]interface{}
+			dinosaurService := &DinosaurServiceMock{
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceErrors.ServiceError {
+					updates = values
+					return nil
+				},
+			}
+			placementStrategy := &ClusterPlacementStrategyMock{
+				FindClusterFunc: func(central *dbapi.CentralRequest) (*api.Cluster, error) {
+					return &api.Cluster{ClusterID: "target"}, nil
+				},
+			}
+
+			err := NewCentralMigrationService(nil, dinosaurService, placementStrategy).MigrateCentral(&central)
+			if tc.expectedCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.expectedCode, err.Code)
+				assert.Nil(t, updates)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, "target", central.MigrationTargetClusterID)
+			assert.Equal(t, constants.CentralMigrationStatusScalingDownSource.String(), updates["migration_status"])
+		})
+	}
+}
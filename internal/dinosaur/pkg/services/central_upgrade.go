@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// CentralUpgradeService rolls new desired versions out across the fleet.
+//
+// A rollout upgrades the ready centrals in waves. The canary wave upgrades the centrals of the configured internal
+// organisations. Each following wave upgrades the centrals until the given cumulative percentage of the fleet has been
+// upgraded. Only centrals on clusters on which the central operator version is ready are upgraded. A wave is started once all upgrades of the previous wave succeeded or failed. The upgrade of a central
+// succeeds when the data plane reports it as ready with the desired versions, and fails when the data plane reports
+// an error or the central does not become ready in time. A rollout is paused automatically when the ratio of failed
+// upgrades exceeds its failure threshold.
+//
+//go:generate moq -out central_upgrade_moq.go . CentralUpgradeService
+type CentralUpgradeService interface {
+	// StartRollout starts a rollout of the versions of the given rollout. Only one rollout can be active at a time.
+	StartRollout(ctx context.Context, rollout *dbapi.CentralUpgradeRollout) *errors.ServiceError
+	// Get returns the rollout with the given ID.
+	Get(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError)
+	// PauseRollout stops starting new waves of a running rollout. Upgrades which were started already continue.
+	PauseRollout(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError)
+	// ResumeRollout continues a paused rollout.
+	ResumeRollout(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError)
+	// AbortRollout stops an active rollout for good. Centrals which were upgraded already keep their desired versions.
+	AbortRollout(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError)
+	// GetActiveRollout returns the running or paused rollout, or nil if there is none.
+	GetActiveRollout() (*dbapi.CentralUpgradeRollout, *errors.ServiceError)
+	// ReconcileRollout checks the health of the upgrades of a running rollout and starts its next wave once the
+	// current wave is done.
+	ReconcileRollout(rollout *dbapi.CentralUpgradeRollout) *errors.ServiceError
+	// CountUpgrades returns the number of centrals upgraded by a rollout per upgrade status.
+	CountUpgrades(rolloutID string) (map[constants.CentralUpgradeStatus]int, *errors.ServiceError)
+}
+
+var _ CentralUpgradeService = &centralUpgradeService{}
+
+type centralUpgradeService struct {
+	connectionFactory *db.ConnectionFactory
+	upgradeConfig     *config.CentralUpgradeConfig
+	clusterService    ClusterService
+}
+
+// NewCentralUpgradeService ...
+func NewCentralUpgradeService(connectionFactory *db.ConnectionFactory, centralConfig *config.CentralConfig, clusterService ClusterService) CentralUpgradeService {
+	return &centralUpgradeService{
+		connectionFactory: connectionFactory,
+		upgradeConfig:     centralConfig.Upgrade,
+		clusterService:    clusterService,
+	}
+}
+
+// StartRollout ...
+func (u *centralUpgradeService) StartRollout(ctx context.Context, rollout *dbapi.CentralUpgradeRollout) *errors.ServiceError {
+	if !auth.GetIsAdminFromContext(ctx) {
+		return errors.New(errors.ErrorUnauthenticated, "User not authenticated")
+	}
+	if rollout.CentralVersion == "" || rollout.CentralOperatorVersion == "" {
+		return errors.BadRequest("central version and central operator version are required")
+	}
+
+	waves, err := rollout.GetWaves()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorBadRequest, err, "invalid waves")
+	}
+	if len(waves) == 0 {
+		waves = u.upgradeConfig.DefaultWaves
+	}
+	if err := validateWaves(waves); err != nil {
+		return errors.NewWithCause(errors.ErrorBadRequest, err, "invalid waves %v", waves)
+	}
+	if err := rollout.SetWaves(waves); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to set waves")
+	}
+	if rollout.FailureThreshold == nil {
+		defaultFailureThreshold := u.upgradeConfig.DefaultFailureThreshold
+		rollout.FailureThreshold = &defaultFailureThreshold
+	}
+	if *rollout.FailureThreshold < 0 || *rollout.FailureThreshold > 1 {
+		return errors.BadRequest("failure threshold %v is not between 0 and 1", *rollout.FailureThreshold)
+	}
+
+	clusterIDs, svcErr := u.findClustersWithReadyVersions(rollout)
+	if svcErr != nil {
+		return svcErr
+	}
+	if len(clusterIDs) == 0 {
+		return errors.BadRequest("central operator version %s with central version %s is not ready on any cluster",
+			rollout.CentralOperatorVersion, rollout.CentralVersion)
+	}
+
+	active, svcErr := u.GetActiveRollout()
+	if svcErr != nil {
+		return svcErr
+	}
+	if active != nil {
+		return errors.Conflict("rollout %s of central version %s is still %s", active.ID, active.CentralVersion, active.Status)
+	}
+
+	rollout.Status = constants.CentralUpgradeRolloutStatusRunning.String()
+	rollout.CurrentWave = 0
+	dbConn := u.connectionFactory.New()
+	if err := dbConn.Create(rollout).Error; err != nil {
+		return services.HandleCreateError("CentralUpgradeRollout", err)
+	}
+
+	glog.Infof("Started rollout %s of central version %s and central operator version %s in waves %v", rollout.ID, rollout.CentralVersion, rollout.CentralOperatorVersion, waves)
+	return nil
+}
+
+// Get ...
+func (u *centralUpgradeService) Get(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	if !auth.GetIsAdminFromContext(ctx) {
+		return nil, errors.New(errors.ErrorUnauthenticated, "User not authenticated")
+	}
+	return u.getByID(id)
+}
+
+// PauseRollout ...
+func (u *centralUpgradeService) PauseRollout(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	rollout, svcErr := u.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if rollout.Status != constants.CentralUpgradeRolloutStatusRunning.String() {
+		return nil, errors.Conflict("rollout %s can not be paused in status %s", rollout.ID, rollout.Status)
+	}
+	if svcErr := u.pause(rollout, "paused by admin"); svcErr != nil {
+		return nil, svcErr
+	}
+	return rollout, nil
+}
+
+// ResumeRollout ...
+func (u *centralUpgradeService) ResumeRollout(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	rollout, svcErr := u.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if rollout.Status != constants.CentralUpgradeRolloutStatusPaused.String() {
+		return nil, errors.Conflict("rollout %s can not be resumed in status %s", rollout.ID, rollout.Status)
+	}
+	if svcErr := u.updateRollout(rollout, map[string]interface{}{
+		"status": constants.CentralUpgradeRolloutStatusRunning.String(),
+	}); svcErr != nil {
+		return nil, svcErr
+	}
+	glog.Infof("Resumed rollout %s of central version %s", rollout.ID, rollout.CentralVersion)
+	return rollout, nil
+}
+
+// AbortRollout ...
+func (u *centralUpgradeService) AbortRollout(ctx context.Context, id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	rollout, svcErr := u.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !constants.CentralUpgradeRolloutStatus(rollout.Status).IsActive() {
+		return nil, errors.Conflict("rollout %s can not be aborted in status %s", rollout.ID, rollout.Status)
+	}
+	now := time.Now()
+	if svcErr := u.updateRollout(rollout, map[string]interface{}{
+		"status":       constants.CentralUpgradeRolloutStatusAborted.String(),
+		"completed_at": &now,
+	}); svcErr != nil {
+		return nil, svcErr
+	}
+	glog.Infof("Aborted rollout %s of central version %s", rollout.ID, rollout.CentralVersion)
+	return rollout, nil
+}
+
+// GetActiveRollout ...
+func (u *centralUpgradeService) GetActiveRollout() (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	dbConn := u.connectionFactory.New()
+	var rollout dbapi.CentralUpgradeRollout
+	activeStatuses := []string{constants.CentralUpgradeRolloutStatusRunning.String(), constants.CentralUpgradeRolloutStatusPaused.String()}
+	if err := dbConn.Where("status IN (?)", activeStatuses).Order("created_at desc").First(&rollout).Error; err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get active rollout")
+	}
+	return &rollout, nil
+}
+
+// ReconcileRollout ...
+func (u *centralUpgradeService) ReconcileRollout(rollout *dbapi.CentralUpgradeRollout) *errors.ServiceError {
+	if rollout.Status != constants.CentralUpgradeRolloutStatusRunning.String() {
+		return nil
+	}
+
+	if svcErr := u.failTimedOutUpgrades(rollout.ID); svcErr != nil {
+		return svcErr
+	}
+	counts, svcErr := u.CountUpgrades(rollout.ID)
+	if svcErr != nil {
+		return svcErr
+	}
+	failureThreshold := u.upgradeConfig.DefaultFailureThreshold
+	if rollout.FailureThreshold != nil {
+		failureThreshold = *rollout.FailureThreshold
+	}
+	if ratio := failureRatio(counts); ratio > failureThreshold {
+		reason := fmt.Sprintf("%d of %d upgrades failed, exceeding the failure threshold of %.0f%%",
+			counts[constants.CentralUpgradeStatusFailed], totalUpgrades(counts), failureThreshold*100)
+		glog.Warningf("Pausing rollout %s of central version %s: %s", rollout.ID, rollout.CentralVersion, reason)
+		return u.pause(rollout, reason)
+	}
+	if counts[constants.CentralUpgradeStatusUpgrading] > 0 {
+		glog.V(5).Infof("Wave %d of rollout %s has %d upgrades in progress", rollout.CurrentWave, rollout.ID, counts[constants.CentralUpgradeStatusUpgrading])
+		return nil
+	}
+
+	waves, err := rollout.GetWaves()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get waves of rollout %s", rollout.ID)
+	}
+	wave := rollout.CurrentWave
+	if rollout.WaveStartedAt != nil {
+		// The current wave is done.
+		wave++
+	}
+	if wave > len(waves) {
+		now := time.Now()
+		glog.Infof("Completed rollout %s of central version %s", rollout.ID, rollout.CentralVersion)
+		return u.updateRollout(rollout, map[string]interface{}{
+			"status":       constants.CentralUpgradeRolloutStatusCompleted.String(),
+			"completed_at": &now,
+		})
+	}
+	return u.startWave(rollout, wave, waves)
+}
+
+// CountUpgrades ...
+func (u *centralUpgradeService) CountUpgrades(rolloutID string) (map[constants.CentralUpgradeStatus]int, *errors.ServiceError) {
+	dbConn := u.connectionFactory.New()
+	var results []struct {
+		UpgradeStatus string
+		Count         int
+	}
+	if err := dbConn.Model(&dbapi.CentralRequest{}).
+		Select("upgrade_status, count(1) as count").
+		Where("upgrade_rollout_id = ?", rolloutID).
+		Group("upgrade_status").
+		Scan(&results).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count upgrades of rollout %s", rolloutID)
+	}
+
+	counts := map[constants.CentralUpgradeStatus]int{}
+	for _, result := range results {
+		counts[constants.CentralUpgradeStatus(result.UpgradeStatus)] = result.Count
+	}
+	return counts, nil
+}
+
+// startWave sets the desired versions of the centrals upgraded by the given wave. Wave 0 upgrades the centrals of the
+// canary organisations, wave i > 0 upgrades centrals until waves[i-1] percent of the fleet are upgraded. The fleet
+// consists of the centrals on clusters on which the versions of the rollout are ready.
+func (u *centralUpgradeService) startWave(rollout *dbapi.CentralUpgradeRollout, wave int, waves []int) *errors.ServiceError {
+	clusterIDs, svcErr := u.findClustersWithReadyVersions(rollout)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	dbConn := u.connectionFactory.New()
+	candidates := dbConn.Model(&dbapi.CentralRequest{}).
+		Where("status = ?", constants.CentralRequestStatusReady.String()).
+		Where("cluster_id IN (?)", clusterIDs).
+		Where("upgrade_rollout_id IS NULL OR upgrade_rollout_id <> ?", rollout.ID)
+
+	if len(clusterIDs) == 0 {
+		glog.Warningf("Versions of rollout %s are not ready on any cluster, skipping wave %d", rollout.ID, wave)
+		candidates = nil
+	} else if wave == 0 {
+		if len(u.upgradeConfig.CanaryOrganisations) == 0 {
+			glog.Infof("No canary organisations configured, skipping canary wave of rollout %s", rollout.ID)
+			candidates = nil
+		} else {
+			candidates = candidates.Where("organisation_id IN (?)", u.upgradeConfig.CanaryOrganisations)
+		}
+	} else {
+		var fleetSize, upgraded int64
+		if err := dbConn.Model(&dbapi.CentralRequest{}).
+			Where("(status = ? AND cluster_id IN (?)) OR upgrade_rollout_id = ?", constants.CentralRequestStatusReady.String(), clusterIDs, rollout.ID).
+			Count(&fleetSize).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to count centrals")
+		}
+		if err := dbConn.Model(&dbapi.CentralRequest{}).
+			Where("upgrade_rollout_id = ?", rollout.ID).
+			Count(&upgraded).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to count centrals upgraded by rollout %s", rollout.ID)
+		}
+		limit := waveTargetCount(waves[wave-1], int(fleetSize)) - int(upgraded)
+		if limit <= 0 {
+			candidates = nil
+		} else {
+			candidates = candidates.Order("created_at").Limit(limit)
+		}
+	}
+
+	var ids []string
+	if candidates != nil {
+		if err := candidates.Pluck("id", &ids).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to list centrals for wave %d of rollout %s", wave, rollout.ID)
+		}
+	}
+
+	now := time.Now()
+	if len(ids) > 0 {
+		if err := dbConn.Model(&dbapi.CentralRequest{}).Where("id IN (?)", ids).Updates(map[string]interface{}{
+			"desired_central_version":          rollout.CentralVersion,
+			"desired_central_operator_version": rollout.CentralOperatorVersion,
+			"upgrade_rollout_id":               rollout.ID,
+			"upgrade_status":                   constants.CentralUpgradeStatusUpgrading.String(),
+			"upgrade_started_at":               &now,
+			"resource_version":                 incrementResourceVersion,
+		}).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to upgrade centrals for wave %d of rollout %s", wave, rollout.ID)
+		}
+		for range ids {
+			metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationUpgrade)
+		}
+	}
+
+	glog.Infof("Started wave %d of rollout %s upgrading %d centrals to central version %s", wave, rollout.ID, len(ids), rollout.CentralVersion)
+	return u.updateRollout(rollout, map[string]interface{}{
+		"current_wave":    wave,
+		"wave_started_at": &now,
+	})
+}
+
+// findClustersWithReadyVersions returns the IDs of the clusters on which the central operator version of the rollout is
+// ready and supports the central version of the rollout.
+func (u *centralUpgradeService) findClustersWithReadyVersions(rollout *dbapi.CentralUpgradeRollout) ([]string, *errors.ServiceError) {
+	clusters, svcErr := u.clusterService.FindAllClusters(FindClusterCriteria{})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var clusterIDs []string
+	for _, cluster := range clusters {
+		operatorVersions, err := cluster.GetAvailableAndReadyCentralOperatorVersions()
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get central operator versions of cluster %s", cluster.ClusterID)
+		}
+		if hasCentralVersion(operatorVersions, rollout.CentralOperatorVersion, rollout.CentralVersion) {
+			clusterIDs = append(clusterIDs, cluster.ClusterID)
+		}
+	}
+	return clusterIDs, nil
+}
+
+// failTimedOutUpgrades marks the upgrades of a rollout which did not succeed within the upgrade timeout as failed.
+func (u *centralUpgradeService) failTimedOutUpgrades(rolloutID string) *errors.ServiceError {
+	dbConn := u.connectionFactory.New()
+	result := dbConn.Model(&dbapi.CentralRequest{}).
+		Where("upgrade_rollout_id = ?", rolloutID).
+		Where("upgrade_status = ?", constants.CentralUpgradeStatusUpgrading.String()).
+		Where("upgrade_started_at < ?", time.Now().Add(-u.upgradeConfig.UpgradeTimeout)).
+		Updates(map[string]interface{}{
+			"upgrade_status":   constants.CentralUpgradeStatusFailed.String(),
+			"resource_version": incrementResourceVersion,
+		})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to fail timed out upgrades of rollout %s", rolloutID)
+	}
+	if result.RowsAffected > 0 {
+		glog.Warningf("%d upgrades of rollout %s timed out after %s", result.RowsAffected, rolloutID, u.upgradeConfig.UpgradeTimeout)
+	}
+	return nil
+}
+
+func (u *centralUpgradeService) pause(rollout *dbapi.CentralUpgradeRollout, reason string) *errors.ServiceError {
+	return u.updateRollout(rollout, map[string]interface{}{
+		"status":        constants.CentralUpgradeRolloutStatusPaused.String(),
+		"paused_reason": reason,
+	})
+}
+
+func (u *centralUpgradeService) getByID(id string) (*dbapi.CentralUpgradeRollout, *errors.ServiceError) {
+	dbConn := u.connectionFactory.New()
+	var rollout dbapi.CentralUpgradeRollout
+	if err := dbConn.Where("id = ?", id).First(&rollout).Error; err != nil {
+		return nil, services.HandleGetError("CentralUpgradeRollout", "id", id, err)
+	}
+	return &rollout, nil
+}
+
+func (u *centralUpgradeService) updateRollout(rollout *dbapi.CentralUpgradeRollout, fields map[string]interface{}) *errors.ServiceError {
+	dbConn := u.connectionFactory.New()
+	if err := dbConn.Model(rollout).Updates(fields).Error; err != nil {
+		return services.HandleUpdateError("CentralUpgradeRollout", err)
+	}
+	return nil
+}
+
+func hasCentralVersion(operatorVersions []api.CentralOperatorVersion, operatorVersion, centralVersion string) bool {
+	for _, v := range operatorVersions {
+		if v.Version != operatorVersion {
+			continue
+		}
+		for _, cv := range v.CentralVersions {
+			if cv.Version == centralVersion {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateWaves(waves []int) error {
+	previous := 0
+	for _, wave := range waves {
+		if wave <= previous || wave > 100 {
+			return fmt.Errorf("wave percentages must be increasing and at most 100")
+		}
+		previous = wave
+	}
+	if previous != 100 {
+		return fmt.Errorf("the last wave must upgrade 100 percent")
+	}
+	return nil
+}
+
+// waveTargetCount returns the number of centrals which are upgraded after a wave upgrading percent of the fleet.
+func waveTargetCount(percent int, fleetSize int) int {
+	return int(math.Ceil(float64(percent) * float64(fleetSize) / 100))
+}
+
+func totalUpgrades(counts map[constants.CentralUpgradeStatus]int) int {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
+
+func failureRatio(counts map[constants.CentralUpgradeStatus]int) float64 {
+	total := totalUpgrades(counts)
+	if total == 0 {
+		return 0
+	}
+	return float64(counts[constants.CentralUpgradeStatusFailed]) / float64(total)
+}
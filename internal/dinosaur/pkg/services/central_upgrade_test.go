@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaveTargetCount(t *testing.T) {
+	tt := []struct {
+		description string
+		percent     int
+		fleetSize   int
+		expected    int
+	}{
+		{
+			description: "should round up partial centrals",
+			percent:     10,
+			fleetSize:   15,
+			expected:    2,
+		},
+		{
+			description: "should upgrade at least one central of a small fleet",
+			percent:     10,
+			fleetSize:   1,
+			expected:    1,
+		},
+		{
+			description: "should upgrade the whole fleet in the last wave",
+			percent:     100,
+			fleetSize:   42,
+			expected:    42,
+		},
+		{
+			description: "should upgrade nothing in an empty fleet",
+			percent:     50,
+			fleetSize:   0,
+			expected:    0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, waveTargetCount(tc.percent, tc.fleetSize))
+		})
+	}
+}
+
+func TestFailureRatio(t *testing.T) {
+	tt := []struct {
+		description string
+		counts      map[constants.CentralUpgradeStatus]int
+		expected    float64
+	}{
+		{
+			description: "should be zero without upgrades",
+			counts:      map[constants.CentralUpgradeStatus]int{},
+			expected:    0,
+		},
+		{
+			description: "should count failures of all upgrades",
+			counts: map[constants.CentralUpgradeStatus]int{
+				constants.CentralUpgradeStatusUpgrading: 1,
+				constants.CentralUpgradeStatusSucceeded: 2,
+				constants.CentralUpgradeStatusFailed:    1,
+			},
+			expected: 0.25,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, failureRatio(tc.counts))
+		})
+	}
+}
+
+func TestValidateWaves(t *testing.T) {
+	tt := []struct {
+		description string
+		waves       []int
+		wantErr     bool
+	}{
+		{
+			description: "should accept increasing waves up to 100 percent",
+			waves:       []int{10, 50, 100},
+		},
+		{
+			description: "should reject decreasing waves",
+			waves:       []int{50, 10, 100},
+			wantErr:     true,
+		},
+		{
+			description: "should reject waves not upgrading the whole fleet",
+			waves:       []int{10, 50},
+			wantErr:     true,
+		},
+		{
+			description: "should reject waves above 100 percent",
+			waves:       []int{10, 150},
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			err := validateWaves(tc.waves)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func readyClusters(t *testing.T) *ClusterServiceMock {
+	cluster := api.Cluster{ClusterID: "cluster-ready"}
+	require.NoError(t, cluster.SetAvailableCentralOperatorVersions([]api.CentralOperatorVersion{
+		{Version: "3.74.0", Ready: true, CentralVersions: []api.CentralVersion{{Version: "3.74.0"}}},
+	}))
+	return &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+			return []*api.Cluster{{ClusterID: "cluster-old"}, &cluster}, nil
+		},
+	}
+}
+
+func TestCentralUpgradeServiceStartRollout(t *testing.T) {
+	zero := 0.0
+	tooHigh := 1.5
+	tt := []struct {
+		description              string
+		rollout                  dbapi.CentralUpgradeRollout
+		expectedCode             serviceErrors.ServiceErrorCode
+		expectedFailureThreshold float64
+	}{
+		{
+			description:              "should apply the default failure threshold",
+			rollout:                  dbapi.CentralUpgradeRollout{CentralVersion: "3.74.0", CentralOperatorVersion: "3.74.0"},
+			expectedFailureThreshold: 0.1,
+		},
+		{
+			description:              "should accept a failure threshold of zero",
+			rollout:                  dbapi.CentralUpgradeRollout{CentralVersion: "3.74.0", CentralOperatorVersion: "3.74.0", FailureThreshold: &zero},
+			expectedFailureThreshold: 0,
+		},
+		{
+			description:  "should reject failure thresholds above one",
+			rollout:      dbapi.CentralUpgradeRollout{CentralVersion: "3.74.0", CentralOperatorVersion: "3.74.0", FailureThreshold: &tooHigh},
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+		{
+			description:  "should reject versions which are not ready on any cluster",
+			rollout:      dbapi.CentralUpgradeRollout{CentralVersion: "3.75.0", CentralOperatorVersion: "3.74.0"},
+			expectedCode: serviceErrors.ErrorBadRequest,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "central_upgrade_rollouts"`).WithReply([]map[string]interface{}{})
+			created := false
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "central_upgrade_rollouts"`).WithCallback(func(string, []driver.NamedValue) {
+				created = true
+			})
+
+			u := NewCentralUpgradeService(db.NewMockConnectionFactory(nil), config.NewCentralConfig(), readyClusters(t))
+			rollout := tc.rollout
+			err := u.StartRollout(auth.SetIsAdminContext(context.Background(), true), &rollout)
+			if tc.expectedCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.expectedCode, err.Code)
+				assert.False(t, created)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, created)
+			assert.Equal(t, constants.CentralUpgradeRolloutStatusRunning.String(), rollout.Status)
+			require.NotNil(t, rollout.FailureThreshold)
+			assert.Equal(t, tc.expectedFailureThreshold, *rollout.FailureThreshold)
+		})
+	}
+}
+
+func TestCentralUpgradeServiceReconcileRollout(t *testing.T) {
+	now := time.Now()
+	tt := []struct {
+		description    string
+		currentWave    int
+		waveStartedAt  *time.Time
+		counts         []map[string]interface{}
+		expectedStatus string
+		expectedWave   interface{}
+	}{
+		{
+			description: "should pause the rollout when too many upgrades failed",
+			currentWave: 1,
+			counts: []map[string]interface{}{
+				{"upgrade_status": constants.CentralUpgradeStatusSucceeded.String(), "count": 3},
+				{"upgrade_status": constants.CentralUpgradeStatusFailed.String(), "count": 1},
+			},
+			waveStartedAt:  &now,
+			expectedStatus: constants.CentralUpgradeRolloutStatusPaused.String(),
+		},
+		{
+			description: "should wait for upgrades in progress",
+			currentWave: 1,
+			counts: []map[string]interface{}{
+				{"upgrade_status": constants.CentralUpgradeStatusUpgrading.String(), "count": 1},
+			},
+			waveStartedAt: &now,
+		},
+		{
+			description:  "should start the canary wave",
+			expectedWave: int64(0),
+		},
+		{
+			description: "should start the next wave once the current wave is done",
+			currentWave: 1,
+			counts: []map[string]interface{}{
+				{"upgrade_status": constants.CentralUpgradeStatusSucceeded.String(), "count": 1},
+			},
+			waveStartedAt: &now,
+			expectedWave:  int64(2),
+		},
+		{
+			description: "should complete the rollout after the last wave",
+			currentWave: 2,
+			counts: []map[string]interface{}{
+				{"upgrade_status": constants.CentralUpgradeStatusSucceeded.String(), "count": 4},
+			},
+			waveStartedAt:  &now,
+			expectedStatus: constants.CentralUpgradeRolloutStatusCompleted.String(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT upgrade_status, count(1) as count`).WithReply(tc.counts)
+			var fleetSizeQuery string
+			mocket.Catcher.NewMock().WithQuery(`SELECT count(*)`).WithReply([]map[string]interface{}{{"count": 10}}).OneTime().
+				WithCallback(func(query string, _ []driver.NamedValue) {
+					fleetSizeQuery = query
+				})
+			mocket.Catcher.NewMock().WithQuery(`SELECT count(*)`).WithReply([]map[string]interface{}{{"count": 1}})
+			var candidatesQuery string
+			var candidatesArgs []driver.NamedValue
+			mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "central_requests"`).WithCallback(func(query string, args []driver.NamedValue) {
+				candidatesQuery = query
+				candidatesArgs = args
+			})
+			var rolloutUpdate []driver.NamedValue
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "central_upgrade_rollouts"`).WithCallback(func(_ string, args []driver.NamedValue) {
+				rolloutUpdate = args
+			})
+
+			upgradeConfig := config.NewCentralConfig()
+			upgradeConfig.Upgrade.CanaryOrganisations = []string{"internal"}
+			u := NewCentralUpgradeService(db.NewMockConnectionFactory(nil), upgradeConfig, readyClusters(t))
+			failureThreshold := 0.2
+			rollout := &dbapi.CentralUpgradeRollout{
+				Meta:                   api.Meta{ID: "rollout-id"},
+				CentralVersion:         "3.74.0",
+				CentralOperatorVersion: "3.74.0",
+				Status:                 constants.CentralUpgradeRolloutStatusRunning.String(),
+				CurrentWave:            tc.currentWave,
+				WaveStartedAt:          tc.waveStartedAt,
+				FailureThreshold:       &failureThreshold,
+			}
+			require.NoError(t, rollout.SetWaves([]int{50, 100}))
+
+			require.Nil(t, u.ReconcileRollout(rollout))
+
+			if tc.expectedWave == nil {
+				assert.Empty(t, candidatesQuery)
+			} else {
+				assert.Contains(t, candidatesQuery, "cluster_id IN ($2)")
+				require.Greater(t, len(candidatesArgs), 1)
+				assert.Equal(t, "cluster-ready", candidatesArgs[1].Value)
+				require.NotNil(t, rolloutUpdate)
+				assert.Equal(t, tc.expectedWave, rolloutUpdate[0].Value)
+			}
+			if tc.expectedWave != nil && tc.expectedWave != int64(0) {
+				assert.Contains(t, fleetSizeQuery, "cluster_id IN ($2)")
+			}
+			if tc.expectedStatus == "" {
+				if tc.expectedWave == nil {
+					assert.Nil(t, rolloutUpdate)
+				}
+				return
+			}
+			var values []interface{}
+			for _, arg := range rolloutUpdate {
+				values = append(values, arg.Value)
+			}
+			assert.Contains(t, values, tc.expectedStatus)
+		})
+	}
+}
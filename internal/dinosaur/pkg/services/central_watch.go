@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+)
+
+const (
+	// DefaultCentralWatchTimeout is the time a watch waits for changes if the client does not request a timeout.
+	DefaultCentralWatchTimeout = 30 * time.Second
+	// MaxCentralWatchTimeout is the maximum time a watch waits for changes.
+	MaxCentralWatchTimeout = 60 * time.Second
+
+	// defaultCentralWatchResyncInterval is the interval in which a watch lists the centrals even without a change
+	// notification, so that a watch does not miss changes while notifications are not delivered.
+	defaultCentralWatchResyncInterval = 10 * time.Second
+)
+
+// CentralWatchResult contains the centrals of a data plane cluster which changed since the requested resource version.
+type CentralWatchResult struct {
+	// ResourceVersion identifies the current state of the centrals of the data plane cluster.
+	ResourceVersion string
+	// Changed are the centrals which changed since the requested resource version.
+	Changed []*dbapi.CentralRequest
+	// CentralIDs are the IDs of all centrals of the data plane cluster.
+	CentralIDs []string
+}
+
+// CentralWatchService waits for changes of the centrals assigned to a data plane cluster.
+//
+// The resource version of a data plane cluster consists of the latest update time of its centrals and a hash of the
+// IDs of its centrals, so that added, updated and removed centrals all change the resource version. A watch lists the
+// centrals of the cluster whenever the CentralChangeNotifier reports a change, instead of polling the database.
+type CentralWatchService interface {
+	// WatchByClusterID blocks until the centrals of the cluster differ from the given resource version, the timeout
+	// expires or the context is cancelled. An empty resource version returns all centrals immediately.
+	WatchByClusterID(ctx context.Context, clusterID string, resourceVersion string, timeout time.Duration) (*CentralWatchResult, *errors.ServiceError)
+}
+
+var _ CentralWatchService = &centralWatchService{}
+
+type centralWatchService struct {
+	dinosaurService DinosaurService
+	notifier        CentralChangeNotifier
+	resyncInterval  time.Duration
+}
+
+// NewCentralWatchService ...
+func NewCentralWatchService(dinosaurService DinosaurService, notifier CentralChangeNotifier) CentralWatchService {
+	return &centralWatchService{
+		dinosaurService: dinosaurService,
+		notifier:        notifier,
+		resyncInterval:  defaultCentralWatchResyncInterval,
+	}
+}
+
+// WatchByClusterID ...
+func (s *centralWatchService) WatchByClusterID(ctx context.Context, clusterID string, resourceVersion string, timeout time.Duration) (*CentralWatchResult, *errors.ServiceError) {
+	var since time.Time
+	if resourceVersion != "" {
+		var err error
+		if since, err = parseCentralResourceVersion(resourceVersion); err != nil {
+			return nil, errors.BadRequest("invalid resource version %q: %v", resourceVersion, err)
+		}
+	}
+
+	// Subscribe before listing the centrals, so that no change between listing and waiting is missed.
+	changes, unsubscribe := s.notifier.Subscribe(clusterID)
+	defer unsubscribe()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(s.resyncInterval)
+	defer ticker.Stop()
+
+	for {
+		centrals, svcErr := s.dinosaurService.ListByClusterID(clusterID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		currentVersion := centralResourceVersion(centrals)
+		if currentVersion != resourceVersion {
+			return newCentralWatchResult(centrals, currentVersion, since), nil
+		}
+
+		select {
+		case <-changes:
+		case <-ticker.C:
+		case <-deadline.C:
+			return newCentralWatchResult(centrals, currentVersion, since), nil
+		case <-ctx.Done():
+			return nil, errors.GeneralError("watch for centrals of cluster %s cancelled: %v", clusterID, ctx.Err())
+		}
+	}
+}
+
+func newCentralWatchResult(centrals []*dbapi.CentralRequest, resourceVersion string, since time.Time) *CentralWatchResult {
+	result := &CentralWatchResult{
+		ResourceVersion: resourceVersion,
+		Changed:         []*dbapi.CentralRequest{},
+		CentralIDs:      make([]string, 0, len(centrals)),
+	}
+	for _, central := range centrals {
+		result.CentralIDs = append(result.CentralIDs, central.ID)
+		if central.UpdatedAt.After(since) {
+			result.Changed = append(result.Changed, central)
+		}
+	}
+	return result
+}
+
+// centralResourceVersion returns the resource version of the given centrals in the format
+// <latest update time in unix nanoseconds>-<hash of the sorted central IDs>.
+func centralResourceVersion(centrals []*dbapi.CentralRequest) string {
+	var latest int64
+	ids := make([]string, 0, len(centrals))
+	for _, central := range centrals {
+		if updatedAt := central.UpdatedAt.UnixNano(); updatedAt > latest {
+			latest = updatedAt
+		}
+		ids = append(ids, central.ID)
+	}
+	sort.Strings(ids)
+
+	hash := fnv.New64a()
+	for _, id := range ids {
+		_, _ = hash.Write([]byte(id))
+		_, _ = hash.Write([]byte{0})
+	}
+	return fmt.Sprintf("%d-%x", latest, hash.Sum64())
+}
+
+func parseCentralResourceVersion(resourceVersion string) (time.Time, error) {
+	parts := strings.SplitN(resourceVersion, "-", 2)
+	if len(parts) != 2 {
+		return time.Time{}, fmt.Errorf("expected format <timestamp>-<hash>")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing timestamp: %w", err)
+	}
+	return time.Unix(0, nanos), nil
+}
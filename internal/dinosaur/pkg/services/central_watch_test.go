@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func buildWatchedCentral(id string, updatedAt time.Time) *dbapi.CentralRequest {
+	return &dbapi.CentralRequest{
+		Meta: api.Meta{
+			ID:        id,
+			UpdatedAt: updatedAt,
+		},
+	}
+}
+
+// fakeCentralChangeNotifier notifies the subscribers of all clusters on each value sent to changes.
+type fakeCentralChangeNotifier struct {
+	changes chan struct{}
+}
+
+func (n *fakeCentralChangeNotifier) Subscribe(clusterID string) (<-chan struct{}, func()) {
+	return n.changes, func() {}
+}
+
+func TestCentralWatchService_WatchByClusterID(t *testing.T) {
+	now := time.Now()
+	unchanged := []*dbapi.CentralRequest{
+		buildWatchedCentral("central-1", now.Add(-time.Hour)),
+		buildWatchedCentral("central-2", now.Add(-time.Minute)),
+	}
+	unchangedVersion := centralResourceVersion(unchanged)
+
+	tt := []struct {
+		description     string
+		listed          [][]*dbapi.CentralRequest
+		resourceVersion string
+		expectedChanged []string
+		expectedIDs     []string
+		expectedError   bool
+	}{
+		{
+			description:     "should return all centrals without resource version",
+			listed:          [][]*dbapi.CentralRequest{unchanged},
+			expectedChanged: []string{"central-1", "central-2"},
+			expectedIDs:     []string{"central-1", "central-2"},
+		},
+		{
+			description:     "should return no centrals if nothing changed until the timeout",
+			listed:          [][]*dbapi.CentralRequest{unchanged},
+			resourceVersion: unchangedVersion,
+			expectedChanged: []string{},
+			expectedIDs:     []string{"central-1", "central-2"},
+		},
+		{
+			description: "should return updated centrals",
+			listed: [][]*dbapi.CentralRequest{
+				unchanged,
+				{
+					buildWatchedCentral("central-1", now.Add(-time.Hour)),
+					buildWatchedCentral("central-2", now),
+				},
+			},
+			resourceVersion: unchangedVersion,
+			expectedChanged: []string{"central-2"},
+			expectedIDs:     []string{"central-1", "central-2"},
+		},
+		{
+			description: "should return removed centrals by their absence in the central IDs",
+			listed: [][]*dbapi.CentralRequest{
+				{
+					buildWatchedCentral("central-1", now.Add(-time.Hour)),
+				},
+			},
+			resourceVersion: unchangedVersion,
+			expectedChanged: []string{},
+			expectedIDs:     []string{"central-1"},
+		},
+		{
+			description:     "should return error for invalid resource version",
+			listed:          [][]*dbapi.CentralRequest{unchanged},
+			resourceVersion: "invalid",
+			expectedError:   true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			calls := 0
+			service := &centralWatchService{
+				dinosaurService: &DinosaurServiceMock{
+					ListByClusterIDFunc: func(clusterID string) ([]*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+						listed := tc.listed[calls]
+						if calls < len(tc.listed)-1 {
+							calls++
+						}
+						return listed, nil
+					},
+				},
+				notifier:       &fakeCentralChangeNotifier{},
+				resyncInterval: time.Millisecond,
+			}
+
+			result, err := service.WatchByClusterID(context.Background(), "cluster-1", tc.resourceVersion, 50*time.Millisecond)
+			if tc.expectedError {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+
+			changed := []string{}
+			for _, central := range result.Changed {
+				changed = append(changed, central.ID)
+			}
+			require.Equal(t, tc.expectedChanged, changed)
+			require.Equal(t, tc.expectedIDs, result.CentralIDs)
+			require.Equal(t, centralResourceVersion(tc.listed[len(tc.listed)-1]), result.ResourceVersion)
+		})
+	}
+}
+
+func TestCentralWatchService_WatchByClusterIDNotified(t *testing.T) {
+	now := time.Now()
+	unchanged := []*dbapi.CentralRequest{buildWatchedCentral("central-1", now.Add(-time.Hour))}
+	updated := []*dbapi.CentralRequest{buildWatchedCentral("central-1", now)}
+
+	notifier := &fakeCentralChangeNotifier{changes: make(chan struct{}, 1)}
+	calls := 0
+	service := &centralWatchService{
+		dinosaurService: &DinosaurServiceMock{
+			ListByClusterIDFunc: func(clusterID string) ([]*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+				calls++
+				if calls == 1 {
+					notifier.changes <- struct{}{}
+					return unchanged, nil
+				}
+				return updated, nil
+			},
+		},
+		notifier:       notifier,
+		resyncInterval: time.Hour,
+	}
+
+	result, err := service.WatchByClusterID(context.Background(), "cluster-1", centralResourceVersion(unchanged), time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, 2, calls, "centrals must be listed again once a change is notified")
+	require.Len(t, result.Changed, 1)
+	require.Equal(t, centralResourceVersion(updated), result.ResourceVersion)
+}
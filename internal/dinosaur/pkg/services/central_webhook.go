@@ -0,0 +1,425 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+const (
+	// CentralWebhookSignatureHeader is the header of the HMAC-SHA256 signature of the body of a webhook delivery
+	CentralWebhookSignatureHeader = "X-Fleet-Manager-Signature"
+
+	cloudEventSpecVersion  = "1.0"
+	cloudEventSource       = "/api/rhacs/v1/centrals"
+	cloudEventTypePrefix   = "com.redhat.rhacs.central."
+	cloudEventsContentType = "application/cloudevents+json; charset=utf-8"
+)
+
+// centralCloudEventTypes maps the statuses of centrals whose changes are delivered to webhooks to the suffixes of the
+// CloudEvent types delivered.
+var centralCloudEventTypes = map[string]string{
+	constants.CentralRequestStatusAccepted.String():     "accepted",
+	constants.CentralRequestStatusProvisioning.String(): "provisioning",
+	constants.CentralRequestStatusReady.String():        "ready",
+	constants.CentralRequestStatusFailed.String():       "failed",
+	constants.CentralRequestStatusDeprovision.String():  "deprovision",
+	constants.CentralRequestStatusSuspended.String():    "suspended",
+	constants.CentralRequestStatusResuming.String():     "resuming",
+}
+
+// CentralWebhookService manages the webhook endpoints of organisations and the delivery of the lifecycle events of
+// their centrals to the endpoints.
+//
+// Events are written to an outbox of deliveries in the transaction recording them and delivered asynchronously by the
+// CentralWebhookManager as CloudEvents in structured JSON mode. Failed deliveries are retried with exponential backoff.
+// Endpoints are only called on public addresses, and delivered and failed deliveries are pruned after a retention
+// period.
+//
+//go:generate moq -out central_webhook_moq.go . CentralWebhookService
+type CentralWebhookService interface {
+	// Create registers a webhook for the organisation of the user authenticated for the request.
+	Create(ctx context.Context, webhook *dbapi.CentralWebhook) *errors.ServiceError
+	// List returns the webhooks of the organisation of the user authenticated for the request.
+	List(ctx context.Context) (dbapi.CentralWebhookList, *errors.ServiceError)
+	// Delete removes a webhook of the organisation of the user authenticated for the request together with its
+	// pending deliveries.
+	Delete(ctx context.Context, id string) *errors.ServiceError
+	// EnqueueDeliveries adds deliveries of a central event to the outbox of every webhook of the organisation of the
+	// central within the transaction recording the event. Events which are not delivered to webhooks are ignored.
+	EnqueueDeliveries(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError
+	// ListDueDeliveries returns the pending deliveries whose next attempt is due, oldest first.
+	ListDueDeliveries() (dbapi.CentralWebhookDeliveryList, *errors.ServiceError)
+	// Deliver attempts to deliver an event to its webhook and updates the delivery with the outcome of the attempt.
+	Deliver(delivery *dbapi.CentralWebhookDelivery) *errors.ServiceError
+	// PruneDeliveries removes the delivered and failed deliveries whose last attempt is older than the retention period.
+	PruneDeliveries() *errors.ServiceError
+}
+
+var _ CentralWebhookService = &centralWebhookService{}
+
+type centralWebhookService struct {
+	connectionFactory *db.ConnectionFactory
+	webhookConfig     *config.CentralWebhookConfig
+	httpClient        *http.Client
+}
+
+// NewCentralWebhookService ...
+func NewCentralWebhookService(connectionFactory *db.ConnectionFactory, centralConfig *config.CentralConfig) CentralWebhookService {
+	return &centralWebhookService{
+		connectionFactory: connectionFactory,
+		webhookConfig:     centralConfig.Webhook,
+		httpClient:        newCentralWebhookHTTPClient(centralConfig.Webhook),
+	}
+}
+
+// newCentralWebhookHTTPClient returns the client delivering events to webhook endpoints. The endpoints are registered
+// by tenants, so the client only connects to public addresses, unless private addresses are allowed e.g. for a local
+// stand-in during development. The addresses are checked when connecting, so that the check applies to every address
+// the host name resolves to at the time of the delivery. Redirects are not followed.
+func newCentralWebhookHTTPClient(webhookConfig *config.CentralWebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: webhookConfig.DeliveryTimeout}
+	if !webhookConfig.AllowPrivateAddresses {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return fmt.Errorf("parsing address %s: %w", address, err)
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("webhook address %s is not public", host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   webhookConfig.DeliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return fmt.Errorf("webhook redirected to %s, redirects are not followed", req.URL.Redacted())
+		},
+	}
+}
+
+// isPublicIP returns false for loopback, private, link-local, unspecified and multicast addresses.
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// Create ...
+func (w *centralWebhookService) Create(ctx context.Context, webhook *dbapi.CentralWebhook) *errors.ServiceError {
+	orgID, owner, err := getWebhookOrganisation(ctx)
+	if err != nil {
+		return err
+	}
+	webhook.OrganisationID = orgID
+	webhook.Owner = owner
+
+	dbConn := w.connectionFactory.New()
+	if err := dbConn.Create(webhook).Error; err != nil {
+		return services.HandleCreateError("CentralWebhook", err)
+	}
+	return nil
+}
+
+// List ...
+func (w *centralWebhookService) List(ctx context.Context) (dbapi.CentralWebhookList, *errors.ServiceError) {
+	orgID, _, err := getWebhookOrganisation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbConn := w.connectionFactory.New()
+	var webhooks dbapi.CentralWebhookList
+	if err := dbConn.Where("organisation_id = ?", orgID).Order("created_at").Find(&webhooks).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list webhooks of organisation %s", orgID)
+	}
+	return webhooks, nil
+}
+
+// Delete ...
+func (w *centralWebhookService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	if id == "" {
+		return errors.Validation("id is undefined")
+	}
+	orgID, _, err := getWebhookOrganisation(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbConn := w.connectionFactory.New()
+	var webhook dbapi.CentralWebhook
+	if err := dbConn.Where("id = ? AND organisation_id = ?", id, orgID).First(&webhook).Error; err != nil {
+		return services.HandleGetError("CentralWebhook", "id", id, err)
+	}
+	if err := dbConn.Where("webhook_id = ? AND status = ?", id, constants.CentralWebhookDeliveryStatusPending.String()).
+		Delete(&dbapi.CentralWebhookDelivery{}).Error; err != nil {
+		return services.HandleDeleteError("CentralWebhookDelivery", "webhook_id", id, err)
+	}
+	if err := dbConn.Delete(&webhook).Error; err != nil {
+		return services.HandleDeleteError("CentralWebhook", "id", id, err)
+	}
+	return nil
+}
+
+// EnqueueDeliveries ...
+func (w *centralWebhookService) EnqueueDeliveries(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError {
+	eventType, ok := centralCloudEventType(event)
+	if !ok {
+		return nil
+	}
+
+	// The central is soft deleted already when its deletion is recorded.
+	var central dbapi.CentralRequest
+	if err := tx.Unscoped().Where("id = ?", event.CentralID).First(&central).Error; err != nil {
+		return services.HandleGetError("CentralResource", "id", event.CentralID, err)
+	}
+	if central.OrganisationID == "" {
+		return nil
+	}
+
+	var webhooks dbapi.CentralWebhookList
+	if err := tx.Where("organisation_id = ?", central.OrganisationID).Find(&webhooks).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to list webhooks of organisation %s", central.OrganisationID)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(newCentralCloudEvent(eventType, event, &central))
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to marshal %s event of central %s", eventType, central.ID)
+	}
+	now := time.Now()
+	for _, webhook := range webhooks {
+		delivery := &dbapi.CentralWebhookDelivery{
+			WebhookID:     webhook.ID,
+			CentralID:     central.ID,
+			EventID:       event.ID,
+			EventType:     eventType,
+			Payload:       payload,
+			Status:        constants.CentralWebhookDeliveryStatusPending.String(),
+			NextAttemptAt: now,
+		}
+		if err := tx.Create(delivery).Error; err != nil {
+			return services.HandleCreateError("CentralWebhookDelivery", err)
+		}
+	}
+	return nil
+}
+
+// ListDueDeliveries ...
+func (w *centralWebhookService) ListDueDeliveries() (dbapi.CentralWebhookDeliveryList, *errors.ServiceError) {
+	dbConn := w.connectionFactory.New()
+	var deliveries dbapi.CentralWebhookDeliveryList
+	if err := dbConn.Where("status = ? AND next_attempt_at <= ?", constants.CentralWebhookDeliveryStatusPending.String(), time.Now()).
+		Order("next_attempt_at").
+		Limit(w.webhookConfig.DeliveryBatchSize).
+		Find(&deliveries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list due webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// Deliver ...
+func (w *centralWebhookService) Deliver(delivery *dbapi.CentralWebhookDelivery) *errors.ServiceError {
+	dbConn := w.connectionFactory.New()
+	var webhook dbapi.CentralWebhook
+	if err := dbConn.Where("id = ?", delivery.WebhookID).First(&webhook).Error; err != nil {
+		if !services.IsRecordNotFoundError(err) {
+			return services.HandleGetError("CentralWebhook", "id", delivery.WebhookID, err)
+		}
+		// The webhook was deleted after the delivery had been listed, there is nothing to retry.
+		delivery.Status = constants.CentralWebhookDeliveryStatusFailed.String()
+		delivery.LastError = fmt.Sprintf("webhook %s has been deleted", delivery.WebhookID)
+		return w.updateDelivery(delivery)
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	if err := postCentralCloudEvent(w.httpClient, webhook.URL, webhook.Secret, delivery.Payload); err != nil {
+		glog.Warningf("Failed to deliver %s event of central %s to webhook %s (attempt %d): %v", delivery.EventType, delivery.CentralID, webhook.ID, delivery.Attempts, err)
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= w.webhookConfig.MaxDeliveryAttempts {
+			delivery.Status = constants.CentralWebhookDeliveryStatusFailed.String()
+		} else {
+			delivery.NextAttemptAt = now.Add(webhookRetryBackoff(w.webhookConfig.InitialBackoff, w.webhookConfig.MaxBackoff, delivery.Attempts))
+		}
+	} else {
+		delivery.Status = constants.CentralWebhookDeliveryStatusDelivered.String()
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	}
+	return w.updateDelivery(delivery)
+}
+
+// PruneDeliveries ...
+func (w *centralWebhookService) PruneDeliveries() *errors.ServiceError {
+	dbConn := w.connectionFactory.New()
+	completedStatuses := []string{constants.CentralWebhookDeliveryStatusDelivered.String(), constants.CentralWebhookDeliveryStatusFailed.String()}
+	result := dbConn.Unscoped().
+		Where("status IN (?) AND updated_at < ?", completedStatuses, time.Now().Add(-w.webhookConfig.DeliveryRetention)).
+		Delete(&dbapi.CentralWebhookDelivery{})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to prune webhook deliveries")
+	}
+	if result.RowsAffected > 0 {
+		glog.Infof("Pruned %d webhook deliveries older than %s", result.RowsAffected, w.webhookConfig.DeliveryRetention)
+	}
+	return nil
+}
+
+func (w *centralWebhookService) updateDelivery(delivery *dbapi.CentralWebhookDelivery) *errors.ServiceError {
+	dbConn := w.connectionFactory.New()
+	if err := dbConn.Model(delivery).Select("status", "attempts", "next_attempt_at", "last_error", "delivered_at", "updated_at").Updates(delivery).Error; err != nil {
+		return services.HandleUpdateError("CentralWebhookDelivery", err)
+	}
+	return nil
+}
+
+func getWebhookOrganisation(ctx context.Context) (string, string, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", "", errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	orgID, _ := claims.GetOrgID()
+	if orgID == "" {
+		return "", "", errors.Forbidden("webhooks can only be managed by users of an organisation")
+	}
+	owner, _ := claims.GetUsername()
+	return orgID, owner, nil
+}
+
+// centralCloudEvent is a CloudEvent in structured JSON mode, see https://github.com/cloudevents/spec.
+type centralCloudEvent struct {
+	SpecVersion     string                `json:"specversion"`
+	ID              string                `json:"id"`
+	Source          string                `json:"source"`
+	Type            string                `json:"type"`
+	Subject         string                `json:"subject"`
+	Time            time.Time             `json:"time"`
+	DataContentType string                `json:"datacontenttype"`
+	Data            centralCloudEventData `json:"data"`
+}
+
+type centralCloudEventData struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	OrganisationID string `json:"organisation_id"`
+	CloudProvider  string `json:"cloud_provider"`
+	Region         string `json:"region"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// centralCloudEventType returns the type of the CloudEvent delivered for a central event, or false if the event is
+// not delivered to webhooks.
+func centralCloudEventType(event *dbapi.CentralEvent) (string, bool) {
+	switch constants.CentralEventType(event.Type) {
+	case constants.CentralEventTypeDeletion:
+		return cloudEventTypePrefix + "deleted", true
+	case constants.CentralEventTypeExpiryWarning:
+		return cloudEventTypePrefix + "expiring", true
+	case constants.CentralEventTypeStatusChange, constants.CentralEventTypeFailure:
+		suffix, ok := centralCloudEventTypes[event.ToStatus]
+		if !ok {
+			return "", false
+		}
+		return cloudEventTypePrefix + suffix, true
+	default:
+		return "", false
+	}
+}
+
+func newCentralCloudEvent(eventType string, event *dbapi.CentralEvent, central *dbapi.CentralRequest) centralCloudEvent {
+	status := event.ToStatus
+	if constants.CentralEventType(event.Type) == constants.CentralEventTypeDeletion {
+		status = "deleted"
+	}
+	return centralCloudEvent{
+		SpecVersion:     cloudEventSpecVersion,
+		ID:              event.ID,
+		Source:          cloudEventSource,
+		Type:            eventType,
+		Subject:         central.ID,
+		Time:            event.CreatedAt,
+		DataContentType: "application/json",
+		Data: centralCloudEventData{
+			ID:             central.ID,
+			Name:           central.Name,
+			OrganisationID: central.OrganisationID,
+			CloudProvider:  central.CloudProvider,
+			Region:         central.Region,
+			Status:         status,
+			PreviousStatus: event.FromStatus,
+			Reason:         event.PublicReason(central.Owner),
+		},
+	}
+}
+
+// signCentralWebhookPayload returns the value of the signature header of a delivery of the given payload.
+func signCentralWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// postCentralCloudEvent POSTs a signed CloudEvent to a webhook endpoint. Any response but 2xx fails the delivery.
+func postCentralCloudEvent(client *http.Client, url string, secret string, payload []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", cloudEventsContentType)
+	req.Header.Set(CentralWebhookSignatureHeader, signCentralWebhookPayload(secret, payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// webhookRetryBackoff returns the time to wait before the next attempt of a delivery which failed the given number of
+// times. The backoff doubles with every attempt up to the maximum.
+func webhookRetryBackoff(initial, max time.Duration, attempts int) time.Duration {
+	backoff := initial
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"crypto/hmac"
+	"database/sql/driver"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralCloudEventType(t *testing.T) {
+	tt := []struct {
+		description string
+		event       *dbapi.CentralEvent
+		expected    string
+		expectedOK  bool
+	}{
+		{
+			description: "should deliver a central becoming ready",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypeStatusChange.String(), ToStatus: constants.CentralRequestStatusReady.String()},
+			expected:    "com.redhat.rhacs.central.ready",
+			expectedOK:  true,
+		},
+		{
+			description: "should deliver a failure",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypeFailure.String(), ToStatus: constants.CentralRequestStatusFailed.String()},
+			expected:    "com.redhat.rhacs.central.failed",
+			expectedOK:  true,
+		},
+		{
+			description: "should deliver a deletion",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypeDeletion.String(), FromStatus: constants.CentralRequestStatusDeleting.String()},
+			expected:    "com.redhat.rhacs.central.deleted",
+			expectedOK:  true,
+		},
+		{
+			description: "should deliver an expiry warning",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypeExpiryWarning.String(), ToStatus: constants.CentralRequestStatusReady.String()},
+			expected:    "com.redhat.rhacs.central.expiring",
+			expectedOK:  true,
+		},
+		{
+			description: "should not deliver a lifespan extension",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypeLifespanExtension.String(), ToStatus: constants.CentralRequestStatusReady.String()},
+		},
+		{
+			description: "should not deliver a change to preparing",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypeStatusChange.String(), ToStatus: constants.CentralRequestStatusPreparing.String()},
+		},
+		{
+			description: "should not deliver a placement",
+			event:       &dbapi.CentralEvent{Type: constants.CentralEventTypePlacement.String(), ToStatus: constants.CentralRequestStatusAccepted.String()},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			eventType, ok := centralCloudEventType(tc.event)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, eventType)
+		})
+	}
+}
+
+func TestPostCentralCloudEvent(t *testing.T) {
+	payload := []byte(`{"specversion":"1.0","type":"com.redhat.rhacs.central.ready"}`)
+	secret := "0123456789abcdef" // pragma: allowlist secret
+
+	tt := []struct {
+		description   string
+		responseCode  int
+		expectedError bool
+	}{
+		{
+			description:  "should succeed when the endpoint accepts the event",
+			responseCode: http.StatusNoContent,
+		},
+		{
+			description:   "should fail when the endpoint rejects the event",
+			responseCode:  http.StatusServiceUnavailable,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, payload, body)
+				assert.Equal(t, cloudEventsContentType, r.Header.Get("Content-Type"))
+				assert.True(t, hmac.Equal([]byte(signCentralWebhookPayload(secret, body)), []byte(r.Header.Get(CentralWebhookSignatureHeader))))
+				w.WriteHeader(tc.responseCode)
+			}))
+			defer server.Close()
+
+			err := postCentralCloudEvent(server.Client(), server.URL, secret, payload)
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	tt := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: 30 * time.Second},
+		{attempts: 2, expected: time.Minute},
+		{attempts: 4, expected: 4 * time.Minute},
+		{attempts: 20, expected: time.Hour},
+	}
+
+	for _, tc := range tt {
+		assert.Equal(t, tc.expected, webhookRetryBackoff(30*time.Second, time.Hour, tc.attempts))
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tt := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "203.0.113.10", expected: true},
+		{ip: "2001:4860:4860::8888", expected: true},
+		{ip: "127.0.0.1"},
+		{ip: "::1"},
+		{ip: "10.0.0.1"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "fd00::1"},
+		{ip: "169.254.169.254"},
+		{ip: "fe80::1"},
+		{ip: "0.0.0.0"},
+		{ip: "224.0.0.1"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.ip, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isPublicIP(net.ParseIP(tc.ip)))
+		})
+	}
+}
+
+func TestCentralWebhookHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/", http.StatusTemporaryRedirect)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	tt := []struct {
+		description           string
+		allowPrivateAddresses bool
+		path                  string
+		expectedError         bool
+	}{
+		{
+			description:   "should refuse to connect to a loopback address",
+			path:          "/",
+			expectedError: true,
+		},
+		{
+			description:           "should connect to a loopback address if private addresses are allowed",
+			allowPrivateAddresses: true,
+			path:                  "/",
+		},
+		{
+			description:           "should not follow redirects",
+			allowPrivateAddresses: true,
+			path:                  "/redirect",
+			expectedError:         true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			webhookConfig := config.NewCentralWebhookConfig()
+			webhookConfig.AllowPrivateAddresses = tc.allowPrivateAddresses
+			client := newCentralWebhookHTTPClient(webhookConfig)
+
+			err := postCentralCloudEvent(client, server.URL+tc.path, "secret", []byte("{}"))
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCentralWebhookServicePruneDeliveries(t *testing.T) {
+	var query string
+	var args []driver.NamedValue
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`DELETE FROM "central_webhook_deliveries"`).WithRowsNum(2).
+		WithCallback(func(q string, a []driver.NamedValue) {
+			query = q
+			args = a
+		})
+
+	webhookConfig := config.NewCentralWebhookConfig()
+	service := &centralWebhookService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		webhookConfig:     webhookConfig,
+	}
+	require.Nil(t, service.PruneDeliveries())
+
+	assert.Contains(t, query, "status IN")
+	assert.NotContains(t, query, "deleted_at")
+	var values []interface{}
+	for _, arg := range args {
+		values = append(values, arg.Value)
+	}
+	assert.Contains(t, values, constants.CentralWebhookDeliveryStatusDelivered.String())
+	assert.Contains(t, values, constants.CentralWebhookDeliveryStatusFailed.String())
+	assert.NotContains(t, values, constants.CentralWebhookDeliveryStatusPending.String())
+}
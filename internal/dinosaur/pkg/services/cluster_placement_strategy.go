@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
 )
 
 // ClusterPlacementStrategy ...
@@ -21,15 +23,23 @@ type ClusterPlacementStrategy interface {
 // NewClusterPlacementStrategy return a concrete strategy impl. depends on the
 // placement configuration. An appropriate ClusterPlacementStrategy implementation
 // is returned based on the received parameters content
-func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig) ClusterPlacementStrategy {
+func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, providerConfig *config.ProviderConfig) ClusterPlacementStrategy {
 	var clusterSelection ClusterPlacementStrategy
 	if dataplaneClusterConfig.DataPlaneClusterTarget != "" {
 		clusterSelection = TargetClusterPlacementStrategy{
 			targetClusterID: dataplaneClusterConfig.DataPlaneClusterTarget,
 			clusterService:  clusterService}
+	} else if dataplaneClusterConfig.IsLeastLoadedPlacementEnabled() {
+		clusterSelection = LeastLoadedPlacementStrategy{
+			clusterService: clusterService,
+			clusterConfig:  dataplaneClusterConfig.ClusterConfig,
+			providerConfig: providerConfig,
+			weights:        dataplaneClusterConfig.ClusterPlacementWeights,
+		}
 	} else {
 		clusterSelection = FirstReadyPlacementStrategy{
 			clusterService: clusterService,
+			providerConfig: providerConfig,
 		}
 	}
 
@@ -41,20 +51,32 @@ var _ ClusterPlacementStrategy = (*FirstReadyPlacementStrategy)(nil)
 // FirstReadyPlacementStrategy ...
 type FirstReadyPlacementStrategy struct {
 	clusterService ClusterService
+	providerConfig *config.ProviderConfig
 }
 
-// FindCluster ...
+// FindCluster returns the first ready and schedulable cluster in the region of the central.
+// The nearest regions of the central region are tried in order if no such cluster exists.
 func (d FirstReadyPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
 	clusters, err := d.clusterService.FindAllClusters(FindClusterCriteria{Status: api.ClusterReady})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, c := range clusters {
-		if !c.SkipScheduling && supportsInstanceType(c, central.InstanceType) {
-			return c, nil
+	located := false
+	for _, region := range placementRegions(d.providerConfig, central) {
+		for _, c := range clusters {
+			if !matchesLocation(c, central, region) || isMigrationSource(c, central) {
+				continue
+			}
+			located = true
+			if !c.SkipScheduling && supportsInstanceType(c, central.InstanceType) {
+				return c, nil
+			}
 		}
 	}
+	if !located {
+		return nil, noClusterInLocationError(central)
+	}
 
 	return nil, errors.New("no schedulable cluster found")
 }
@@ -74,15 +96,215 @@ func (f TargetClusterPlacementStrategy) FindCluster(central *dbapi.CentralReques
 		return nil, err
 	}
 
+	if cluster == nil {
+		return nil, fmt.Errorf("target cluster %v not found in cluster list", f.targetClusterID)
+	}
+
+	if cluster.Status == api.ClusterDraining {
+		return nil, fmt.Errorf("target cluster %s is draining", f.targetClusterID)
+	}
+
 	if !supportsInstanceType(cluster, central.InstanceType) {
 		return nil, fmt.Errorf("target cluster %s, does not support instance type %s", f.targetClusterID, central.InstanceType)
 	}
 
-	if cluster != nil {
-		return cluster, nil
+	if !matchesLocation(cluster, central, central.Region) {
+		return nil, noClusterInLocationError(central)
+	}
+
+	if isMigrationSource(cluster, central) {
+		return nil, fmt.Errorf("target cluster %s is the cluster central %s is migrated from", f.targetClusterID, central.ID)
+	}
+
+	return cluster, nil
+}
+
+var _ ClusterPlacementStrategy = LeastLoadedPlacementStrategy{}
+
+// LeastLoadedPlacementStrategy implements the ClusterPlacementStrategy to return the ready and schedulable cluster
+// with the highest weighted score. The cloud provider and multi AZ of the central request are hard constraints. The
+// clusters in the region of the central request are scored first, and the clusters of its nearest regions are only
+// scored in order if no cluster in the previous regions is schedulable. The score favours clusters with more free
+// capacity. Suspended centrals do not count toward the load of a cluster.
+type LeastLoadedPlacementStrategy struct {
+	clusterService ClusterService
+	clusterConfig  *config.ClusterConfig
+	providerConfig *config.ProviderConfig
+	weights        config.ClusterPlacementWeights
+}
+
+// ClusterRejection describes why a cluster has not been selected by a ClusterPlacementStrategy.
+type ClusterRejection struct {
+	ClusterID string
+	Reason    string
+}
+
+// NoSchedulableClusterError is returned by the LeastLoadedPlacementStrategy when no cluster is able to host a central.
+// It holds the reason why each candidate cluster has been rejected.
+type NoSchedulableClusterError struct {
+	Rejections []ClusterRejection
+}
+
+// Error ...
+func (e *NoSchedulableClusterError) Error() string {
+	if len(e.Rejections) == 0 {
+		return "no schedulable cluster found"
+	}
+	reasons := make([]string, 0, len(e.Rejections))
+	for _, r := range e.Rejections {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", r.ClusterID, r.Reason))
+	}
+	return fmt.Sprintf("no schedulable cluster found: %s", strings.Join(reasons, "; "))
+}
+
+// FindCluster returns the ready and schedulable cluster with the highest score or a NoSchedulableClusterError
+func (l LeastLoadedPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
+	readyClusters, err := l.clusterService.FindAllClusters(FindClusterCriteria{Status: api.ClusterReady})
+	if err != nil {
+		return nil, err
+	}
+
+	regions := placementRegions(l.providerConfig, central)
+	var clusters []*api.Cluster
+	var rejections []ClusterRejection
+	for _, c := range readyClusters {
+		if reason := locationRejectionReason(c, central, regions); reason != "" {
+			rejections = append(rejections, ClusterRejection{ClusterID: c.ClusterID, Reason: reason})
+		} else if isMigrationSource(c, central) {
+			rejections = append(rejections, ClusterRejection{ClusterID: c.ClusterID, Reason: "central is migrated away from the cluster"})
+		} else {
+			clusters = append(clusters, c)
+		}
+	}
+	if len(clusters) == 0 {
+		logRejections(central, rejections)
+		return nil, noClusterInLocationError(central)
+	}
+
+	clusterIDs := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		clusterIDs = append(clusterIDs, c.ClusterID)
+	}
+	counts, err := l.clusterService.FindActiveDinosaurInstanceCount(clusterIDs)
+	if err != nil {
+		return nil, err
+	}
+	countByCluster := make(map[string]int, len(counts))
+	maxCount := 0
+	for _, c := range counts {
+		countByCluster[c.Clusterid] = c.Count
+		if c.Count > maxCount {
+			maxCount = c.Count
+		}
+	}
+
+	for _, region := range regions {
+		var selected *api.Cluster
+		var selectedScore float64
+		for _, c := range clusters {
+			if !matchesLocation(c, central, region) {
+				continue
+			}
+			count := countByCluster[c.ClusterID]
+			if reason := l.rejectionReason(c, central, count); reason != "" {
+				rejections = append(rejections, ClusterRejection{ClusterID: c.ClusterID, Reason: reason})
+				continue
+			}
+			score := l.score(c, count, maxCount)
+			glog.V(10).Infof("Cluster %s scored %f for central %s", c.ClusterID, score, central.ID)
+			if selected == nil || score > selectedScore {
+				selected = c
+				selectedScore = score
+			}
+		}
+		if selected != nil {
+			logRejections(central, rejections)
+			return selected, nil
+		}
+	}
+
+	logRejections(central, rejections)
+	return nil, &NoSchedulableClusterError{Rejections: rejections}
+}
+
+func logRejections(central *dbapi.CentralRequest, rejections []ClusterRejection) {
+	for _, r := range rejections {
+		glog.V(10).Infof("Cluster %s rejected for central %s: %s", r.ClusterID, central.ID, r.Reason)
+	}
+}
+
+func (l LeastLoadedPlacementStrategy) rejectionReason(c *api.Cluster, central *dbapi.CentralRequest, count int) string {
+	if c.SkipScheduling {
+		return "scheduling is disabled"
+	}
+	if !supportsInstanceType(c, central.InstanceType) {
+		return fmt.Sprintf("instance type %s is not supported", central.InstanceType)
+	}
+	if l.clusterConfig != nil && !l.clusterConfig.IsNumberOfDinosaurWithinClusterLimit(c.ClusterID, count+1) {
+		return fmt.Sprintf("central instance limit reached with %d instances", count)
+	}
+	return ""
+}
+
+// score computes the weighted score of a cluster. The load part is the free capacity ratio of the cluster.
+// For clusters without a configured limit the ratio is relative to the most loaded candidate cluster.
+func (l LeastLoadedPlacementStrategy) score(c *api.Cluster, count int, maxCount int) float64 {
+	capacity := maxCount + 1
+	if l.clusterConfig != nil {
+		if limit, ok := l.clusterConfig.GetClusterInstanceLimit(c.ClusterID); ok && limit > 0 {
+			capacity = limit
+		}
+	}
+	return l.weights.Load * (1 - float64(count)/float64(capacity))
+}
+
+// placementRegions returns the region of the central followed by the nearest regions configured for it.
+func placementRegions(providerConfig *config.ProviderConfig, central *dbapi.CentralRequest) []string {
+	regions := []string{central.Region}
+	if providerConfig != nil {
+		regions = append(regions, providerConfig.ProvidersConfig.SupportedProviders.GetNearestRegions(central.CloudProvider, central.Region)...)
+	}
+	return regions
+}
+
+// matchesLocation checks whether a cluster is located in the given region and in the cloud provider of the central.
+// Multi AZ centrals require a multi AZ cluster, except for standalone clusters which do not track availability zones.
+// Empty values on the central match any cluster.
+func matchesLocation(c *api.Cluster, central *dbapi.CentralRequest, region string) bool {
+	return locationRejectionReason(c, central, []string{region}) == ""
+}
+
+// locationRejectionReason returns why a cluster is not located in one of the given regions, in the cloud provider of
+// the central or does not support its multi AZ setting, or an empty string if it is. See matchesLocation.
+func locationRejectionReason(c *api.Cluster, central *dbapi.CentralRequest, regions []string) string {
+	if central.CloudProvider != "" && c.CloudProvider != central.CloudProvider {
+		return fmt.Sprintf("cloud provider %s does not match %s", c.CloudProvider, central.CloudProvider)
+	}
+	if !matchesRegion(c, regions) {
+		return fmt.Sprintf("region %s is not one of %s", c.Region, strings.Join(regions, ", "))
+	}
+	if central.MultiAZ && !c.MultiAZ && c.ProviderType != api.ClusterProviderStandalone {
+		return "multi AZ is not supported"
 	}
+	return ""
+}
+
+func matchesRegion(c *api.Cluster, regions []string) bool {
+	for _, region := range regions {
+		if region == "" || c.Region == region {
+			return true
+		}
+	}
+	return false
+}
+
+// isMigrationSource checks whether a central is migrated away from the cluster.
+func isMigrationSource(c *api.Cluster, central *dbapi.CentralRequest) bool {
+	return central.MigrationSourceClusterID != "" && c.ClusterID == central.MigrationSourceClusterID
+}
 
-	return nil, fmt.Errorf("target cluster %v not found in cluster list", f.targetClusterID)
+func noClusterInLocationError(central *dbapi.CentralRequest) *serviceErrors.ServiceError {
+	return serviceErrors.RegionNotSupported("no cluster available for cloud provider %q in region %q with multi AZ %t", central.CloudProvider, central.Region, central.MultiAZ)
 }
 
 func supportsInstanceType(c *api.Cluster, instanceType string) bool {
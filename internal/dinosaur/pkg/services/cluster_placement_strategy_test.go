@@ -42,17 +42,41 @@ func TestPlacementStrategyType(t *testing.T) {
 			},
 			expectedType: TargetClusterPlacementStrategy{},
 		},
+		{
+			description: "LeastLoadedPlacementStrategy",
+			createClusterService: func() ClusterService {
+				return &ClusterServiceMock{}
+			},
+			dataPlaneConfig: &config.DataplaneClusterConfig{
+				DataPlaneClusterPlacementStrategy: config.LeastLoadedPlacement,
+			},
+			expectedType: LeastLoadedPlacementStrategy{},
+		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.description, func(t *testing.T) {
-			strategy := NewClusterPlacementStrategy(tc.createClusterService(), tc.dataPlaneConfig)
+			strategy := NewClusterPlacementStrategy(tc.createClusterService(), tc.dataPlaneConfig, nil)
 
 			require.IsType(t, tc.expectedType, strategy)
 		})
 	}
 }
 
+var testPlacementProviderConfig = &config.ProviderConfig{
+	ProvidersConfig: config.ProviderConfiguration{
+		SupportedProviders: config.ProviderList{
+			{
+				Name: testCentralRequestProvider,
+				Regions: config.RegionList{
+					{Name: "eu-west-1", NearestRegions: []string{"eu-central-1"}},
+					{Name: "eu-central-1"},
+				},
+			},
+		},
+	},
+}
+
 func TestFirstClusterPlacementStrategy(t *testing.T) {
 	tt := []struct {
 		description           string
@@ -83,8 +107,10 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 					},
 				}
 			},
-			central:         buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {}),
-			expectedError:   errors.New("no schedulable cluster found"),
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.Region = testRegion
+			}),
+			expectedError:   serviceErrors.RegionNotSupported("no cluster available for cloud provider %q in region %q with multi AZ %t", testCentralRequestProvider, testRegion, false),
 			expectedCluster: nil,
 		},
 		{
@@ -103,7 +129,9 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 					},
 				}
 			},
-			central:         buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {}),
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.Region = testRegion
+			}),
 			expectedError:   errors.New("no schedulable cluster found"),
 			expectedCluster: nil,
 		},
@@ -121,6 +149,7 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 			},
 			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
 				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
 			}),
 			expectedError:   errors.New("no schedulable cluster found"),
 			expectedCluster: nil,
@@ -141,9 +170,113 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 			},
 			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
 				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
+			}),
+			expectedError: nil,
+			expectedCluster: buildCluster(func(cluster *api.Cluster) {
+				cluster.SupportedInstanceType = "standard,eval"
+			}),
+		},
+		{
+			description: "should return error if no cluster in the region of the central was found",
+			newClusterServiceMock: func() ClusterService {
+				return &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+						return []*api.Cluster{
+							buildCluster(func(cluster *api.Cluster) {
+								cluster.SupportedInstanceType = "standard,eval"
+							}),
+						}, nil
+					},
+				}
+			},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = "eu-west-1"
+			}),
+			expectedError:   serviceErrors.RegionNotSupported("no cluster available for cloud provider %q in region %q with multi AZ %t", testCentralRequestProvider, "eu-west-1", false),
+			expectedCluster: nil,
+		},
+		{
+			description: "should return error if no multi AZ cluster was found for a multi AZ central",
+			newClusterServiceMock: func() ClusterService {
+				return &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+						return []*api.Cluster{
+							buildCluster(func(cluster *api.Cluster) {
+								cluster.MultiAZ = false
+								cluster.SupportedInstanceType = "standard,eval"
+							}),
+						}, nil
+					},
+				}
+			},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
+				centralRequest.MultiAZ = true
+			}),
+			expectedError:   serviceErrors.RegionNotSupported("no cluster available for cloud provider %q in region %q with multi AZ %t", testCentralRequestProvider, testRegion, true),
+			expectedCluster: nil,
+		},
+		{
+			description: "should return schedulable cluster in the nearest region",
+			newClusterServiceMock: func() ClusterService {
+				return &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+						return []*api.Cluster{
+							buildCluster(func(cluster *api.Cluster) {
+								cluster.ClusterID = "cluster-1"
+								cluster.Region = "eu-west-1"
+								cluster.SkipScheduling = true
+								cluster.SupportedInstanceType = "standard,eval"
+							}),
+							buildCluster(func(cluster *api.Cluster) {
+								cluster.ClusterID = "cluster-2"
+								cluster.Region = "eu-central-1"
+								cluster.SupportedInstanceType = "standard,eval"
+							}),
+						}, nil
+					},
+				}
+			},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = "eu-west-1"
+			}),
+			expectedError: nil,
+			expectedCluster: buildCluster(func(cluster *api.Cluster) {
+				cluster.ClusterID = "cluster-2"
+				cluster.Region = "eu-central-1"
+				cluster.SupportedInstanceType = "standard,eval"
+			}),
+		},
+		{
+			description: "should not return the cluster the central is migrated from",
+			newClusterServiceMock: func() ClusterService {
+				return &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+						return []*api.Cluster{
+							buildCluster(func(cluster *api.Cluster) {
+								cluster.ClusterID = "cluster-1"
+								cluster.SupportedInstanceType = "standard,eval"
+							}),
+							buildCluster(func(cluster *api.Cluster) {
+								cluster.ClusterID = "cluster-2"
+								cluster.SupportedInstanceType = "standard,eval"
+							}),
+						}, nil
+					},
+				}
+			},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
+				centralRequest.MigrationSourceClusterID = "cluster-1"
 			}),
 			expectedError: nil,
 			expectedCluster: buildCluster(func(cluster *api.Cluster) {
+				cluster.ClusterID = "cluster-2"
 				cluster.SupportedInstanceType = "standard,eval"
 			}),
 		},
@@ -151,7 +284,10 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.description, func(t *testing.T) {
-			strategy := FirstReadyPlacementStrategy{clusterService: tc.newClusterServiceMock()}
+			strategy := FirstReadyPlacementStrategy{
+				clusterService: tc.newClusterServiceMock(),
+				providerConfig: testPlacementProviderConfig,
+			}
 			cluster, err := strategy.FindCluster(tc.central)
 			require.Equal(t, err, tc.expectedError)
 			if tc.expectedError != nil {
@@ -166,3 +302,222 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 
 	}
 }
+
+func TestLeastLoadedPlacementStrategy(t *testing.T) {
+	clusterConfig := config.NewClusterConfig(config.ClusterList{
+		{ClusterID: "cluster-1", CentralInstanceLimit: 10},
+		{ClusterID: "cluster-2", CentralInstanceLimit: 10},
+		{ClusterID: "cluster-3", CentralInstanceLimit: 2},
+	})
+
+	tt := []struct {
+		description        string
+		clusters           []*api.Cluster
+		counts             map[string]int
+		weights            config.ClusterPlacementWeights
+		central            *dbapi.CentralRequest
+		expectedClusterID  string
+		expectedRejections []ClusterRejection
+	}{
+		{
+			description: "should return the least loaded cluster",
+			clusters: []*api.Cluster{
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-2"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+			},
+			counts:  map[string]int{"cluster-1": 8, "cluster-2": 3},
+			weights: config.ClusterPlacementWeights{Load: 1},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
+			}),
+			expectedClusterID: "cluster-2",
+		},
+		{
+			description: "should prefer a cluster with capacity in the region of the central over an emptier cluster in its nearest regions",
+			clusters: []*api.Cluster{
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-2"
+					cluster.Region = "eu-central-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-1"
+					cluster.Region = "eu-west-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+			},
+			counts:  map[string]int{"cluster-1": 9, "cluster-2": 0},
+			weights: config.ClusterPlacementWeights{Load: 1},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = "eu-west-1"
+			}),
+			expectedClusterID: "cluster-1",
+		},
+		{
+			description: "should fall back to the least loaded cluster in the nearest regions if the region of the central is full",
+			clusters: []*api.Cluster{
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-1"
+					cluster.Region = "eu-central-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-2"
+					cluster.Region = "eu-central-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-3"
+					cluster.Region = "eu-west-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+			},
+			counts:  map[string]int{"cluster-1": 7, "cluster-2": 4, "cluster-3": 2},
+			weights: config.ClusterPlacementWeights{Load: 1},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = "eu-west-1"
+			}),
+			expectedClusterID: "cluster-2",
+		},
+		{
+			description: "should return error with the rejection reason of each cluster",
+			clusters: []*api.Cluster{
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-1"
+					cluster.SkipScheduling = true
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-2"
+					cluster.SupportedInstanceType = "eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-3"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+			},
+			counts:  map[string]int{"cluster-3": 2},
+			weights: config.ClusterPlacementWeights{Load: 1},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
+			}),
+			expectedRejections: []ClusterRejection{
+				{ClusterID: "cluster-1", Reason: "scheduling is disabled"},
+				{ClusterID: "cluster-2", Reason: "instance type standard is not supported"},
+				{ClusterID: "cluster-3", Reason: "central instance limit reached with 2 instances"},
+			},
+		},
+		{
+			description: "should report clusters outside the location of the central as rejected",
+			clusters: []*api.Cluster{
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-1"
+					cluster.CloudProvider = "gcp"
+					cluster.Region = "eu-west-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-2"
+					cluster.Region = "us-east-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-3"
+					cluster.Region = "eu-central-1"
+					cluster.MultiAZ = false
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-4"
+					cluster.Region = "eu-west-1"
+					cluster.MultiAZ = true
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-5"
+					cluster.Region = "eu-west-1"
+					cluster.MultiAZ = true
+					cluster.SupportedInstanceType = "eval"
+				}),
+			},
+			weights: config.ClusterPlacementWeights{Load: 1},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = "eu-west-1"
+				centralRequest.MultiAZ = true
+				centralRequest.MigrationSourceClusterID = "cluster-4"
+			}),
+			expectedRejections: []ClusterRejection{
+				{ClusterID: "cluster-1", Reason: "cloud provider gcp does not match aws"},
+				{ClusterID: "cluster-2", Reason: "region us-east-1 is not one of eu-west-1, eu-central-1"},
+				{ClusterID: "cluster-3", Reason: "multi AZ is not supported"},
+				{ClusterID: "cluster-4", Reason: "central is migrated away from the cluster"},
+				{ClusterID: "cluster-5", Reason: "instance type standard is not supported"},
+			},
+		},
+		{
+			description: "should not return the cluster the central is migrated from",
+			clusters: []*api.Cluster{
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-1"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+				buildCluster(func(cluster *api.Cluster) {
+					cluster.ClusterID = "cluster-2"
+					cluster.SupportedInstanceType = "standard,eval"
+				}),
+			},
+			counts:  map[string]int{"cluster-1": 0, "cluster-2": 9},
+			weights: config.ClusterPlacementWeights{Load: 1},
+			central: buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.InstanceType = "standard"
+				centralRequest.Region = testRegion
+				centralRequest.MigrationSourceClusterID = "cluster-1"
+			}),
+			expectedClusterID: "cluster-2",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			clusterService := &ClusterServiceMock{
+				FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+					return tc.clusters, nil
+				},
+				FindActiveDinosaurInstanceCountFunc: func(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceErrors.ServiceError) {
+					var res []ResDinosaurInstanceCount
+					for _, id := range clusterIDs {
+						res = append(res, ResDinosaurInstanceCount{Clusterid: id, Count: tc.counts[id]})
+					}
+					return res, nil
+				},
+			}
+			strategy := LeastLoadedPlacementStrategy{
+				clusterService: clusterService,
+				clusterConfig:  clusterConfig,
+				providerConfig: testPlacementProviderConfig,
+				weights:        tc.weights,
+			}
+			cluster, err := strategy.FindCluster(tc.central)
+			if tc.expectedRejections != nil {
+				require.Nil(t, cluster)
+				var noClusterErr *NoSchedulableClusterError
+				require.ErrorAs(t, err, &noClusterErr)
+				require.Equal(t, tc.expectedRejections, noClusterErr.Rejections)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedClusterID, cluster.ClusterID)
+		})
+	}
+}
@@ -54,6 +54,9 @@ type ClusterService interface {
 	FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError)
 	// FindDinosaurInstanceCount returns the dinosaur instance counts associated with the list of clusters. If the list is empty, it will list all clusterIds that have Dinosaur instances assigned.
 	FindDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError)
+	// FindActiveDinosaurInstanceCount returns the dinosaur instance counts like FindDinosaurInstanceCount, but without
+	// the suspended dinosaurs, which do not use any capacity of their cluster.
+	FindActiveDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError)
 	// UpdateMultiClusterStatus updates a list of clusters' status to a status
 	UpdateMultiClusterStatus(clusterIds []string, status api.ClusterStatus) *apiErrors.ServiceError
 	// UpdateMultiClusterSkipScheduling updates the SkipScheduling field of a list of clusters
@@ -495,11 +498,24 @@ func (c clusterService) GetExternalID(clusterID string) (string, *apiErrors.Serv
 
 // FindDinosaurInstanceCount ...
 func (c clusterService) FindDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError) {
+	// dinosaur in accepted state do not have a cluster_id assigned to them
+	return c.findDinosaurInstanceCount(clusterIDs, []string{constants2.CentralRequestStatusAccepted.String()})
+}
+
+// FindActiveDinosaurInstanceCount ...
+func (c clusterService) FindActiveDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError) {
+	return c.findDinosaurInstanceCount(clusterIDs, []string{
+		constants2.CentralRequestStatusAccepted.String(),
+		constants2.CentralRequestStatusSuspended.String(),
+	})
+}
+
+func (c clusterService) findDinosaurInstanceCount(clusterIDs []string, excludedStatuses []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError) {
 	var res []ResDinosaurInstanceCount
 	query := c.connectionFactory.New().
 		Model(&dbapi.CentralRequest{}).
 		Select("cluster_id as Clusterid, count(1) as Count").
-		Where("status != ?", constants2.CentralRequestStatusAccepted.String()) // dinosaur in accepted state do not have a cluster_id assigned to them
+		Where("status NOT IN (?)", excludedStatuses)
 
 	if len(clusterIDs) > 0 {
 		query = query.Where("cluster_id in (?)", clusterIDs)
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	constants2 "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/clusters"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/clusters/types"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
@@ -1288,6 +1289,36 @@ func Test_clusterService_FindDinosaurInstanceCount(t *testing.T) {
 	}
 }
 
+func Test_clusterService_FindActiveDinosaurInstanceCount(t *testing.T) {
+	counters := []map[string]interface{}{
+		{
+			"clusterid": "test01",
+			"count":     1,
+		},
+	}
+	mocket.Catcher.Reset()
+	// suspended dinosaurs must not be counted
+	mocket.Catcher.NewMock().
+		WithQuery(`WHERE status NOT IN ($1,$2) AND cluster_id in ($3,$4)`).
+		WithArgs(constants2.CentralRequestStatusAccepted.String(), constants2.CentralRequestStatusSuspended.String(), "test01", "test02").
+		WithReply(counters)
+
+	c := clusterService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+	}
+	got, err := c.FindActiveDinosaurInstanceCount([]string{"test01", "test02"})
+	if err != nil {
+		t.Fatalf("FindActiveDinosaurInstanceCount() unexpected error = %v", err)
+	}
+	want := []ResDinosaurInstanceCount{
+		{Clusterid: "test01", Count: 1},
+		{Clusterid: "test02", Count: 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindActiveDinosaurInstanceCount() got = %v, want %v", got, want)
+	}
+}
+
 func Test_clusterService_FindAllClusters(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
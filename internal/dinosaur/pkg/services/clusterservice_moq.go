@@ -44,6 +44,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			DeleteByClusterIDFunc: func(clusterID string) *serviceError.ServiceError {
 //				panic("mock out the DeleteByClusterID method")
 //			},
+//			FindActiveDinosaurInstanceCountFunc: func(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceError.ServiceError) {
+//				panic("mock out the FindActiveDinosaurInstanceCount method")
+//			},
 //			FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the FindAllClusters method")
 //			},
@@ -138,6 +141,9 @@ type ClusterServiceMock struct {
 	// DeleteByClusterIDFunc mocks the DeleteByClusterID method.
 	DeleteByClusterIDFunc func(clusterID string) *serviceError.ServiceError
 
+	// FindActiveDinosaurInstanceCountFunc mocks the FindActiveDinosaurInstanceCount method.
+	FindActiveDinosaurInstanceCountFunc func(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceError.ServiceError)
+
 	// FindAllClustersFunc mocks the FindAllClusters method.
 	FindAllClustersFunc func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError)
 
@@ -249,6 +255,11 @@ type ClusterServiceMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// FindActiveDinosaurInstanceCount holds details about calls to the FindActiveDinosaurInstanceCount method.
+		FindActiveDinosaurInstanceCount []struct {
+			// ClusterIDs is the clusterIDs argument value.
+			ClusterIDs []string
+		}
 		// FindAllClusters holds details about calls to the FindAllClusters method.
 		FindAllClusters []struct {
 			// Criteria is the criteria argument value.
@@ -381,6 +392,7 @@ type ClusterServiceMock struct {
 	lockCreate                              sync.RWMutex
 	lockDelete                              sync.RWMutex
 	lockDeleteByClusterID                   sync.RWMutex
+	lockFindActiveDinosaurInstanceCount     sync.RWMutex
 	lockFindAllClusters                     sync.RWMutex
 	lockFindCluster                         sync.RWMutex
 	lockFindClusterByID                     sync.RWMutex
@@ -672,6 +684,38 @@ func (mock *ClusterServiceMock) DeleteByClusterIDCalls() []struct {
 	return calls
 }
 
+// FindActiveDinosaurInstanceCount calls FindActiveDinosaurInstanceCountFunc.
+func (mock *ClusterServiceMock) FindActiveDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceError.ServiceError) {
+	if mock.FindActiveDinosaurInstanceCountFunc == nil {
+		panic("ClusterServiceMock.FindActiveDinosaurInstanceCountFunc: method is nil but ClusterService.FindActiveDinosaurInstanceCount was just called")
+	}
+	callInfo := struct {
+		ClusterIDs []string
+	}{
+		ClusterIDs: clusterIDs,
+	}
+	mock.lockFindActiveDinosaurInstanceCount.Lock()
+	mock.calls.FindActiveDinosaurInstanceCount = append(mock.calls.FindActiveDinosaurInstanceCount, callInfo)
+	mock.lockFindActiveDinosaurInstanceCount.Unlock()
+	return mock.FindActiveDinosaurInstanceCountFunc(clusterIDs)
+}
+
+// FindActiveDinosaurInstanceCountCalls gets all the calls that were made to FindActiveDinosaurInstanceCount.
+// Check the length with:
+//
+//	len(mockedClusterService.FindActiveDinosaurInstanceCountCalls())
+func (mock *ClusterServiceMock) FindActiveDinosaurInstanceCountCalls() []struct {
+	ClusterIDs []string
+} {
+	var calls []struct {
+		ClusterIDs []string
+	}
+	mock.lockFindActiveDinosaurInstanceCount.RLock()
+	calls = mock.calls.FindActiveDinosaurInstanceCount
+	mock.lockFindActiveDinosaurInstanceCount.RUnlock()
+	return calls
+}
+
 // FindAllClusters calls FindAllClustersFunc.
 func (mock *ClusterServiceMock) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError) {
 	if mock.FindAllClustersFunc == nil {
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +28,7 @@ const (
 	statusError      centralStatus = "error"
 	statusRejected   centralStatus = "rejected"
 	statusDeleted    centralStatus = "deleted"
+	statusSuspended  centralStatus = "suspended"
 	statusUnknown    centralStatus = "unknown"
 
 	// TODO: Renaming these dinosaurs will require a DB migration step
@@ -39,17 +42,21 @@ type DataPlaneCentralService interface {
 }
 
 type dataPlaneCentralService struct {
-	dinosaurService DinosaurService
-	clusterService  ClusterService
-	dinosaurConfig  *config.CentralConfig
+	dinosaurService  DinosaurService
+	clusterService   ClusterService
+	migrationService CentralMigrationService
+	eventService     CentralEventService
+	dinosaurConfig   *config.CentralConfig
 }
 
 // NewDataPlaneCentralService ...
-func NewDataPlaneCentralService(dinosaurSrv DinosaurService, clusterSrv ClusterService, dinosaurConfig *config.CentralConfig) *dataPlaneCentralService {
+func NewDataPlaneCentralService(dinosaurSrv DinosaurService, clusterSrv ClusterService, migrationSrv CentralMigrationService, eventSrv CentralEventService, dinosaurConfig *config.CentralConfig) *dataPlaneCentralService {
 	return &dataPlaneCentralService{
-		dinosaurService: dinosaurSrv,
-		clusterService:  clusterSrv,
-		dinosaurConfig:  dinosaurConfig,
+		dinosaurService:  dinosaurSrv,
+		clusterService:   clusterSrv,
+		migrationService: migrationSrv,
+		eventService:     eventSrv,
+		dinosaurConfig:   dinosaurConfig,
 	}
 }
 
@@ -70,6 +77,15 @@ func (d *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 			glog.Error(errors.Wrapf(getErr, "failed to get central cluster by id %s", ks.CentralClusterID))
 			continue
 		}
+		// The migration source only scales the central down or removes it, and the migration target provisions it until
+		// the central is assigned to it.
+		if dinosaur.IsMigrating() && (dinosaur.MigrationSourceClusterID == clusterID ||
+			(dinosaur.MigrationTargetClusterID == clusterID && dinosaur.ClusterID != clusterID)) {
+			if e := d.updateCentralMigration(dinosaur, ks, cluster); e != nil {
+				log.Error(errors.Wrapf(e, "Error updating central %s migration status", ks.CentralClusterID))
+			}
+			continue
+		}
 		if dinosaur.ClusterID != clusterID {
 			log.Warningf("clusterId for central cluster %s does not match clusterId. central clusterId = %s :: clusterId = %s", dinosaur.ID, dinosaur.ClusterID, clusterID)
 			continue
@@ -77,12 +93,19 @@ func (d *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 		var e *serviceError.ServiceError
 		switch s := getStatus(ks); s {
 		case statusReady:
+			if dinosaur.IsSuspended() {
+				// The central has been reported as ready before the data plane cluster started to scale it down.
+				log.V(5).Infof("central cluster %s is still ready while it is %s", ks.CentralClusterID, dinosaur.Status)
+				break
+			}
 			// Only store the routes (and create them) when the Dinosaurs are ready, as by the time they are ready,
 			// the routes should definitely be there.
 			e = d.persistCentralRoutes(dinosaur, ks, cluster)
 			if e == nil {
 				e = d.setCentralClusterReady(dinosaur)
 			}
+		case statusSuspended:
+			e = d.setCentralClusterSuspended(dinosaur)
 		case statusError:
 			// when getStatus returns statusError we know that the ready
 			// condition will be there so there's no need to check for it
@@ -105,6 +128,21 @@ func (d *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 		if e != nil {
 			log.Error(errors.Wrapf(e, "Error updating central '%s' version fields", ks.CentralClusterID))
 		}
+
+		e = d.setCentralRequestUpgradeFields(dinosaur, ks)
+		if e != nil {
+			log.Error(errors.Wrapf(e, "Error updating central '%s' upgrade fields", ks.CentralClusterID))
+		}
+
+		e = d.setCentralRequestDBFields(dinosaur, ks)
+		if e != nil {
+			log.Error(errors.Wrapf(e, "Error updating central '%s' DB fields", ks.CentralClusterID))
+		}
+
+		e = d.setCentralRequestHealthFields(dinosaur, ks)
+		if e != nil {
+			log.Error(errors.Wrapf(e, "Error updating central '%s' health conditions", ks.CentralClusterID))
+		}
 	}
 
 	return nil
@@ -123,7 +161,12 @@ func (d *dataPlaneCentralService) setCentralClusterReady(centralRequest *dbapi.C
 		return err
 	}
 
-	err = d.dinosaurService.Updates(centralRequest, map[string]interface{}{"failed_reason": "", "status": constants2.CentralRequestStatusReady.String()})
+	var events []*dbapi.CentralEvent
+	if previousStatus := constants2.CentralStatus(centralRequest.Status); previousStatus != constants2.CentralRequestStatusReady {
+		events = append(events, dbapi.NewCentralStatusEvent(centralRequest, previousStatus,
+			constants2.CentralRequestStatusReady, constants2.CentralEventActorDataPlane, "central reported as ready"))
+	}
+	err = d.dinosaurService.Updates(centralRequest, map[string]interface{}{"failed_reason": "", "status": constants2.CentralRequestStatusReady.String()}, events...)
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update status %s for central cluster %s", constants2.CentralRequestStatusReady, centralRequest.ID)
 	}
@@ -136,6 +179,27 @@ func (d *dataPlaneCentralService) setCentralClusterReady(centralRequest *dbapi.C
 	return nil
 }
 
+// setCentralClusterSuspended completes the suspension of a central once the data plane cluster reports that it has
+// been scaled down. Reports of centrals which are resumed again are ignored.
+func (d *dataPlaneCentralService) setCentralClusterSuspended(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+	if centralRequest.Status != constants2.CentralRequestStatusSuspending.String() {
+		logger.Logger.V(5).Infof("central %s reported as suspended while it is %s", centralRequest.ID, centralRequest.Status)
+		return nil
+	}
+	// The health conditions reported before the central was scaled down are outdated.
+	suspendedFields := map[string]interface{}{
+		"status":            constants2.CentralRequestStatusSuspended.String(),
+		"health_conditions": api.JSON(nil),
+	}
+	event := dbapi.NewCentralStatusEvent(centralRequest, constants2.CentralRequestStatusSuspending,
+		constants2.CentralRequestStatusSuspended, constants2.CentralEventActorDataPlane, "central reported as suspended")
+	if err := d.dinosaurService.Updates(centralRequest, suspendedFields, event); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update status %s for central cluster %s", constants2.CentralRequestStatusSuspended, centralRequest.ID)
+	}
+	logger.Logger.Infof("Central %s has been suspended", centralRequest.ID)
+	return nil
+}
+
 func (d *dataPlaneCentralService) setCentralRequestVersionFields(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
 	needsUpdate := false
 	prevActualDinosaurVersion := status.CentralVersion
@@ -200,6 +264,136 @@ func (d *dataPlaneCentralService) setCentralRequestVersionFields(centralRequest
 	return nil
 }
 
+// setCentralRequestUpgradeFields completes the upgrade of a central by a rollout. The upgrade succeeded once the central
+// is ready with the desired versions, and failed if the central reports an error.
+func (d *dataPlaneCentralService) setCentralRequestUpgradeFields(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	if centralRequest.UpgradeStatus != constants2.CentralUpgradeStatusUpgrading.String() {
+		return nil
+	}
+
+	var upgradeStatus constants2.CentralUpgradeStatus
+	switch getStatus(status) {
+	case statusReady:
+		if centralRequest.CentralUpgrading || centralRequest.CentralOperatorUpgrading ||
+			centralRequest.ActualCentralVersion != centralRequest.DesiredCentralVersion ||
+			centralRequest.ActualCentralOperatorVersion != centralRequest.DesiredCentralOperatorVersion {
+			return nil
+		}
+		upgradeStatus = constants2.CentralUpgradeStatusSucceeded
+	case statusError:
+		upgradeStatus = constants2.CentralUpgradeStatusFailed
+	default:
+		return nil
+	}
+
+	logger.Logger.Infof("Upgrade of Central ID '%s' to version '%s' by rollout %s %s", centralRequest.ID, centralRequest.DesiredCentralVersion, centralRequest.UpgradeRolloutID, upgradeStatus)
+	if err := d.dinosaurService.Updates(centralRequest, map[string]interface{}{"upgrade_status": upgradeStatus.String()}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update upgrade status for central cluster %s", centralRequest.ID)
+	}
+	if upgradeStatus == constants2.CentralUpgradeStatusSucceeded {
+		metrics.IncreaseCentralSuccessOperationsCountMetric(constants2.CentralOperationUpgrade)
+	}
+	return nil
+}
+
+// setCentralRequestDBFields updates the progress of the backup and restore of the managed database of the central and
+// the list of its snapshots. Reports of operations other than the last requested ones are ignored.
+func (d *dataPlaneCentralService) setCentralRequestDBFields(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	if status.DB == nil {
+		return nil
+	}
+	dbFields := map[string]interface{}{}
+
+	backup := status.DB.Backup
+	if backup.ID != "" && backup.ID == centralRequest.DBBackupID && centralRequest.HasActiveDBBackup() && backup.Status != centralRequest.DBBackupStatus {
+		logger.Logger.Infof("Updating DB backup %s status for Central ID '%s' from '%s' to '%s'", backup.ID, centralRequest.ID, centralRequest.DBBackupStatus, backup.Status)
+		dbFields["db_backup_status"] = backup.Status
+		if backup.Status == constants2.CentralDBOperationStatusFailed.String() {
+			dbFields["db_failed_reason"] = backup.Message
+		}
+		if backup.Status == constants2.CentralDBOperationStatusCompleted.String() {
+			metrics.IncreaseCentralSuccessOperationsCountMetric(constants2.CentralOperationBackup)
+		}
+	}
+
+	restore := status.DB.Restore
+	if restore.ID != "" && restore.ID == centralRequest.DBRestoreID && centralRequest.HasActiveDBRestore() && restore.Status != centralRequest.DBRestoreStatus {
+		logger.Logger.Infof("Updating DB restore %s status for Central ID '%s' from '%s' to '%s'", restore.ID, centralRequest.ID, centralRequest.DBRestoreStatus, restore.Status)
+		dbFields["db_restore_status"] = restore.Status
+		if restore.Status == constants2.CentralDBOperationStatusFailed.String() {
+			dbFields["db_failed_reason"] = restore.Message
+		}
+		if restore.Status == constants2.CentralDBOperationStatusCompleted.String() {
+			metrics.IncreaseCentralSuccessOperationsCountMetric(constants2.CentralOperationRestore)
+		}
+	}
+
+	if status.DB.Snapshots != nil {
+		snapshots, err := json.Marshal(status.DB.Snapshots)
+		if err != nil {
+			return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to marshal DB snapshots for central cluster %s", centralRequest.ID)
+		}
+		if !bytes.Equal(snapshots, centralRequest.DBSnapshots) {
+			dbFields["db_snapshots"] = api.JSON(snapshots)
+		}
+	}
+
+	if len(dbFields) == 0 {
+		return nil
+	}
+	if err := d.dinosaurService.Updates(centralRequest, dbFields); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update DB fields for central cluster %s", centralRequest.ID)
+	}
+	return nil
+}
+
+// setCentralRequestHealthFields stores the health conditions reported for the central. The transition time of a
+// condition is kept as long as its status does not change.
+func (d *dataPlaneCentralService) setCentralRequestHealthFields(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	reported := status.GetHealthConditions()
+	if len(reported) == 0 {
+		return nil
+	}
+
+	previous, err := centralRequest.GetHealthConditions()
+	if err != nil {
+		// The conditions are replaced by the reported ones, only their transition times are lost.
+		logger.Logger.Warningf("Ignoring invalid health conditions of Central ID '%s': %v", centralRequest.ID, err)
+	}
+	previousByType := make(map[string]dbapi.CentralHealthCondition, len(previous))
+	for _, c := range previous {
+		previousByType[c.Type] = c
+	}
+
+	now := time.Now()
+	conditions := make([]dbapi.CentralHealthCondition, 0, len(reported))
+	for _, c := range reported {
+		condition := dbapi.CentralHealthCondition{
+			Type:               c.Type,
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: now,
+		}
+		if p, ok := previousByType[c.Type]; ok && p.Status == c.Status {
+			condition.LastTransitionTime = p.LastTransitionTime
+		}
+		conditions = append(conditions, condition)
+	}
+
+	healthConditions, marshalErr := json.Marshal(conditions)
+	if marshalErr != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, marshalErr, "failed to marshal health conditions for central cluster %s", centralRequest.ID)
+	}
+	if bytes.Equal(healthConditions, centralRequest.HealthConditions) {
+		return nil
+	}
+	if err := d.dinosaurService.Updates(centralRequest, map[string]interface{}{"health_conditions": api.JSON(healthConditions)}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update health conditions for central cluster %s", centralRequest.ID)
+	}
+	return nil
+}
+
 func (d *dataPlaneCentralService) setCentralClusterFailed(centralRequest *dbapi.CentralRequest, errMessage string) *serviceError.ServiceError {
 	// if dinosaur was already reported as failed we don't do anything
 	if centralRequest.Status == string(constants2.CentralRequestStatusFailed) {
@@ -212,9 +406,11 @@ func (d *dataPlaneCentralService) setCentralClusterFailed(centralRequest *dbapi.
 		return err
 	}
 
+	previousStatus := constants2.CentralStatus(centralRequest.Status)
 	centralRequest.Status = string(constants2.CentralRequestStatusFailed)
 	centralRequest.FailedReason = fmt.Sprintf("Central reported as failed: '%s'", errMessage)
-	err = d.dinosaurService.Update(centralRequest)
+	err = d.dinosaurService.Update(centralRequest, dbapi.NewCentralStatusEvent(centralRequest, previousStatus,
+		constants2.CentralRequestStatusFailed, constants2.CentralEventActorDataPlane, centralRequest.FailedReason))
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update central cluster to %s status for central cluster %s", constants2.CentralRequestStatusFailed, centralRequest.ID)
 	}
@@ -229,7 +425,9 @@ func (d *dataPlaneCentralService) setCentralClusterFailed(centralRequest *dbapi.
 
 func (d *dataPlaneCentralService) setCentralClusterDeleting(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 	// If the Dinosaur cluster is deleted from the data plane cluster, we will make it as "deleting" in db and the reconcilier will ensure it is cleaned up properly
-	if ok, updateErr := d.dinosaurService.UpdateStatus(centralRequest.ID, constants2.CentralRequestStatusDeleting); ok {
+	event := dbapi.NewCentralStatusEvent(centralRequest, constants2.CentralStatus(centralRequest.Status),
+		constants2.CentralRequestStatusDeleting, constants2.CentralEventActorDataPlane, "central reported as deleted")
+	if ok, updateErr := d.dinosaurService.UpdateStatus(centralRequest.ID, constants2.CentralRequestStatusDeleting, event); ok {
 		if updateErr != nil {
 			return serviceError.NewWithCause(updateErr.Code, updateErr, "failed to update status %s for central cluster %s", constants2.CentralRequestStatusDeleting, centralRequest.ID)
 		}
@@ -247,6 +445,8 @@ func (d *dataPlaneCentralService) reassignCentralCluster(centralRequest *dbapi.C
 		if err := d.dinosaurService.Update(centralRequest); err != nil {
 			return err
 		}
+		RecordCentralEvent(d.eventService, dbapi.NewCentralPlacementEvent(centralRequest, constants2.CentralEventActorDataPlane,
+			fmt.Sprintf("central rejected by cluster, retrying with placement %s", centralRequest.PlacementID)))
 		metrics.UpdateCentralRequestsStatusSinceCreatedMetric(constants2.CentralRequestStatusProvisioning, centralRequest.ID, centralRequest.ClusterID, time.Since(centralRequest.CreatedAt))
 	} else {
 		logger.Logger.Infof("central cluster %s is rejected and current status is %s", centralRequest.ID, centralRequest.Status)
@@ -255,6 +455,62 @@ func (d *dataPlaneCentralService) reassignCentralCluster(centralRequest *dbapi.C
 	return nil
 }
 
+// updateCentralMigration handles the status of a central reported by the cluster the central is migrated from, or by
+// the cluster the central is migrated to while it is not the cluster the central is assigned to.
+func (d *dataPlaneCentralService) updateCentralMigration(centralRequest *dbapi.CentralRequest, centralStatus *dbapi.DataPlaneCentralStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	migrationStatus := constants2.CentralMigrationStatus(centralRequest.MigrationStatus)
+	s := getStatus(centralStatus)
+	switch {
+	case migrationStatus == constants2.CentralMigrationStatusScalingDownSource && cluster.ClusterID == centralRequest.MigrationSourceClusterID:
+		if s == statusSuspended {
+			logger.Logger.Infof("Central %s has been scaled down on migration source cluster %s", centralRequest.ID, cluster.ClusterID)
+			return d.migrationService.UpdateMigrationStatus(centralRequest, constants2.CentralMigrationStatusProvisioning, nil)
+		}
+	case migrationStatus == constants2.CentralMigrationStatusProvisioning && cluster.ClusterID == centralRequest.MigrationTargetClusterID:
+		switch s {
+		case statusReady:
+			return d.setCentralMigrationTargetReady(centralRequest, centralStatus, cluster)
+		case statusError, statusRejected, statusDeleted:
+			logger.Logger.Errorf("Central %s could not be provisioned on migration target cluster %s: %s", centralRequest.ID, cluster.ClusterID, s)
+			return d.migrationService.UpdateMigrationStatus(centralRequest, constants2.CentralMigrationStatusDeprovisioningTarget, nil)
+		}
+	case s == statusDeleted && cluster.ClusterID == centralRequest.MigrationTeardownClusterID():
+		if migrationStatus == constants2.CentralMigrationStatusDeprovisioningSource {
+			logger.Logger.Infof("Central %s has been migrated from cluster %s to cluster %s", centralRequest.ID, centralRequest.MigrationSourceClusterID, centralRequest.MigrationTargetClusterID)
+			return d.migrationService.UpdateMigrationStatus(centralRequest, constants2.CentralMigrationStatusCompleted, nil)
+		}
+		logger.Logger.Infof("Central %s has been removed from migration target cluster %s", centralRequest.ID, centralRequest.MigrationTargetClusterID)
+		return d.migrationService.UpdateMigrationStatus(centralRequest, constants2.CentralMigrationStatusFailed, nil)
+	}
+	logger.Logger.V(5).Infof("central %s reported status %s from cluster %s while in migration status %s", centralRequest.ID, s, cluster.ClusterID, migrationStatus)
+	return nil
+}
+
+// setCentralMigrationTargetReady stores the routes reported by the migration target cluster so that the CNAME records
+// can be switched to it.
+func (d *dataPlaneCentralService) setCentralMigrationTargetReady(centralRequest *dbapi.CentralRequest, centralStatus *dbapi.DataPlaneCentralStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	if len(centralStatus.Routes) == 0 {
+		logger.Logger.V(10).Infof("migration target cluster %s did not report routes for central %s yet", cluster.ClusterID, centralRequest.ID)
+		return nil
+	}
+	clusterDNS, err := d.clusterService.GetClusterDNS(cluster.ClusterID)
+	if err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to get DNS entry for cluster %s", cluster.ClusterID)
+	}
+	if routesErr := validateRouters(centralStatus.Routes, centralRequest, clusterDNS); routesErr != nil {
+		return serviceError.NewWithCause(serviceError.ErrorBadRequest, routesErr, "routes are not valid")
+	}
+	if err := centralRequest.SetRoutes(centralStatus.Routes); err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set routes for central %s", centralRequest.ID)
+	}
+
+	// The routes creation ID is reset so that the CNAME records are changed again for the new routes.
+	return d.migrationService.UpdateMigrationStatus(centralRequest, constants2.CentralMigrationStatusSwitchingDNS, map[string]interface{}{
+		"routes":             centralRequest.Routes,
+		"routes_creation_id": "",
+	})
+}
+
 func getStatus(status *dbapi.DataPlaneCentralStatus) centralStatus {
 	for _, c := range status.Conditions {
 		if strings.EqualFold(c.Type, "Ready") {
@@ -276,6 +532,9 @@ func getStatus(status *dbapi.DataPlaneCentralStatus) centralStatus {
 			if strings.EqualFold(c.Reason, "Rejected") {
 				return statusRejected
 			}
+			if strings.EqualFold(c.Reason, "Suspended") {
+				return statusSuspended
+			}
 		}
 	}
 	return statusInstalling
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataPlaneCentralServiceSetCentralClusterReady(t *testing.T) {
+	tt := []struct {
+		description   string
+		status        constants.CentralStatus
+		expectedEvent bool
+	}{
+		{
+			description:   "should record an event when a provisioning central becomes ready",
+			status:        constants.CentralRequestStatusProvisioning,
+			expectedEvent: true,
+		},
+		{
+			description: "should not record an event for a central which is ready already",
+			status:      constants.CentralRequestStatusReady,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			var events []*dbapi.CentralEvent
+			dinosaurService := &DinosaurServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+					return &dbapi.CentralRequest{Status: tc.status.String()}, nil
+				},
+				// Like gorm, the mock writes the updated values into the central.
+				UpdatesFunc: func(centralRequest *dbapi.CentralRequest, values map[string]interface{}, updateEvents ...*dbapi.CentralEvent) *serviceErrors.ServiceError {
+					centralRequest.Status = values["status"].(string)
+					events = updateEvents
+					return nil
+				},
+			}
+			service := NewDataPlaneCentralService(dinosaurService, nil, nil, nil, nil)
+
+			central := &dbapi.CentralRequest{Status: tc.status.String(), RoutesCreated: true}
+			require.Nil(t, service.setCentralClusterReady(central))
+
+			assert.Equal(t, constants.CentralRequestStatusReady.String(), central.Status)
+			if !tc.expectedEvent {
+				assert.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 1)
+			assert.Equal(t, tc.status.String(), events[0].FromStatus)
+			assert.Equal(t, constants.CentralRequestStatusReady.String(), events[0].ToStatus)
+		})
+	}
+}
+
+func TestDataPlaneCentralServiceUpdateCentralMigration(t *testing.T) {
+	const sourceClusterID, targetClusterID = "source", "target"
+	tt := []struct {
+		description     string
+		migrationStatus constants.CentralMigrationStatus
+		clusterID       string
+		reason          string
+		ready           bool
+		expectedStatus  constants.CentralMigrationStatus
+	}{
+		{
+			description:     "should provision the target once the central is scaled down on the source",
+			migrationStatus: constants.CentralMigrationStatusScalingDownSource,
+			clusterID:       sourceClusterID,
+			reason:          "Suspended",
+			expectedStatus:  constants.CentralMigrationStatusProvisioning,
+		},
+		{
+			description:     "should wait while the central is still running on the source",
+			migrationStatus: constants.CentralMigrationStatusScalingDownSource,
+			clusterID:       sourceClusterID,
+			ready:           true,
+		},
+		{
+			description:     "should ignore the target while the central is scaled down on the source",
+			migrationStatus: constants.CentralMigrationStatusScalingDownSource,
+			clusterID:       targetClusterID,
+			ready:           true,
+		},
+		{
+			description:     "should wait for routes of the ready central on the target",
+			migrationStatus: constants.CentralMigrationStatusProvisioning,
+			clusterID:       targetClusterID,
+			ready:           true,
+		},
+		{
+			description:     "should remove the central from the target if it fails there",
+			migrationStatus: constants.CentralMigrationStatusProvisioning,
+			clusterID:       targetClusterID,
+			reason:          "Error",
+			expectedStatus:  constants.CentralMigrationStatusDeprovisioningTarget,
+		},
+		{
+			description:     "should complete the migration once the central is removed from the source",
+			migrationStatus: constants.CentralMigrationStatusDeprovisioningSource,
+			clusterID:       sourceClusterID,
+			reason:          "Deleted",
+			expectedStatus:  constants.CentralMigrationStatusCompleted,
+		},
+		{
+			description:     "should fail the migration once the central is removed from the target",
+			migrationStatus: constants.CentralMigrationStatusDeprovisioningTarget,
+			clusterID:       targetClusterID,
+			reason:          "Deleted",
+			expectedStatus:  constants.CentralMigrationStatusFailed,
+		},
+		{
+			description:     "should not fail the migration for the scaled down central on the source",
+			migrationStatus: constants.CentralMigrationStatusDeprovisioningTarget,
+			clusterID:       sourceClusterID,
+			reason:          "Suspended",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			migrationService := &CentralMigrationServiceMock{
+				UpdateMigrationStatusFunc: func(central *dbapi.CentralRequest, status constants.CentralMigrationStatus, fields map[string]interface{}) *serviceErrors.ServiceError {
+					return nil
+				},
+			}
+			service := NewDataPlaneCentralService(nil, nil, migrationService, nil, nil)
+
+			central := &dbapi.CentralRequest{
+				MigrationStatus:          tc.migrationStatus.String(),
+				MigrationSourceClusterID: sourceClusterID,
+				MigrationTargetClusterID: targetClusterID,
+				ClusterID:                sourceClusterID,
+			}
+			if tc.migrationStatus == constants.CentralMigrationStatusDeprovisioningSource {
+				central.ClusterID = targetClusterID
+			}
+			condition := dbapi.DataPlaneCentralStatusCondition{Type: "Ready", Status: "False", Reason: tc.reason}
+			if tc.ready {
+				condition = dbapi.DataPlaneCentralStatusCondition{Type: "Ready", Status: "True"}
+			}
+			centralStatus := &dbapi.DataPlaneCentralStatus{Conditions: []dbapi.DataPlaneCentralStatusCondition{condition}}
+
+			require.Nil(t, service.updateCentralMigration(central, centralStatus, &api.Cluster{ClusterID: tc.clusterID}))
+
+			calls := migrationService.UpdateMigrationStatusCalls()
+			if tc.expectedStatus == "" {
+				assert.Empty(t, calls)
+				return
+			}
+			require.Len(t, calls, 1)
+			assert.Equal(t, tc.expectedStatus, calls[0].Status)
+		})
+	}
+}
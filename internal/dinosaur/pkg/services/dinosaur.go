@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"sync"
 	"time"
@@ -26,9 +27,16 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/logger"
 	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
+	// incrementResourceVersion bumps the resource version of the centrals updated in bulk, so that concurrent updates
+	// of single centrals based on a previous version fail.
+	incrementResourceVersion = gorm.Expr("resource_version + 1")
+
 	dinosaurDeletionStatuses = []string{
 		dinosaurConstants.CentralRequestStatusDeleting.String(),
 		dinosaurConstants.CentralRequestStatusDeprovision.String(),
@@ -39,6 +47,33 @@ var (
 		dinosaurConstants.CentralRequestStatusDeprovision.String(),
 		dinosaurConstants.CentralRequestStatusReady.String(),
 		dinosaurConstants.CentralRequestStatusFailed.String(),
+		dinosaurConstants.CentralRequestStatusSuspending.String(),
+		dinosaurConstants.CentralRequestStatusSuspended.String(),
+		dinosaurConstants.CentralRequestStatusResuming.String(),
+	}
+
+	// centralSearchColumns are the columns users can search centrals by.
+	centralSearchColumns = []string{"region", "name", "cloud_provider", "status", "owner", "instance_type", "created_at", "updated_at"}
+
+	// adminCentralSearchColumns are the columns admins can search centrals by.
+	adminCentralSearchColumns = []string{"region", "name", "cloud_provider", "status", "owner", "instance_type", "created_at", "updated_at",
+		"id", "organisation_id", "cluster_id", "cloud_account_id"}
+
+	// centralOrderByColumns are the columns centrals can be ordered by, mapped to the values of the columns for a
+	// central. The values of the last central of a page are stored in the token of the next page.
+	centralOrderByColumns = map[string]func(central *dbapi.CentralRequest) interface{}{
+		"cloud_provider":  func(central *dbapi.CentralRequest) interface{} { return central.CloudProvider },
+		"cluster_id":      func(central *dbapi.CentralRequest) interface{} { return central.ClusterID },
+		"created_at":      func(central *dbapi.CentralRequest) interface{} { return central.CreatedAt },
+		"id":              func(central *dbapi.CentralRequest) interface{} { return central.ID },
+		"instance_type":   func(central *dbapi.CentralRequest) interface{} { return central.InstanceType },
+		"multi_az":        func(central *dbapi.CentralRequest) interface{} { return central.MultiAZ },
+		"name":            func(central *dbapi.CentralRequest) interface{} { return central.Name },
+		"organisation_id": func(central *dbapi.CentralRequest) interface{} { return central.OrganisationID },
+		"owner":           func(central *dbapi.CentralRequest) interface{} { return central.Owner },
+		"region":          func(central *dbapi.CentralRequest) interface{} { return central.Region },
+		"status":          func(central *dbapi.CentralRequest) interface{} { return central.Status },
+		"updated_at":      func(central *dbapi.CentralRequest) interface{} { return central.UpdatedAt },
 	}
 )
 
@@ -51,6 +86,9 @@ const DinosaurRoutesActionCreate DinosaurRoutesAction = "CREATE"
 // DinosaurRoutesActionDelete ...
 const DinosaurRoutesActionDelete DinosaurRoutesAction = "DELETE"
 
+// DinosaurRoutesActionUpsert ...
+const DinosaurRoutesActionUpsert DinosaurRoutesAction = "UPSERT"
+
 // CNameRecordStatus ...
 type CNameRecordStatus struct {
 	ID     *string
@@ -76,7 +114,9 @@ type DinosaurService interface {
 	GetByID(id string) (*dbapi.CentralRequest, *errors.ServiceError)
 	// Delete cleans up all dependencies for a Dinosaur request and soft deletes the Dinosaur Request record from the database.
 	// The Dinosaur Request in the database will be updated with a deleted_at timestamp.
-	Delete(centralRequest *dbapi.CentralRequest, force bool) *errors.ServiceError
+	// If resourceVersion is set, the Dinosaur Request is only deleted if it still has this resource version,
+	// otherwise a precondition failed error is returned.
+	Delete(centralRequest *dbapi.CentralRequest, force bool, resourceVersion *int64) *errors.ServiceError
 	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.CentralList, *api.PagingMeta, *errors.ServiceError)
 	ListByClusterID(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError)
 	RegisterDinosaurJob(dinosaurRequest *dbapi.CentralRequest) *errors.ServiceError
@@ -85,20 +125,32 @@ type DinosaurService interface {
 	// The returned boolean is to be used to know if the update has been tried or not. An update is not tried if the
 	// original status is 'deprovision' (cluster in deprovision state can't be change state) or if the final status is the
 	// same as the original status. The error will contain any error encountered when attempting to update or the reason
-	// why no attempt has been done
-	UpdateStatus(id string, status dinosaurConstants.CentralStatus) (bool, *errors.ServiceError)
-	Update(dinosaurRequest *dbapi.CentralRequest) *errors.ServiceError
+	// why no attempt has been done. The given events are recorded in the transaction of the update.
+	UpdateStatus(id string, status dinosaurConstants.CentralStatus, events ...*dbapi.CentralEvent) (bool, *errors.ServiceError)
+	// Update updates a dinosaur. The given events are recorded in the transaction of the update, and only if the
+	// dinosaur has been updated.
+	Update(dinosaurRequest *dbapi.CentralRequest, events ...*dbapi.CentralEvent) *errors.ServiceError
 	// Updates() updates the given fields of a dinosaur. This takes in a map so that even zero-fields can be updated.
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `DinosaurService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
-	Updates(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError
+	// The given events are recorded like by Update.
+	Updates(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *errors.ServiceError
 	ChangeDinosaurCNAMErecords(dinosaurRequest *dbapi.CentralRequest, action DinosaurRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
 	GetCNAMERecordStatus(dinosaurRequest *dbapi.CentralRequest) (*CNameRecordStatus, error)
 	DetectInstanceType(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType
-	RegisterDinosaurDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
+	// RegisterDinosaurDeprovisionJob registers a dinosaur for deprovisioning. If resourceVersion is set, the dinosaur
+	// is only deprovisioned if it still has this resource version, otherwise a precondition failed error is returned.
+	RegisterDinosaurDeprovisionJob(ctx context.Context, id string, resourceVersion *int64) *errors.ServiceError
 	// DeprovisionDinosaurForUsers registers all dinosaurs for deprovisioning given the list of owners
 	DeprovisionDinosaurForUsers(users []string) *errors.ServiceError
-	DeprovisionExpiredDinosaurs(dinosaurAgeInHours int) *errors.ServiceError
+	// DeprovisionExpiredDinosaurs registers all dinosaurs whose expiration time has passed for deprovisioning
+	DeprovisionExpiredDinosaurs() *errors.ServiceError
+	// SetMissingExpirations sets the expiration of centrals which were created before expirations were stored, from the
+	// configured lifespan of their instance type
+	SetMissingExpirations() *errors.ServiceError
+	// WarnExpiringCentrals records an expiry warning event for every central which expires within the given period
+	// and has not been warned about yet
+	WarnExpiringCentrals(warningPeriod time.Duration) *errors.ServiceError
 	CountByStatus(status []dinosaurConstants.CentralStatus) ([]DinosaurStatusCount, error)
 	CountByRegionAndInstanceType() ([]DinosaurRegionCount, error)
 	ListDinosaursWithRoutesNotCreated() ([]*dbapi.CentralRequest, *errors.ServiceError)
@@ -121,10 +173,11 @@ type dinosaurService struct {
 	authService              authorization.Authorization
 	dataplaneClusterConfig   *config.DataplaneClusterConfig
 	clusterPlacementStrategy ClusterPlacementStrategy
+	centralEventService      CentralEventService
 }
 
 // NewDinosaurService ...
-func NewDinosaurService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, iamService sso.IAMService, dinosaurConfig *config.CentralConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, clusterPlacementStrategy ClusterPlacementStrategy) *dinosaurService {
+func NewDinosaurService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, iamService sso.IAMService, dinosaurConfig *config.CentralConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, clusterPlacementStrategy ClusterPlacementStrategy, centralEventService CentralEventService) *dinosaurService {
 	return &dinosaurService{
 		connectionFactory:        connectionFactory,
 		clusterService:           clusterService,
@@ -136,6 +189,7 @@ func NewDinosaurService(connectionFactory *db.ConnectionFactory, clusterService
 		authService:              authorizationService,
 		dataplaneClusterConfig:   dataplaneClusterConfig,
 		clusterPlacementStrategy: clusterPlacementStrategy,
+		centralEventService:      centralEventService,
 	}
 }
 
@@ -161,7 +215,11 @@ func (k *dinosaurService) HasAvailableCapacityInRegion(dinosaurRequest *dbapi.Ce
 
 	dbConn := k.connectionFactory.New()
 	var count int64
-	if err := dbConn.Model(&dbapi.CentralRequest{}).Where("region = ?", dinosaurRequest.Region).Count(&count).Error; err != nil {
+	// Suspended centrals do not use any capacity of the clusters in the region.
+	if err := dbConn.Model(&dbapi.CentralRequest{}).
+		Where("region = ?", dinosaurRequest.Region).
+		Where("status != ?", dinosaurConstants.CentralRequestStatusSuspended.String()).
+		Count(&count).Error; err != nil {
 		return false, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count central request")
 	}
 
@@ -242,8 +300,17 @@ func (k *dinosaurService) RegisterDinosaurJob(dinosaurRequest *dbapi.CentralRequ
 	instanceType := k.DetectInstanceType(dinosaurRequest)
 
 	dinosaurRequest.InstanceType = instanceType.String()
+	if lifespan := k.dinosaurConfig.CentralLifespan.LifespanForInstanceType(instanceType); lifespan > 0 {
+		expiresAt := time.Now().Add(lifespan)
+		dinosaurRequest.ExpiresAt = &expiresAt
+	}
 
 	cluster, e := k.clusterPlacementStrategy.FindCluster(dinosaurRequest)
+	var svcErr *errors.ServiceError
+	if goerrors.As(e, &svcErr) && svcErr.Code == errors.ErrorRegionNotSupported {
+		logger.Logger.Errorf("No cluster found for central instance in cloud provider '%s' and region: '%s'", dinosaurRequest.CloudProvider, dinosaurRequest.Region)
+		return svcErr
+	}
 	if e != nil || cluster == nil {
 		msg := fmt.Sprintf("No available cluster found for '%s' central instance in region: '%s'", dinosaurRequest.InstanceType, dinosaurRequest.Region)
 		logger.Logger.Errorf(msg)
@@ -255,7 +322,6 @@ func (k *dinosaurService) RegisterDinosaurJob(dinosaurRequest *dbapi.CentralRequ
 		return err
 	}
 
-	dbConn := k.connectionFactory.New()
 	dinosaurRequest.Status = dinosaurConstants.CentralRequestStatusAccepted.String()
 	dinosaurRequest.SubscriptionID = subscriptionID
 	glog.Infof("Central request %s has been assigned the subscription %s.", dinosaurRequest.ID, subscriptionID)
@@ -264,8 +330,16 @@ func (k *dinosaurService) RegisterDinosaurJob(dinosaurRequest *dbapi.CentralRequ
 	// the API is restarted this time changing the --quota-type flag to quota-management-list, when dinosaur A is deleted at this point,
 	// we want to use the correct quota to perform the deletion.
 	dinosaurRequest.QuotaType = k.dinosaurConfig.Quota.Type
-	if err := dbConn.Create(dinosaurRequest).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create central request") // hide the db error to http caller
+	if svcErr := k.inTransaction(true, func(tx *gorm.DB) *errors.ServiceError {
+		if err := tx.Create(dinosaurRequest).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create central request") // hide the db error to http caller
+		}
+		return k.recordEvents(tx, []*dbapi.CentralEvent{
+			dbapi.NewCentralStatusEvent(dinosaurRequest, "", dinosaurConstants.CentralRequestStatusAccepted, dinosaurRequest.Owner, "central requested"),
+			dbapi.NewCentralPlacementEvent(dinosaurRequest, dinosaurConstants.CentralEventActorFleetManager, "selected by the cluster placement strategy"),
+		})
+	}); svcErr != nil {
+		return svcErr
 	}
 	metrics.UpdateCentralRequestsStatusSinceCreatedMetric(dinosaurConstants.CentralRequestStatusAccepted, dinosaurRequest.ID, dinosaurRequest.ClusterID, time.Since(dinosaurRequest.CreatedAt))
 	return nil
@@ -300,14 +374,18 @@ func (k *dinosaurService) AcceptCentralRequest(centralRequest *dbapi.CentralRequ
 		Meta: api.Meta{
 			ID: centralRequest.ID,
 		},
-		Host:        centralRequest.Host,
-		PlacementID: api.NewID(),
-		Status:      dinosaurConstants.CentralRequestStatusPreparing.String(),
-		Namespace:   centralRequest.Namespace,
+		Host:            centralRequest.Host,
+		PlacementID:     api.NewID(),
+		Status:          dinosaurConstants.CentralRequestStatusPreparing.String(),
+		Namespace:       centralRequest.Namespace,
+		ResourceVersion: centralRequest.ResourceVersion,
 	}
-	if err := k.Update(updatedDinosaurRequest); err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update central request")
+	event := dbapi.NewCentralStatusEvent(centralRequest, dinosaurConstants.CentralStatus(centralRequest.Status),
+		dinosaurConstants.CentralRequestStatusPreparing, dinosaurConstants.CentralEventActorFleetManager, "")
+	if err := k.Update(updatedDinosaurRequest, event); err != nil {
+		return errors.NewWithCause(err.Code, err, "failed to update central request")
 	}
+	centralRequest.ResourceVersion = updatedDinosaurRequest.ResourceVersion
 
 	return nil
 }
@@ -333,11 +411,15 @@ func (k *dinosaurService) PrepareDinosaurRequest(dinosaurRequest *dbapi.CentralR
 		Meta: api.Meta{
 			ID: dinosaurRequest.ID,
 		},
-		Status: dinosaurConstants.CentralRequestStatusProvisioning.String(),
+		Status:          dinosaurConstants.CentralRequestStatusProvisioning.String(),
+		ResourceVersion: dinosaurRequest.ResourceVersion,
 	}
-	if err := k.Update(updatedCentralRequest); err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update central request")
+	event := dbapi.NewCentralStatusEvent(dinosaurRequest, dinosaurConstants.CentralStatus(dinosaurRequest.Status),
+		dinosaurConstants.CentralRequestStatusProvisioning, dinosaurConstants.CentralEventActorFleetManager, "auth config is ready")
+	if err := k.Update(updatedCentralRequest, event); err != nil {
+		return errors.NewWithCause(err.Code, err, "failed to update central request")
 	}
+	dinosaurRequest.ResourceVersion = updatedCentralRequest.ResourceVersion
 
 	return nil
 }
@@ -415,7 +497,7 @@ func (k *dinosaurService) GetByID(id string) (*dbapi.CentralRequest, *errors.Ser
 }
 
 // RegisterDinosaurDeprovisionJob registers a dinosaur deprovision job in the dinosaur table
-func (k *dinosaurService) RegisterDinosaurDeprovisionJob(ctx context.Context, id string) *errors.ServiceError {
+func (k *dinosaurService) RegisterDinosaurDeprovisionJob(ctx context.Context, id string, resourceVersion *int64) *errors.ServiceError {
 	if id == "" {
 		return errors.Validation("id is undefined")
 	}
@@ -442,12 +524,22 @@ func (k *dinosaurService) RegisterDinosaurDeprovisionJob(ctx context.Context, id
 	if err := dbConn.First(&dinosaurRequest).Error; err != nil {
 		return services.HandleGetError("CentralResource", "id", id, err)
 	}
+	if resourceVersion != nil && dinosaurRequest.ResourceVersion != *resourceVersion {
+		return errors.PreconditionFailed("central %s has been modified", id)
+	}
 	metrics.IncreaseCentralTotalOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
 
 	deprovisionStatus := dinosaurConstants.CentralRequestStatusDeprovision
 
-	if executed, err := k.UpdateStatus(id, deprovisionStatus); executed {
+	user, _ := claims.GetUsername()
+	event := dbapi.NewCentralStatusEvent(&dinosaurRequest, dinosaurConstants.CentralStatus(dinosaurRequest.Status),
+		deprovisionStatus, user, "deletion requested")
+	// The status is only updated if the central has not been modified since it was read above.
+	if executed, err := k.updateStatus(&dinosaurRequest, deprovisionStatus, event); executed {
 		if err != nil {
+			if err.Code == errors.ErrorPreconditionFailed {
+				return err
+			}
 			return services.HandleGetError("CentralResource", "id", id, err)
 		}
 		metrics.IncreaseCentralSuccessOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
@@ -459,25 +551,18 @@ func (k *dinosaurService) RegisterDinosaurDeprovisionJob(ctx context.Context, id
 
 // DeprovisionDinosaurForUsers registers all dinosaurs for deprovisioning given the list of owners
 func (k *dinosaurService) DeprovisionDinosaurForUsers(users []string) *errors.ServiceError {
-	now := time.Now()
-	dbConn := k.connectionFactory.New().
-		Model(&dbapi.CentralRequest{}).
-		Where("owner IN (?)", users).
-		Where("status NOT IN (?)", dinosaurDeletionStatuses).
-		Updates(map[string]interface{}{
-			"status":             dinosaurConstants.CentralRequestStatusDeprovision,
-			"deletion_timestamp": now,
-		})
+	query := k.connectionFactory.New().
+		Where("owner IN (?)", users)
 
-	err := dbConn.Error
+	deprovisioned, err := k.deprovisionCentrals(query, "owner is no longer allowed to use the service")
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to deprovision central requests for users")
 	}
 
-	if dbConn.RowsAffected >= 1 {
-		glog.Infof("%v centrals are now deprovisioning for users %v", dbConn.RowsAffected, users)
+	if deprovisioned >= 1 {
+		glog.Infof("%v centrals are now deprovisioning for users %v", deprovisioned, users)
 		var counter int64
-		for ; counter < dbConn.RowsAffected; counter++ {
+		for ; counter < deprovisioned; counter++ {
 			metrics.IncreaseCentralTotalOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
 			metrics.IncreaseCentralSuccessOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
 		}
@@ -487,27 +572,19 @@ func (k *dinosaurService) DeprovisionDinosaurForUsers(users []string) *errors.Se
 }
 
 // DeprovisionExpiredDinosaurs cleaning up expired dinosaurs
-func (k *dinosaurService) DeprovisionExpiredDinosaurs(dinosaurAgeInHours int) *errors.ServiceError {
-	now := time.Now()
-	dbConn := k.connectionFactory.New().
-		Model(&dbapi.CentralRequest{}).
-		Where("instance_type = ?", types.EVAL.String()).
-		Where("created_at  <=  ?", now.Add(-1*time.Duration(dinosaurAgeInHours)*time.Hour)).
-		Where("status NOT IN (?)", dinosaurDeletionStatuses)
+func (k *dinosaurService) DeprovisionExpiredDinosaurs() *errors.ServiceError {
+	query := k.connectionFactory.New().
+		Where("expires_at <= ?", time.Now())
 
-	db := dbConn.Updates(map[string]interface{}{
-		"status":             dinosaurConstants.CentralRequestStatusDeprovision,
-		"deletion_timestamp": now,
-	})
-	err := db.Error
+	deprovisioned, err := k.deprovisionCentrals(query, "central expired")
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision expired centrals")
 	}
 
-	if db.RowsAffected >= 1 {
-		glog.Infof("%v central_request's lifespans are over %d hours and have had their status updated to deprovisioning", db.RowsAffected, dinosaurAgeInHours)
+	if deprovisioned >= 1 {
+		glog.Infof("%v central_request's have expired and have had their status updated to deprovisioning", deprovisioned)
 		var counter int64
-		for ; counter < db.RowsAffected; counter++ {
+		for ; counter < deprovisioned; counter++ {
 			metrics.IncreaseCentralTotalOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
 			metrics.IncreaseCentralSuccessOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
 		}
@@ -516,13 +593,117 @@ func (k *dinosaurService) DeprovisionExpiredDinosaurs(dinosaurAgeInHours int) *e
 	return nil
 }
 
+// SetMissingExpirations ...
+func (k *dinosaurService) SetMissingExpirations() *errors.ServiceError {
+	lifespan := k.dinosaurConfig.CentralLifespan.LifespanForInstanceType(types.EVAL)
+	if lifespan == 0 {
+		return nil
+	}
+	result := k.connectionFactory.New().
+		Model(&dbapi.CentralRequest{}).
+		Where("instance_type = ?", types.EVAL.String()).
+		Where("expires_at IS NULL").
+		Updates(map[string]interface{}{
+			"expires_at":       gorm.Expr("created_at + ? * interval '1 second'", int64(lifespan.Seconds())),
+			"resource_version": incrementResourceVersion,
+		})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to set missing expirations of centrals")
+	}
+	if result.RowsAffected > 0 {
+		glog.Infof("Set the expiration of %d eval centrals created before expirations were stored", result.RowsAffected)
+	}
+	return nil
+}
+
+// WarnExpiringCentrals ...
+func (k *dinosaurService) WarnExpiringCentrals(warningPeriod time.Duration) *errors.ServiceError {
+	var centrals dbapi.CentralList
+	err := k.connectionFactory.New().
+		Where("expires_at <= ?", time.Now().Add(warningPeriod)).
+		Where("expiry_warning_sent = ?", false).
+		Where("status NOT IN (?)", dinosaurDeletionStatuses).
+		Find(&centrals).Error
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to list expiring centrals")
+	}
+
+	for _, central := range centrals {
+		central := central
+		if svcErr := k.inTransaction(true, func(tx *gorm.DB) *errors.ServiceError {
+			// Only the flag is updated, so that the warning does not conflict with concurrent updates of the central.
+			result := tx.
+				Model(&dbapi.CentralRequest{}).
+				Where("id = ?", central.ID).
+				Where("expiry_warning_sent = ?", false).
+				Update("expiry_warning_sent", true)
+			if result.Error != nil {
+				return errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to update expiry warning of central %s", central.ID)
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+			glog.Infof("Central %s expires at %s", central.ID, central.ExpiresAt.Format(time.RFC3339))
+			return k.recordEvents(tx, []*dbapi.CentralEvent{dbapi.NewCentralExpiryWarningEvent(central,
+				fmt.Sprintf("central expires at %s", central.ExpiresAt.UTC().Format(time.RFC3339)))})
+		}); svcErr != nil {
+			return svcErr
+		}
+	}
+
+	return nil
+}
+
+// deprovisionCentrals registers the centrals selected by the given query for deprovisioning, unless they are being
+// deleted already, and records the status change with the given reason. It returns the number of updated centrals.
+func (k *dinosaurService) deprovisionCentrals(query *gorm.DB, reason string) (int64, error) {
+	var centrals dbapi.CentralList
+	if err := query.Where("status NOT IN (?)", dinosaurDeletionStatuses).Find(&centrals).Error; err != nil {
+		return 0, fmt.Errorf("listing centrals to deprovision: %w", err)
+	}
+	if len(centrals) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, 0, len(centrals))
+	for _, central := range centrals {
+		ids = append(ids, central.ID)
+	}
+
+	events := make([]*dbapi.CentralEvent, 0, len(centrals))
+	for _, central := range centrals {
+		events = append(events, dbapi.NewCentralStatusEvent(central, dinosaurConstants.CentralStatus(central.Status),
+			dinosaurConstants.CentralRequestStatusDeprovision, dinosaurConstants.CentralEventActorFleetManager, reason))
+	}
+	var deprovisioned int64
+	if svcErr := k.inTransaction(true, func(tx *gorm.DB) *errors.ServiceError {
+		result := tx.
+			Model(&dbapi.CentralRequest{}).
+			Where("id IN (?)", ids).
+			Where("status NOT IN (?)", dinosaurDeletionStatuses).
+			Updates(map[string]interface{}{
+				"status":             dinosaurConstants.CentralRequestStatusDeprovision,
+				"deletion_timestamp": time.Now(),
+				"resource_version":   incrementResourceVersion,
+			})
+		if result.Error != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, result.Error, "updating status of centrals to deprovision")
+		}
+		deprovisioned = result.RowsAffected
+		return k.recordEvents(tx, events)
+	}); svcErr != nil {
+		return 0, svcErr
+	}
+	return deprovisioned, nil
+}
+
 // Delete a CentralRequest from the database.
 // The implementation uses soft-deletion (via GORM).
 // If the force flag is true, then any errors prior to the final deletion of the CentralRequest will be logged as warnings
 // but do not interrupt the deletion flow.
-func (k *dinosaurService) Delete(centralRequest *dbapi.CentralRequest, force bool) *errors.ServiceError {
-	dbConn := k.connectionFactory.New()
-
+func (k *dinosaurService) Delete(centralRequest *dbapi.CentralRequest, force bool, resourceVersion *int64) *errors.ServiceError {
+	if resourceVersion != nil && centralRequest.ResourceVersion != *resourceVersion {
+		return errors.PreconditionFailed("central %s has been modified", centralRequest.ID)
+	}
 	// if the we don't have the clusterID we can only delete the row from the database
 	if centralRequest.ClusterID != "" {
 		routes, err := centralRequest.GetRoutes()
@@ -544,11 +725,27 @@ func (k *dinosaurService) Delete(centralRequest *dbapi.CentralRequest, force boo
 		}
 	}
 
+	deletionReason := "all resources have been cleaned up"
+	if force {
+		deletionReason = "forced deletion"
+	}
 	// soft delete the dinosaur request
-	if err := dbConn.Delete(centralRequest).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete central request with id %s", centralRequest.ID)
+	if svcErr := k.inTransaction(true, func(tx *gorm.DB) *errors.ServiceError {
+		query := tx
+		if resourceVersion != nil {
+			query = query.Where("resource_version = ?", *resourceVersion)
+		}
+		result := query.Delete(centralRequest)
+		if result.Error != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to delete central request with id %s", centralRequest.ID)
+		}
+		if resourceVersion != nil && result.RowsAffected == 0 {
+			return errors.PreconditionFailed("central %s has been modified concurrently", centralRequest.ID)
+		}
+		return k.recordEvents(tx, []*dbapi.CentralEvent{dbapi.NewCentralDeletionEvent(centralRequest, dinosaurConstants.CentralEventActorFleetManager, deletionReason)})
+	}); svcErr != nil {
+		return svcErr
 	}
-
 	glog.Infof("Successfully deleted Central tenant %q in the database.", centralRequest.ID)
 	if force {
 		glog.Infof("Make sure any other resources belonging to the Central tenant %q are manually deleted.", centralRequest.ID)
@@ -573,7 +770,10 @@ func (k *dinosaurService) List(ctx context.Context, listArgs *services.ListArgum
 		return nil, nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
 	}
 
+	searchColumns := adminCentralSearchColumns
 	if !auth.GetIsAdminFromContext(ctx) {
+		searchColumns = centralSearchColumns
+
 		user, _ := claims.GetUsername()
 		if user == "" {
 			return nil, nil, errors.Unauthenticated("user not authenticated")
@@ -594,44 +794,104 @@ func (k *dinosaurService) List(ctx context.Context, listArgs *services.ListArgum
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		searchDbQuery, err := coreServices.NewQueryParser().Parse(listArgs.Search)
+		searchDbQuery, err := coreServices.NewQueryParser(searchColumns...).Parse(listArgs.Search)
 		if err != nil {
 			return dinosaurRequestList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list central requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
 
-	if len(listArgs.OrderBy) == 0 {
-		// default orderBy name
-		dbConn = dbConn.Order("name")
+	orderBy, svcErr := centralListOrder(listArgs)
+	if svcErr != nil {
+		return dinosaurRequestList, pagingMeta, svcErr
 	}
-
-	// Set the order by arguments if any
-	for _, orderByArg := range listArgs.OrderBy {
-		dbConn = dbConn.Order(orderByArg)
+	for _, field := range orderBy {
+		dbConn = dbConn.Order(clause.OrderByColumn{Column: clause.Column{Name: field.Column}, Desc: field.Desc})
 	}
 
 	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
 	total := int64(pagingMeta.Total)
 	dbConn.Model(&dinosaurRequestList).Count(&total)
 	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	if listArgs.PageToken != "" {
+		// the page starts after the last central of the previous page, instead of at an offset
+		values, err := services.DecodePageToken(listArgs, orderBy, centralOrderByValues(&dbapi.CentralRequest{}, orderBy))
+		if err != nil {
+			return dinosaurRequestList, pagingMeta, errors.NewWithCause(errors.ErrorBadRequest, err, "Unable to list central requests: %s", err.Error())
+		}
+		condition, args := services.KeysetCondition(orderBy, values)
+		dbConn = dbConn.Where(condition, args...)
+		pagingMeta.Page = 0
+	} else {
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size)
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+	// one more central than requested is fetched to know whether there is a next page
+	dbConn = dbConn.Limit(pagingMeta.Size + 1)
 
 	// execute query
 	if err := dbConn.Find(&dinosaurRequestList).Error; err != nil {
 		return dinosaurRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list central requests")
 	}
 
+	if len(dinosaurRequestList) > pagingMeta.Size {
+		dinosaurRequestList = dinosaurRequestList[:pagingMeta.Size]
+		// an empty page has no last central to continue after
+		if pagingMeta.Size > 0 {
+			last := dinosaurRequestList[len(dinosaurRequestList)-1]
+			token, err := services.EncodePageToken(listArgs, orderBy, centralOrderByValues(last, orderBy))
+			if err != nil {
+				return dinosaurRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list central requests")
+			}
+			pagingMeta.NextPageToken = token
+		}
+	}
+	if listArgs.PageToken != "" {
+		pagingMeta.Size = len(dinosaurRequestList)
+	}
+
 	return dinosaurRequestList, pagingMeta, nil
 }
 
-// ListByClusterID returns a list of CentralRequests with specified clusterID
+// centralListOrder returns the validated order of a list of centrals. Centrals are ordered by name by default, and
+// by id when the other fields are equal, so that page tokens identify a position in the list unambiguously.
+func centralListOrder(listArgs *services.ListArguments) ([]services.OrderByField, *errors.ServiceError) {
+	orderBy, err := listArgs.OrderByFields()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorBadRequest, err, "Unable to list central requests: %s", err.Error())
+	}
+	if len(orderBy) == 0 {
+		orderBy = []services.OrderByField{{Column: "name"}}
+	}
+	orderedByID := false
+	for _, field := range orderBy {
+		if _, ok := centralOrderByColumns[field.Column]; !ok {
+			return nil, errors.BadRequest("Unable to list central requests: unknown order by field '%s'", field.Column)
+		}
+		orderedByID = orderedByID || field.Column == "id"
+	}
+	if !orderedByID {
+		orderBy = append(orderBy, services.OrderByField{Column: "id"})
+	}
+	return orderBy, nil
+}
+
+func centralOrderByValues(central *dbapi.CentralRequest, orderBy []services.OrderByField) []interface{} {
+	values := make([]interface{}, 0, len(orderBy))
+	for _, field := range orderBy {
+		values = append(values, centralOrderByColumns[field.Column](central))
+	}
+	return values
+}
+
+// ListByClusterID returns a list of CentralRequests with specified clusterID. This includes CentralRequests which are
+// migrated from the cluster, and CentralRequests migrated to the cluster once they are scaled down on the source.
 func (k *dinosaurService) ListByClusterID(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New().
-		Where("cluster_id = ?", clusterID).
+		Where("cluster_id = ? OR (migration_status IN (?) AND migration_source_cluster_id = ?) OR (migration_status IN (?) AND migration_target_cluster_id = ?)",
+			clusterID, dinosaurConstants.GetActiveMigrationStatuses(), clusterID, dinosaurConstants.GetMigrationTargetStatuses(), clusterID).
 		Where("status IN (?)", dinosaurManagedCRStatuses).
 		Where("host != ''")
 
@@ -644,29 +904,71 @@ func (k *dinosaurService) ListByClusterID(clusterID string) ([]*dbapi.CentralReq
 }
 
 // Update ...
-func (k *dinosaurService) Update(dinosaurRequest *dbapi.CentralRequest) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(dinosaurRequest).
-		Where("status not IN (?)", dinosaurDeletionStatuses) // ignore updates of dinosaur under deletion
-
-	if err := dbConn.Updates(dinosaurRequest).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central")
-	}
-
-	return nil
+func (k *dinosaurService) Update(dinosaurRequest *dbapi.CentralRequest, events ...*dbapi.CentralEvent) *errors.ServiceError {
+	version := dinosaurRequest.ResourceVersion
+	dinosaurRequest.ResourceVersion = version + 1
+	updated, err := k.compareAndSwap(dinosaurRequest, version, dinosaurRequest, events)
+	if !updated {
+		dinosaurRequest.ResourceVersion = version
+	}
+	return err
 }
 
 // Updates ...
-func (k *dinosaurService) Updates(dinosaurRequest *dbapi.CentralRequest, fields map[string]interface{}) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(dinosaurRequest).
-		Where("status not IN (?)", dinosaurDeletionStatuses) // ignore updates of dinosaur under deletion
+func (k *dinosaurService) Updates(dinosaurRequest *dbapi.CentralRequest, fields map[string]interface{}, events ...*dbapi.CentralEvent) *errors.ServiceError {
+	version := dinosaurRequest.ResourceVersion
+	values := make(map[string]interface{}, len(fields)+1)
+	for field, value := range fields {
+		values[field] = value
+	}
+	values["resource_version"] = version + 1
+	// The updated values are assigned to the central even if it has not been updated.
+	updated, err := k.compareAndSwap(dinosaurRequest, version, values, events)
+	if !updated {
+		dinosaurRequest.ResourceVersion = version
+	}
+	return err
+}
 
-	if err := dbConn.Updates(fields).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central")
+// compareAndSwap updates a central only if it has not been updated since it was read with the given resource version,
+// so that e.g. status reports of the data plane never overwrite concurrent changes of the central spec. Updates of
+// centrals under deletion are ignored. The given events are recorded in the transaction of the update. The returned
+// boolean tells whether the central has been updated.
+func (k *dinosaurService) compareAndSwap(dinosaurRequest *dbapi.CentralRequest, version int64, values interface{}, events []*dbapi.CentralEvent) (bool, *errors.ServiceError) {
+	var rowsAffected int64
+	svcErr := k.inTransaction(len(events) > 0, func(tx *gorm.DB) *errors.ServiceError {
+		result := tx.
+			Model(dinosaurRequest).
+			Where("status not IN (?)", dinosaurDeletionStatuses). // ignore updates of dinosaur under deletion
+			Where("resource_version = ?", version).
+			Updates(values)
+		if result.Error != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, result.Error, "Failed to update central")
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return k.recordEvents(tx, events)
+	})
+	if svcErr != nil {
+		return false, svcErr
+	}
+	if rowsAffected > 0 {
+		return true, nil
 	}
 
-	return nil
+	var current dbapi.CentralRequest
+	if err := k.connectionFactory.New().Select("status", "resource_version").Where("id = ?", dinosaurRequest.ID).First(&current).Error; err != nil {
+		if services.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central")
+	}
+	if arrays.Contains(dinosaurDeletionStatuses, current.Status) || current.ResourceVersion == version {
+		return false, nil
+	}
+	return false, errors.PreconditionFailed("central %s has been modified concurrently", dinosaurRequest.ID)
 }
 
 // VerifyAndUpdateDinosaurAdmin ...
@@ -687,13 +989,17 @@ func (k *dinosaurService) VerifyAndUpdateDinosaurAdmin(ctx context.Context, dino
 }
 
 // UpdateStatus ...
-func (k *dinosaurService) UpdateStatus(id string, status dinosaurConstants.CentralStatus) (bool, *errors.ServiceError) {
-	dbConn := k.connectionFactory.New()
-
+func (k *dinosaurService) UpdateStatus(id string, status dinosaurConstants.CentralStatus, events ...*dbapi.CentralEvent) (bool, *errors.ServiceError) {
 	dinosaur, err := k.GetByID(id)
 	if err != nil {
 		return true, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
 	}
+	return k.updateStatus(dinosaur, status, events...)
+}
+
+// updateStatus updates the status of the given central only if it has not been modified since it was read.
+func (k *dinosaurService) updateStatus(dinosaur *dbapi.CentralRequest, status dinosaurConstants.CentralStatus, events ...*dbapi.CentralEvent) (bool, *errors.ServiceError) {
+	id := dinosaur.ID
 	// only allow to change the status to "deleting" if the cluster is already in "deprovision" status
 	if dinosaur.Status == dinosaurConstants.CentralRequestStatusDeprovision.String() && status != dinosaurConstants.CentralRequestStatusDeleting {
 		return false, errors.GeneralError("failed to update status: cluster is deprovisioning")
@@ -704,17 +1010,54 @@ func (k *dinosaurService) UpdateStatus(id string, status dinosaurConstants.Centr
 		return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
 	}
 
-	update := &dbapi.CentralRequest{Status: status.String()}
+	update := &dbapi.CentralRequest{Status: status.String(), ResourceVersion: dinosaur.ResourceVersion + 1}
 	if status.String() == dinosaurConstants.CentralRequestStatusDeprovision.String() {
 		now := time.Now()
 		update.DeletionTimestamp = &now
 	}
 
-	if err := dbConn.Model(&dbapi.CentralRequest{Meta: api.Meta{ID: id}}).Updates(update).Error; err != nil {
-		return true, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central status")
+	svcErr := k.inTransaction(len(events) > 0, func(tx *gorm.DB) *errors.ServiceError {
+		result := tx.Model(&dbapi.CentralRequest{Meta: api.Meta{ID: id}}).
+			Where("resource_version = ?", dinosaur.ResourceVersion).
+			Updates(update)
+		if result.Error != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, result.Error, "Failed to update central status")
+		}
+		if result.RowsAffected == 0 {
+			return errors.PreconditionFailed("failed to update status: central %s has been modified concurrently", id)
+		}
+		return k.recordEvents(tx, events)
+	})
+
+	return true, svcErr
+}
+
+// inTransaction runs fn in a transaction if required, so that e.g. the events recording a change are only persisted
+// together with the change. Otherwise fn runs on a plain connection.
+func (k *dinosaurService) inTransaction(required bool, fn func(tx *gorm.DB) *errors.ServiceError) *errors.ServiceError {
+	if !required {
+		return fn(k.connectionFactory.New())
 	}
+	var svcErr *errors.ServiceError
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if svcErr = fn(tx); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil && svcErr == nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to commit the update of the central")
+	}
+	return svcErr
+}
 
-	return true, nil
+// recordEvents records the events of a change of centrals within the transaction of the change.
+func (k *dinosaurService) recordEvents(tx *gorm.DB, events []*dbapi.CentralEvent) *errors.ServiceError {
+	for _, event := range events {
+		if svcErr := k.centralEventService.RecordInTransaction(tx, event); svcErr != nil {
+			return svcErr
+		}
+	}
+	return nil
 }
 
 // ChangeDinosaurCNAMErecords ...
@@ -780,12 +1123,12 @@ type DinosaurRegionCount struct {
 	Count        int
 }
 
-// CountByRegionAndInstanceType ...
+// CountByRegionAndInstanceType counts the centrals using capacity of the clusters, i.e. without suspended centrals.
 func (k *dinosaurService) CountByRegionAndInstanceType() ([]DinosaurRegionCount, error) {
 	dbConn := k.connectionFactory.New()
 	var results []DinosaurRegionCount
 
-	if err := dbConn.Model(&dbapi.CentralRequest{}).Select("region as Region, instance_type, cluster_id, count(1) as Count").Group("region,instance_type,cluster_id").Scan(&results).Error; err != nil {
+	if err := dbConn.Model(&dbapi.CentralRequest{}).Where("status != ?", dinosaurConstants.CentralRequestStatusSuspended.String()).Select("region as Region, instance_type, cluster_id, count(1) as Count").Group("region,instance_type,cluster_id").Scan(&results).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to count centrals")
 	}
 
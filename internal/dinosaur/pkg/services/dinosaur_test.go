@@ -2,15 +2,22 @@ package services
 
 import (
 	"context"
+	"database/sql/driver"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
 	"gorm.io/gorm"
 )
 
@@ -172,3 +179,392 @@ func Test_dinosaurService_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_dinosaurService_Updates(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupFn     func()
+		wantErr     bool
+		wantVersion int64
+	}{
+		{
+			name: "bumps resource version of unmodified central",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "central_requests"`).WithRowsNum(1)
+			},
+			wantVersion: 2,
+		},
+		{
+			name: "fails if central has been modified concurrently",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "central_requests"`).WithRowsNum(0)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "status","resource_version" FROM "central_requests"`).
+					WithReply([]map[string]interface{}{{"status": "ready", "resource_version": 2}})
+			},
+			wantErr:     true,
+			wantVersion: 1,
+		},
+		{
+			name: "ignores update of central under deletion",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "central_requests"`).WithRowsNum(0)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "status","resource_version" FROM "central_requests"`).
+					WithReply([]map[string]interface{}{{"status": "deprovision", "resource_version": 2}})
+			},
+			wantVersion: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupFn()
+			k := &dinosaurService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			central := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.ResourceVersion = 1
+			})
+			err := k.Updates(central, map[string]interface{}{"plan": "small"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Updates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if central.ResourceVersion != tt.wantVersion {
+				t.Errorf("Updates() resource version = %d, want %d", central.ResourceVersion, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_SetMissingExpirations(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lifespanInHours        int
+		wantExpirationsUpdated bool
+	}{
+		{
+			name:                   "sets missing expirations of eval centrals from the configured lifespan",
+			lifespanInHours:        48,
+			wantExpirationsUpdated: true,
+		},
+		{
+			name: "does nothing if eval centrals do not expire",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateMock := mocket.Catcher.Reset().NewMock().
+				WithQuery(`UPDATE "central_requests" SET "expires_at"=created_at + $1 * interval '1 second'`).
+				WithRowsNum(1)
+			centralConfig := config.NewCentralConfig()
+			centralConfig.CentralLifespan.CentralLifespanInHours = tt.lifespanInHours
+			k := &dinosaurService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				dinosaurConfig:    centralConfig,
+			}
+			if err := k.SetMissingExpirations(); err != nil {
+				t.Fatalf("SetMissingExpirations() unexpected error = %v", err)
+			}
+			if updateMock.Triggered != tt.wantExpirationsUpdated {
+				t.Fatalf("SetMissingExpirations() updated expirations = %v, want %v", updateMock.Triggered, tt.wantExpirationsUpdated)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_RegisterDinosaurDeprovisionJob(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+	version := int64(1)
+	outdatedVersion := int64(0)
+
+	tests := []struct {
+		name            string
+		setupFn         func()
+		resourceVersion *int64
+		wantErrCode     errors.ServiceErrorCode
+	}{
+		{
+			name: "deprovisions central with expected resource version",
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "central_requests"`).WithRowsNum(1)
+			},
+			resourceVersion: &version,
+		},
+		{
+			name:            "fails if central has another resource version",
+			setupFn:         func() {},
+			resourceVersion: &outdatedVersion,
+			wantErrCode:     errors.ErrorPreconditionFailed,
+		},
+		{
+			name: "fails if central has been modified concurrently",
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "central_requests"`).WithRowsNum(0)
+			},
+			resourceVersion: &version,
+			wantErrCode:     errors.ErrorPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "central_requests"`).
+				WithReply([]map[string]interface{}{{"id": testID, "status": "ready", "resource_version": version}})
+			tt.setupFn()
+			k := &dinosaurService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				centralEventService: &CentralEventServiceMock{
+					RecordInTransactionFunc: func(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError {
+						return nil
+					},
+				},
+			}
+			err := k.RegisterDinosaurDeprovisionJob(authenticatedCtx, testID, tt.resourceVersion)
+			if tt.wantErrCode != 0 {
+				if err == nil || err.Code != tt.wantErrCode {
+					t.Fatalf("RegisterDinosaurDeprovisionJob() error = %v, want code %d", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RegisterDinosaurDeprovisionJob() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_Delete(t *testing.T) {
+	version := int64(1)
+
+	tests := []struct {
+		name            string
+		rowsAffected    int64
+		resourceVersion *int64
+		wantErrCode     errors.ServiceErrorCode
+	}{
+		{
+			name:         "deletes central without expected resource version",
+			rowsAffected: 1,
+		},
+		{
+			name:            "deletes central with expected resource version",
+			rowsAffected:    1,
+			resourceVersion: &version,
+		},
+		{
+			name:            "fails if central has been modified concurrently",
+			resourceVersion: &version,
+			wantErrCode:     errors.ErrorPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleteQuery string
+			var deleteArgs []driver.NamedValue
+			mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "central_requests" SET "deleted_at"`).WithRowsNum(tt.rowsAffected).
+				WithCallback(func(query string, args []driver.NamedValue) {
+					deleteQuery = query
+					deleteArgs = args
+				})
+			k := &dinosaurService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				centralEventService: &CentralEventServiceMock{
+					RecordInTransactionFunc: func(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError {
+						return nil
+					},
+				},
+			}
+			central := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+				centralRequest.ResourceVersion = version
+			})
+			err := k.Delete(central, true, tt.resourceVersion)
+			if tt.wantErrCode != 0 {
+				if err == nil || err.Code != tt.wantErrCode {
+					t.Fatalf("Delete() error = %v, want code %d", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Delete() unexpected error = %v", err)
+			}
+			hasVersionCondition := strings.Contains(deleteQuery, "resource_version = ")
+			if hasVersionCondition != (tt.resourceVersion != nil) {
+				t.Errorf("Delete() query %q, args %v", deleteQuery, deleteArgs)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_List(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	orderByName := []services.OrderByField{{Column: "name"}, {Column: "id"}}
+	pageToken, err := services.EncodePageToken(&services.ListArguments{}, orderByName, []interface{}{"a", "id-a"})
+	if err != nil {
+		t.Fatalf("failed to create page token: %s", err.Error())
+	}
+
+	tests := []struct {
+		name              string
+		listArgs          *services.ListArguments
+		setupFn           func()
+		wantIDs           []string
+		wantNextPageToken string
+		wantErrCode       errors.ServiceErrorCode
+	}{
+		{
+			name:     "returns token of next page",
+			listArgs: &services.ListArguments{Page: 1, Size: 1},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(*)`).WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "central_requests"`).
+					WithReply([]map[string]interface{}{{"id": "id-a", "name": "a"}, {"id": "id-b", "name": "b"}})
+			},
+			wantIDs:           []string{"id-a"},
+			wantNextPageToken: pageToken,
+		},
+		{
+			name:     "returns page after page token",
+			listArgs: &services.ListArguments{Page: 1, Size: 1, PageToken: pageToken},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(*)`).WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`((name > $2) OR (name = $3 AND id > $4))`).
+					WithReply([]map[string]interface{}{{"id": "id-b", "name": "b"}})
+			},
+			wantIDs: []string{"id-b"},
+		},
+		{
+			name:     "rejects page token of different order",
+			listArgs: &services.ListArguments{Page: 1, Size: 1, PageToken: pageToken, OrderBy: []string{"name desc"}},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:     "returns no token of next page for empty page",
+			listArgs: &services.ListArguments{Page: 1, Size: 1},
+			setupFn: func() {
+				// the count is outdated when the centrals are listed
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(*)`).WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "central_requests"`).
+					WithReply([]map[string]interface{}{{"id": "id-a", "name": "a"}})
+			},
+		},
+		{
+			name:     "rejects invalid order by direction",
+			listArgs: &services.ListArguments{Page: 1, Size: 1, OrderBy: []string{"name descending"}},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:     "rejects unknown order by field",
+			listArgs: &services.ListArguments{Page: 1, Size: 1, OrderBy: []string{"href"}},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupFn()
+			k := &dinosaurService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			list, paging, err := k.List(authenticatedCtx, tt.listArgs)
+			if tt.wantErrCode != 0 {
+				if err == nil || err.Code != tt.wantErrCode {
+					t.Fatalf("List() error = %v, want code %d", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("List() unexpected error = %v", err)
+			}
+			var ids []string
+			for _, central := range list {
+				ids = append(ids, central.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("List() ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if paging.NextPageToken != tt.wantNextPageToken {
+				t.Errorf("List() next page token = %q, want %q", paging.NextPageToken, tt.wantNextPageToken)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_WarnExpiringCentrals(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupFn    func()
+		wantEvents int
+	}{
+		{
+			name: "warns about expiring centrals once",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "central_requests" WHERE expires_at <= $1 AND expiry_warning_sent = $2`).
+					WithReply([]map[string]interface{}{{"id": "id-a", "expires_at": time.Now()}, {"id": "id-b", "expires_at": time.Now()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "central_requests" SET "expiry_warning_sent"=$1`).WithRowsNum(1).OneTime()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "central_requests" SET "expiry_warning_sent"=$1`).WithRowsNum(0)
+			},
+			wantEvents: 1,
+		},
+		{
+			name: "does nothing without expiring centrals",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "central_requests"`).WithReply([]map[string]interface{}{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupFn()
+			eventService := &CentralEventServiceMock{
+				RecordInTransactionFunc: func(tx *gorm.DB, event *dbapi.CentralEvent) *errors.ServiceError {
+					return nil
+				},
+			}
+			k := &dinosaurService{
+				connectionFactory:   db.NewMockConnectionFactory(nil),
+				centralEventService: eventService,
+			}
+			if err := k.WarnExpiringCentrals(24 * time.Hour); err != nil {
+				t.Fatalf("WarnExpiringCentrals() unexpected error = %v", err)
+			}
+			if len(eventService.RecordInTransactionCalls()) != tt.wantEvents {
+				t.Fatalf("WarnExpiringCentrals() recorded %d events, want %d", len(eventService.RecordInTransactionCalls()), tt.wantEvents)
+			}
+			for _, call := range eventService.RecordInTransactionCalls() {
+				if call.Event.Type != constants.CentralEventTypeExpiryWarning.String() {
+					t.Errorf("WarnExpiringCentrals() recorded event of type %s", call.Event.Type)
+				}
+			}
+		})
+	}
+}
@@ -7,12 +7,13 @@ import (
 	"context"
 	"github.com/aws/aws-sdk-go/service/route53"
 	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
-	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/services"
 	"sync"
+	"time"
 )
 
 // Ensure, that DinosaurServiceMock does implement DinosaurService.
@@ -37,13 +38,13 @@ var _ DinosaurService = &DinosaurServiceMock{}
 //			CountByStatusFunc: func(status []dinosaurConstants.CentralStatus) ([]DinosaurStatusCount, error) {
 //				panic("mock out the CountByStatus method")
 //			},
-//			DeleteFunc: func(centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError {
+//			DeleteFunc: func(centralRequest *dbapi.CentralRequest, force bool, resourceVersion *int64) *serviceError.ServiceError {
 //				panic("mock out the Delete method")
 //			},
 //			DeprovisionDinosaurForUsersFunc: func(users []string) *serviceError.ServiceError {
 //				panic("mock out the DeprovisionDinosaurForUsers method")
 //			},
-//			DeprovisionExpiredDinosaursFunc: func(dinosaurAgeInHours int) *serviceError.ServiceError {
+//			DeprovisionExpiredDinosaursFunc: func() *serviceError.ServiceError {
 //				panic("mock out the DeprovisionExpiredDinosaurs method")
 //			},
 //			DetectInstanceTypeFunc: func(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType {
@@ -85,24 +86,30 @@ var _ DinosaurService = &DinosaurServiceMock{}
 //			PrepareDinosaurRequestFunc: func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the PrepareDinosaurRequest method")
 //			},
-//			RegisterDinosaurDeprovisionJobFunc: func(ctx context.Context, id string) *serviceError.ServiceError {
+//			RegisterDinosaurDeprovisionJobFunc: func(ctx context.Context, id string, resourceVersion *int64) *serviceError.ServiceError {
 //				panic("mock out the RegisterDinosaurDeprovisionJob method")
 //			},
 //			RegisterDinosaurJobFunc: func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the RegisterDinosaurJob method")
 //			},
-//			UpdateFunc: func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+//			SetMissingExpirationsFunc: func() *serviceError.ServiceError {
+//				panic("mock out the SetMissingExpirations method")
+//			},
+//			UpdateFunc: func(dinosaurRequest *dbapi.CentralRequest, events ...*dbapi.CentralEvent) *serviceError.ServiceError {
 //				panic("mock out the Update method")
 //			},
-//			UpdateStatusFunc: func(id string, status dinosaurConstants.CentralStatus) (bool, *serviceError.ServiceError) {
+//			UpdateStatusFunc: func(id string, status dinosaurConstants.CentralStatus, events ...*dbapi.CentralEvent) (bool, *serviceError.ServiceError) {
 //				panic("mock out the UpdateStatus method")
 //			},
-//			UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
+//			UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceError.ServiceError {
 //				panic("mock out the Updates method")
 //			},
 //			VerifyAndUpdateDinosaurAdminFunc: func(ctx context.Context, dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the VerifyAndUpdateDinosaurAdmin method")
 //			},
+//			WarnExpiringCentralsFunc: func(warningPeriod time.Duration) *serviceError.ServiceError {
+//				panic("mock out the WarnExpiringCentrals method")
+//			},
 //		}
 //
 //		// use mockedDinosaurService in code that requires DinosaurService
@@ -123,13 +130,13 @@ type DinosaurServiceMock struct {
 	CountByStatusFunc func(status []dinosaurConstants.CentralStatus) ([]DinosaurStatusCount, error)
 
 	// DeleteFunc mocks the Delete method.
-	DeleteFunc func(centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError
+	DeleteFunc func(centralRequest *dbapi.CentralRequest, force bool, resourceVersion *int64) *serviceError.ServiceError
 
 	// DeprovisionDinosaurForUsersFunc mocks the DeprovisionDinosaurForUsers method.
 	DeprovisionDinosaurForUsersFunc func(users []string) *serviceError.ServiceError
 
 	// DeprovisionExpiredDinosaursFunc mocks the DeprovisionExpiredDinosaurs method.
-	DeprovisionExpiredDinosaursFunc func(dinosaurAgeInHours int) *serviceError.ServiceError
+	DeprovisionExpiredDinosaursFunc func() *serviceError.ServiceError
 
 	// DetectInstanceTypeFunc mocks the DetectInstanceType method.
 	DetectInstanceTypeFunc func(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType
@@ -171,23 +178,29 @@ type DinosaurServiceMock struct {
 	PrepareDinosaurRequestFunc func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
 	// RegisterDinosaurDeprovisionJobFunc mocks the RegisterDinosaurDeprovisionJob method.
-	RegisterDinosaurDeprovisionJobFunc func(ctx context.Context, id string) *serviceError.ServiceError
+	RegisterDinosaurDeprovisionJobFunc func(ctx context.Context, id string, resourceVersion *int64) *serviceError.ServiceError
 
 	// RegisterDinosaurJobFunc mocks the RegisterDinosaurJob method.
 	RegisterDinosaurJobFunc func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
+	// SetMissingExpirationsFunc mocks the SetMissingExpirations method.
+	SetMissingExpirationsFunc func() *serviceError.ServiceError
+
 	// UpdateFunc mocks the Update method.
-	UpdateFunc func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
+	UpdateFunc func(dinosaurRequest *dbapi.CentralRequest, events ...*dbapi.CentralEvent) *serviceError.ServiceError
 
 	// UpdateStatusFunc mocks the UpdateStatus method.
-	UpdateStatusFunc func(id string, status dinosaurConstants.CentralStatus) (bool, *serviceError.ServiceError)
+	UpdateStatusFunc func(id string, status dinosaurConstants.CentralStatus, events ...*dbapi.CentralEvent) (bool, *serviceError.ServiceError)
 
 	// UpdatesFunc mocks the Updates method.
-	UpdatesFunc func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError
+	UpdatesFunc func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceError.ServiceError
 
 	// VerifyAndUpdateDinosaurAdminFunc mocks the VerifyAndUpdateDinosaurAdmin method.
 	VerifyAndUpdateDinosaurAdminFunc func(ctx context.Context, dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
+	// WarnExpiringCentralsFunc mocks the WarnExpiringCentrals method.
+	WarnExpiringCentralsFunc func(warningPeriod time.Duration) *serviceError.ServiceError
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AcceptCentralRequest holds details about calls to the AcceptCentralRequest method.
@@ -216,6 +229,8 @@ type DinosaurServiceMock struct {
 			CentralRequest *dbapi.CentralRequest
 			// Force is the force argument value.
 			Force bool
+			// ResourceVersion is the resourceVersion argument value.
+			ResourceVersion *int64
 		}
 		// DeprovisionDinosaurForUsers holds details about calls to the DeprovisionDinosaurForUsers method.
 		DeprovisionDinosaurForUsers []struct {
@@ -224,8 +239,6 @@ type DinosaurServiceMock struct {
 		}
 		// DeprovisionExpiredDinosaurs holds details about calls to the DeprovisionExpiredDinosaurs method.
 		DeprovisionExpiredDinosaurs []struct {
-			// DinosaurAgeInHours is the dinosaurAgeInHours argument value.
-			DinosaurAgeInHours int
 		}
 		// DetectInstanceType holds details about calls to the DetectInstanceType method.
 		DetectInstanceType []struct {
@@ -294,16 +307,23 @@ type DinosaurServiceMock struct {
 			Ctx context.Context
 			// ID is the id argument value.
 			ID string
+			// ResourceVersion is the resourceVersion argument value.
+			ResourceVersion *int64
 		}
 		// RegisterDinosaurJob holds details about calls to the RegisterDinosaurJob method.
 		RegisterDinosaurJob []struct {
 			// DinosaurRequest is the dinosaurRequest argument value.
 			DinosaurRequest *dbapi.CentralRequest
 		}
+		// SetMissingExpirations holds details about calls to the SetMissingExpirations method.
+		SetMissingExpirations []struct {
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// DinosaurRequest is the dinosaurRequest argument value.
 			DinosaurRequest *dbapi.CentralRequest
+			// Events is the events argument value.
+			Events []*dbapi.CentralEvent
 		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
@@ -311,6 +331,8 @@ type DinosaurServiceMock struct {
 			ID string
 			// Status is the status argument value.
 			Status dinosaurConstants.CentralStatus
+			// Events is the events argument value.
+			Events []*dbapi.CentralEvent
 		}
 		// Updates holds details about calls to the Updates method.
 		Updates []struct {
@@ -318,6 +340,8 @@ type DinosaurServiceMock struct {
 			DinosaurRequest *dbapi.CentralRequest
 			// Values is the values argument value.
 			Values map[string]interface{}
+			// Events is the events argument value.
+			Events []*dbapi.CentralEvent
 		}
 		// VerifyAndUpdateDinosaurAdmin holds details about calls to the VerifyAndUpdateDinosaurAdmin method.
 		VerifyAndUpdateDinosaurAdmin []struct {
@@ -326,6 +350,11 @@ type DinosaurServiceMock struct {
 			// DinosaurRequest is the dinosaurRequest argument value.
 			DinosaurRequest *dbapi.CentralRequest
 		}
+		// WarnExpiringCentrals holds details about calls to the WarnExpiringCentrals method.
+		WarnExpiringCentrals []struct {
+			// WarningPeriod is the warningPeriod argument value.
+			WarningPeriod time.Duration
+		}
 	}
 	lockAcceptCentralRequest              sync.RWMutex
 	lockChangeDinosaurCNAMErecords        sync.RWMutex
@@ -349,10 +378,12 @@ type DinosaurServiceMock struct {
 	lockPrepareDinosaurRequest            sync.RWMutex
 	lockRegisterDinosaurDeprovisionJob    sync.RWMutex
 	lockRegisterDinosaurJob               sync.RWMutex
+	lockSetMissingExpirations             sync.RWMutex
 	lockUpdate                            sync.RWMutex
 	lockUpdateStatus                      sync.RWMutex
 	lockUpdates                           sync.RWMutex
 	lockVerifyAndUpdateDinosaurAdmin      sync.RWMutex
+	lockWarnExpiringCentrals              sync.RWMutex
 }
 
 // AcceptCentralRequest calls AcceptCentralRequestFunc.
@@ -483,21 +514,23 @@ func (mock *DinosaurServiceMock) CountByStatusCalls() []struct {
 }
 
 // Delete calls DeleteFunc.
-func (mock *DinosaurServiceMock) Delete(centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError {
+func (mock *DinosaurServiceMock) Delete(centralRequest *dbapi.CentralRequest, force bool, resourceVersion *int64) *serviceError.ServiceError {
 	if mock.DeleteFunc == nil {
 		panic("DinosaurServiceMock.DeleteFunc: method is nil but DinosaurService.Delete was just called")
 	}
 	callInfo := struct {
-		CentralRequest *dbapi.CentralRequest
-		Force          bool
+		CentralRequest  *dbapi.CentralRequest
+		Force           bool
+		ResourceVersion *int64
 	}{
-		CentralRequest: centralRequest,
-		Force:          force,
+		CentralRequest:  centralRequest,
+		Force:           force,
+		ResourceVersion: resourceVersion,
 	}
 	mock.lockDelete.Lock()
 	mock.calls.Delete = append(mock.calls.Delete, callInfo)
 	mock.lockDelete.Unlock()
-	return mock.DeleteFunc(centralRequest, force)
+	return mock.DeleteFunc(centralRequest, force, resourceVersion)
 }
 
 // DeleteCalls gets all the calls that were made to Delete.
@@ -505,12 +538,14 @@ func (mock *DinosaurServiceMock) Delete(centralRequest *dbapi.CentralRequest, fo
 //
 //	len(mockedDinosaurService.DeleteCalls())
 func (mock *DinosaurServiceMock) DeleteCalls() []struct {
-	CentralRequest *dbapi.CentralRequest
-	Force          bool
+	CentralRequest  *dbapi.CentralRequest
+	Force           bool
+	ResourceVersion *int64
 } {
 	var calls []struct {
-		CentralRequest *dbapi.CentralRequest
-		Force          bool
+		CentralRequest  *dbapi.CentralRequest
+		Force           bool
+		ResourceVersion *int64
 	}
 	mock.lockDelete.RLock()
 	calls = mock.calls.Delete
@@ -551,19 +586,16 @@ func (mock *DinosaurServiceMock) DeprovisionDinosaurForUsersCalls() []struct {
 }
 
 // DeprovisionExpiredDinosaurs calls DeprovisionExpiredDinosaursFunc.
-func (mock *DinosaurServiceMock) DeprovisionExpiredDinosaurs(dinosaurAgeInHours int) *serviceError.ServiceError {
+func (mock *DinosaurServiceMock) DeprovisionExpiredDinosaurs() *serviceError.ServiceError {
 	if mock.DeprovisionExpiredDinosaursFunc == nil {
 		panic("DinosaurServiceMock.DeprovisionExpiredDinosaursFunc: method is nil but DinosaurService.DeprovisionExpiredDinosaurs was just called")
 	}
 	callInfo := struct {
-		DinosaurAgeInHours int
-	}{
-		DinosaurAgeInHours: dinosaurAgeInHours,
-	}
+	}{}
 	mock.lockDeprovisionExpiredDinosaurs.Lock()
 	mock.calls.DeprovisionExpiredDinosaurs = append(mock.calls.DeprovisionExpiredDinosaurs, callInfo)
 	mock.lockDeprovisionExpiredDinosaurs.Unlock()
-	return mock.DeprovisionExpiredDinosaursFunc(dinosaurAgeInHours)
+	return mock.DeprovisionExpiredDinosaursFunc()
 }
 
 // DeprovisionExpiredDinosaursCalls gets all the calls that were made to DeprovisionExpiredDinosaurs.
@@ -571,10 +603,8 @@ func (mock *DinosaurServiceMock) DeprovisionExpiredDinosaurs(dinosaurAgeInHours
 //
 //	len(mockedDinosaurService.DeprovisionExpiredDinosaursCalls())
 func (mock *DinosaurServiceMock) DeprovisionExpiredDinosaursCalls() []struct {
-	DinosaurAgeInHours int
 } {
 	var calls []struct {
-		DinosaurAgeInHours int
 	}
 	mock.lockDeprovisionExpiredDinosaurs.RLock()
 	calls = mock.calls.DeprovisionExpiredDinosaurs
@@ -987,21 +1017,23 @@ func (mock *DinosaurServiceMock) PrepareDinosaurRequestCalls() []struct {
 }
 
 // RegisterDinosaurDeprovisionJob calls RegisterDinosaurDeprovisionJobFunc.
-func (mock *DinosaurServiceMock) RegisterDinosaurDeprovisionJob(ctx context.Context, id string) *serviceError.ServiceError {
+func (mock *DinosaurServiceMock) RegisterDinosaurDeprovisionJob(ctx context.Context, id string, resourceVersion *int64) *serviceError.ServiceError {
 	if mock.RegisterDinosaurDeprovisionJobFunc == nil {
 		panic("DinosaurServiceMock.RegisterDinosaurDeprovisionJobFunc: method is nil but DinosaurService.RegisterDinosaurDeprovisionJob was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		ID  string
+		Ctx             context.Context
+		ID              string
+		ResourceVersion *int64
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:             ctx,
+		ID:              id,
+		ResourceVersion: resourceVersion,
 	}
 	mock.lockRegisterDinosaurDeprovisionJob.Lock()
 	mock.calls.RegisterDinosaurDeprovisionJob = append(mock.calls.RegisterDinosaurDeprovisionJob, callInfo)
 	mock.lockRegisterDinosaurDeprovisionJob.Unlock()
-	return mock.RegisterDinosaurDeprovisionJobFunc(ctx, id)
+	return mock.RegisterDinosaurDeprovisionJobFunc(ctx, id, resourceVersion)
 }
 
 // RegisterDinosaurDeprovisionJobCalls gets all the calls that were made to RegisterDinosaurDeprovisionJob.
@@ -1009,12 +1041,14 @@ func (mock *DinosaurServiceMock) RegisterDinosaurDeprovisionJob(ctx context.Cont
 //
 //	len(mockedDinosaurService.RegisterDinosaurDeprovisionJobCalls())
 func (mock *DinosaurServiceMock) RegisterDinosaurDeprovisionJobCalls() []struct {
-	Ctx context.Context
-	ID  string
+	Ctx             context.Context
+	ID              string
+	ResourceVersion *int64
 } {
 	var calls []struct {
-		Ctx context.Context
-		ID  string
+		Ctx             context.Context
+		ID              string
+		ResourceVersion *int64
 	}
 	mock.lockRegisterDinosaurDeprovisionJob.RLock()
 	calls = mock.calls.RegisterDinosaurDeprovisionJob
@@ -1054,20 +1088,49 @@ func (mock *DinosaurServiceMock) RegisterDinosaurJobCalls() []struct {
 	return calls
 }
 
+// SetMissingExpirations calls SetMissingExpirationsFunc.
+func (mock *DinosaurServiceMock) SetMissingExpirations() *serviceError.ServiceError {
+	if mock.SetMissingExpirationsFunc == nil {
+		panic("DinosaurServiceMock.SetMissingExpirationsFunc: method is nil but DinosaurService.SetMissingExpirations was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSetMissingExpirations.Lock()
+	mock.calls.SetMissingExpirations = append(mock.calls.SetMissingExpirations, callInfo)
+	mock.lockSetMissingExpirations.Unlock()
+	return mock.SetMissingExpirationsFunc()
+}
+
+// SetMissingExpirationsCalls gets all the calls that were made to SetMissingExpirations.
+// Check the length with:
+//
+//	len(mockedDinosaurService.SetMissingExpirationsCalls())
+func (mock *DinosaurServiceMock) SetMissingExpirationsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSetMissingExpirations.RLock()
+	calls = mock.calls.SetMissingExpirations
+	mock.lockSetMissingExpirations.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
-func (mock *DinosaurServiceMock) Update(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+func (mock *DinosaurServiceMock) Update(dinosaurRequest *dbapi.CentralRequest, events ...*dbapi.CentralEvent) *serviceError.ServiceError {
 	if mock.UpdateFunc == nil {
 		panic("DinosaurServiceMock.UpdateFunc: method is nil but DinosaurService.Update was just called")
 	}
 	callInfo := struct {
 		DinosaurRequest *dbapi.CentralRequest
+		Events          []*dbapi.CentralEvent
 	}{
 		DinosaurRequest: dinosaurRequest,
+		Events:          events,
 	}
 	mock.lockUpdate.Lock()
 	mock.calls.Update = append(mock.calls.Update, callInfo)
 	mock.lockUpdate.Unlock()
-	return mock.UpdateFunc(dinosaurRequest)
+	return mock.UpdateFunc(dinosaurRequest, events...)
 }
 
 // UpdateCalls gets all the calls that were made to Update.
@@ -1076,9 +1139,11 @@ func (mock *DinosaurServiceMock) Update(dinosaurRequest *dbapi.CentralRequest) *
 //	len(mockedDinosaurService.UpdateCalls())
 func (mock *DinosaurServiceMock) UpdateCalls() []struct {
 	DinosaurRequest *dbapi.CentralRequest
+	Events          []*dbapi.CentralEvent
 } {
 	var calls []struct {
 		DinosaurRequest *dbapi.CentralRequest
+		Events          []*dbapi.CentralEvent
 	}
 	mock.lockUpdate.RLock()
 	calls = mock.calls.Update
@@ -1087,21 +1152,23 @@ func (mock *DinosaurServiceMock) UpdateCalls() []struct {
 }
 
 // UpdateStatus calls UpdateStatusFunc.
-func (mock *DinosaurServiceMock) UpdateStatus(id string, status dinosaurConstants.CentralStatus) (bool, *serviceError.ServiceError) {
+func (mock *DinosaurServiceMock) UpdateStatus(id string, status dinosaurConstants.CentralStatus, events ...*dbapi.CentralEvent) (bool, *serviceError.ServiceError) {
 	if mock.UpdateStatusFunc == nil {
 		panic("DinosaurServiceMock.UpdateStatusFunc: method is nil but DinosaurService.UpdateStatus was just called")
 	}
 	callInfo := struct {
 		ID     string
 		Status dinosaurConstants.CentralStatus
+		Events []*dbapi.CentralEvent
 	}{
 		ID:     id,
 		Status: status,
+		Events: events,
 	}
 	mock.lockUpdateStatus.Lock()
 	mock.calls.UpdateStatus = append(mock.calls.UpdateStatus, callInfo)
 	mock.lockUpdateStatus.Unlock()
-	return mock.UpdateStatusFunc(id, status)
+	return mock.UpdateStatusFunc(id, status, events...)
 }
 
 // UpdateStatusCalls gets all the calls that were made to UpdateStatus.
@@ -1111,10 +1178,12 @@ func (mock *DinosaurServiceMock) UpdateStatus(id string, status dinosaurConstant
 func (mock *DinosaurServiceMock) UpdateStatusCalls() []struct {
 	ID     string
 	Status dinosaurConstants.CentralStatus
+	Events []*dbapi.CentralEvent
 } {
 	var calls []struct {
 		ID     string
 		Status dinosaurConstants.CentralStatus
+		Events []*dbapi.CentralEvent
 	}
 	mock.lockUpdateStatus.RLock()
 	calls = mock.calls.UpdateStatus
@@ -1123,21 +1192,23 @@ func (mock *DinosaurServiceMock) UpdateStatusCalls() []struct {
 }
 
 // Updates calls UpdatesFunc.
-func (mock *DinosaurServiceMock) Updates(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
+func (mock *DinosaurServiceMock) Updates(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}, events ...*dbapi.CentralEvent) *serviceError.ServiceError {
 	if mock.UpdatesFunc == nil {
 		panic("DinosaurServiceMock.UpdatesFunc: method is nil but DinosaurService.Updates was just called")
 	}
 	callInfo := struct {
 		DinosaurRequest *dbapi.CentralRequest
 		Values          map[string]interface{}
+		Events          []*dbapi.CentralEvent
 	}{
 		DinosaurRequest: dinosaurRequest,
 		Values:          values,
+		Events:          events,
 	}
 	mock.lockUpdates.Lock()
 	mock.calls.Updates = append(mock.calls.Updates, callInfo)
 	mock.lockUpdates.Unlock()
-	return mock.UpdatesFunc(dinosaurRequest, values)
+	return mock.UpdatesFunc(dinosaurRequest, values, events...)
 }
 
 // UpdatesCalls gets all the calls that were made to Updates.
@@ -1147,10 +1218,12 @@ func (mock *DinosaurServiceMock) Updates(dinosaurRequest *dbapi.CentralRequest,
 func (mock *DinosaurServiceMock) UpdatesCalls() []struct {
 	DinosaurRequest *dbapi.CentralRequest
 	Values          map[string]interface{}
+	Events          []*dbapi.CentralEvent
 } {
 	var calls []struct {
 		DinosaurRequest *dbapi.CentralRequest
 		Values          map[string]interface{}
+		Events          []*dbapi.CentralEvent
 	}
 	mock.lockUpdates.RLock()
 	calls = mock.calls.Updates
@@ -1193,3 +1266,35 @@ func (mock *DinosaurServiceMock) VerifyAndUpdateDinosaurAdminCalls() []struct {
 	mock.lockVerifyAndUpdateDinosaurAdmin.RUnlock()
 	return calls
 }
+
+// WarnExpiringCentrals calls WarnExpiringCentralsFunc.
+func (mock *DinosaurServiceMock) WarnExpiringCentrals(warningPeriod time.Duration) *serviceError.ServiceError {
+	if mock.WarnExpiringCentralsFunc == nil {
+		panic("DinosaurServiceMock.WarnExpiringCentralsFunc: method is nil but DinosaurService.WarnExpiringCentrals was just called")
+	}
+	callInfo := struct {
+		WarningPeriod time.Duration
+	}{
+		WarningPeriod: warningPeriod,
+	}
+	mock.lockWarnExpiringCentrals.Lock()
+	mock.calls.WarnExpiringCentrals = append(mock.calls.WarnExpiringCentrals, callInfo)
+	mock.lockWarnExpiringCentrals.Unlock()
+	return mock.WarnExpiringCentralsFunc(warningPeriod)
+}
+
+// WarnExpiringCentralsCalls gets all the calls that were made to WarnExpiringCentrals.
+// Check the length with:
+//
+//	len(mockedDinosaurService.WarnExpiringCentralsCalls())
+func (mock *DinosaurServiceMock) WarnExpiringCentralsCalls() []struct {
+	WarningPeriod time.Duration
+} {
+	var calls []struct {
+		WarningPeriod time.Duration
+	}
+	mock.lockWarnExpiringCentrals.RLock()
+	calls = mock.calls.WarnExpiringCentrals
+	mock.lockWarnExpiringCentrals.RUnlock()
+	return calls
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
 
 	authv1 "github.com/openshift/api/authorization/v1"
@@ -69,6 +70,7 @@ var clusterMetricsStatuses = []api.ClusterStatus{
 	api.ClusterComputeNodeScalingUp,
 	api.ClusterFull,
 	api.ClusterFailed,
+	api.ClusterDraining,
 	api.ClusterDeprovisioning,
 }
 
@@ -98,6 +100,8 @@ type ClusterManagerOptions struct {
 	ClusterService             services.ClusterService
 	CloudProvidersService      services.CloudProvidersService
 	FleetshardOperatorAddon    services.FleetshardOperatorAddon
+	DinosaurService            services.DinosaurService
+	CentralMigrationService    services.CentralMigrationService
 }
 
 type processor func() []error
@@ -170,6 +174,7 @@ func (c *ClusterManager) Reconcile() []error {
 		c.processProvisioningClusters,
 		c.processProvisionedClusters,
 		c.processReadyClusters,
+		c.processDrainingClusters,
 	}
 
 	for _, p := range processors {
@@ -332,6 +337,103 @@ func (c *ClusterManager) processReadyClusters() []error {
 	return errs
 }
 
+func (c *ClusterManager) processDrainingClusters() []error {
+	var errs []error
+	drainingClusters, listErr := c.ClusterService.ListByStatus(api.ClusterDraining)
+	if listErr != nil {
+		errs = append(errs, errors.Wrap(listErr, "failed to list draining clusters"))
+		return errs
+	}
+	glog.Infof("draining clusters count = %d", len(drainingClusters))
+
+	for _, drainingCluster := range drainingClusters {
+		glog.V(10).Infof("draining cluster ClusterID = %s", drainingCluster.ClusterID)
+		for _, err := range c.reconcileDrainingCluster(drainingCluster) {
+			errs = append(errs, errors.Wrapf(err, "failed to reconcile draining cluster %s", drainingCluster.ClusterID))
+		}
+	}
+	return errs
+}
+
+// reconcileDrainingCluster migrates the ready centrals of a draining cluster to other clusters, at most
+// ClusterDrainMaxConcurrentMigrations at a time, and marks the cluster for deprovisioning once it is empty.
+// Centrals which can not be migrated are skipped and reported as errors, so that they do not stall the
+// migration of the other centrals while they keep the cluster from being deprovisioned.
+func (c *ClusterManager) reconcileDrainingCluster(cluster api.Cluster) []error {
+	centrals, err := c.DinosaurService.ListByClusterID(cluster.ClusterID)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	migrating := 0
+	var candidates []*dbapi.CentralRequest
+	for _, central := range centrals {
+		if central.IsMigrating() {
+			if central.MigrationSourceClusterID == cluster.ClusterID {
+				migrating++
+			}
+			continue
+		}
+		if central.ClusterID != cluster.ClusterID {
+			continue
+		}
+		if central.MigrationStatus == dinosaurConstants.CentralMigrationStatusFailed.String() {
+			errs = append(errs, errors.Errorf("central %s failed to migrate before and has to be migrated manually", central.ID))
+			continue
+		}
+		if isUnmigratableCentralStatus(central.Status) {
+			errs = append(errs, errors.Errorf("central %s can not be migrated in status %s", central.ID, central.Status))
+			continue
+		}
+		if central.Status == dinosaurConstants.CentralRequestStatusReady.String() {
+			candidates = append(candidates, central)
+		}
+	}
+
+	for _, central := range candidates {
+		if migrating >= c.DataplaneClusterConfig.ClusterDrainMaxConcurrentMigrations {
+			break
+		}
+		if err := c.CentralMigrationService.MigrateCentral(central); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to migrate central %s", central.ID))
+			continue
+		}
+		migrating++
+	}
+	if migrating > 0 {
+		glog.Infof("%d centrals are being migrated off draining cluster %s", migrating, cluster.ClusterID)
+		return errs
+	}
+
+	nonEmptyCluster, err := c.ClusterService.FindNonEmptyClusterByID(cluster.ClusterID)
+	if err != nil {
+		return append(errs, err)
+	}
+	if nonEmptyCluster != nil {
+		glog.V(10).Infof("draining cluster is not empty, ClusterID = %s", cluster.ClusterID)
+		return errs
+	}
+
+	glog.Infof("Draining cluster %s is empty and will be deprovisioned", cluster.ClusterID)
+	if err := c.ClusterService.UpdateStatus(cluster, api.ClusterDeprovisioning); err != nil {
+		return append(errs, fmt.Errorf("updating status for cluster %s to %s: %w", cluster.ClusterID, api.ClusterDeprovisioning, err))
+	}
+	return errs
+}
+
+// isFleetManagerDrivenClusterStatus returns true for the statuses through which fleet-manager removes a cluster.
+func isFleetManagerDrivenClusterStatus(status api.ClusterStatus) bool {
+	return status == api.ClusterDraining || status == api.ClusterDeprovisioning || status == api.ClusterCleanup
+}
+
+// isUnmigratableCentralStatus returns true for the statuses in which a central stays until it is changed through
+// the API, and which therefore never become ready for a migration on their own.
+func isUnmigratableCentralStatus(status string) bool {
+	return status == dinosaurConstants.CentralRequestStatusSuspending.String() ||
+		status == dinosaurConstants.CentralRequestStatusSuspended.String()
+}
+
 func (c *ClusterManager) reconcileDeprovisioningCluster(cluster *api.Cluster) error {
 	if c.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
 		siblingCluster, findClusterErr := c.ClusterService.FindCluster(services.FindClusterCriteria{
@@ -670,7 +772,10 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 		newCluster.CloudProvider = manualCluster.CloudProvider
 		newCluster.Region = manualCluster.Region
 		newCluster.MultiAZ = manualCluster.MultiAZ
-		newCluster.Status = manualCluster.Status
+		// Draining and the deletion statuses are driven by fleet-manager and must not be reverted by the configuration file.
+		if !isFleetManagerDrivenClusterStatus(cluster.Status) {
+			newCluster.Status = manualCluster.Status
+		}
 		newCluster.ProviderType = manualCluster.ProviderType
 		newCluster.ClusterDNS = manualCluster.ClusterDNS
 		newCluster.SupportedInstanceType = manualCluster.SupportedInstanceType
@@ -700,6 +805,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 		return nil
 	}
 
+	// Suspended centrals do not use any capacity, but still keep their cluster from being deprovisioned.
 	dinosaurInstanceCount, err := c.ClusterService.FindDinosaurInstanceCount(excessClusterIds)
 	if err != nil {
 		return []error{errors.Wrapf(err, "Failed to find central count a cluster: %s", excessClusterIds)}
@@ -1042,7 +1148,8 @@ func (c *ClusterManager) setClusterStatusCountMetrics() error {
 }
 
 func (c *ClusterManager) setDinosaurPerClusterCountMetrics() error {
-	counters, err := c.ClusterService.FindDinosaurInstanceCount([]string{})
+	// Like the placement of centrals, the metric does not count suspended centrals.
+	counters, err := c.ClusterService.FindActiveDinosaurInstanceCount([]string{})
 	if err != nil {
 		return err
 	}
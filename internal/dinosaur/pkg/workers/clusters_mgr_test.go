@@ -0,0 +1,204 @@
+package workers
+
+import (
+	"testing"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClusterID = "test-cluster"
+
+func TestClusterManagerReconcileClusterWithManualConfig(t *testing.T) {
+	tt := []struct {
+		description    string
+		status         api.ClusterStatus
+		expectedStatus api.ClusterStatus
+	}{
+		{
+			description:    "should apply the configured status to a ready cluster",
+			status:         api.ClusterReady,
+			expectedStatus: api.ClusterFull,
+		},
+		{
+			description:    "should apply the configured status to a failed cluster",
+			status:         api.ClusterFailed,
+			expectedStatus: api.ClusterFull,
+		},
+		{
+			description:    "should apply the configured status to a cluster waiting for fleetshard",
+			status:         api.ClusterWaitingForFleetShardOperator,
+			expectedStatus: api.ClusterFull,
+		},
+		{
+			description:    "should keep a draining cluster draining",
+			status:         api.ClusterDraining,
+			expectedStatus: api.ClusterDraining,
+		},
+		{
+			description:    "should keep a deprovisioning cluster deprovisioning",
+			status:         api.ClusterDeprovisioning,
+			expectedStatus: api.ClusterDeprovisioning,
+		},
+		{
+			description:    "should keep a cluster in cleanup in cleanup",
+			status:         api.ClusterCleanup,
+			expectedStatus: api.ClusterCleanup,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			cluster := api.Cluster{ClusterID: testClusterID, Status: tc.status, Region: "us-east-1"}
+			var updated *api.Cluster
+			clusterService := &services.ClusterServiceMock{
+				ListAllClusterIdsFunc: func() ([]api.Cluster, *serviceErrors.ServiceError) {
+					return []api.Cluster{cluster}, nil
+				},
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *serviceErrors.ServiceError) {
+					c := cluster
+					return &c, nil
+				},
+				UpdateFunc: func(cluster api.Cluster) *serviceErrors.ServiceError {
+					updated = &cluster
+					return nil
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{
+				{ClusterID: testClusterID, Status: api.ClusterFull},
+			})
+			manager := NewClusterManager(ClusterManagerOptions{
+				DataplaneClusterConfig: dataplaneClusterConfig,
+				ClusterService:         clusterService,
+			})
+
+			require.Empty(t, manager.reconcileClusterWithManualConfig())
+
+			require.NotNil(t, updated)
+			assert.Equal(t, tc.expectedStatus, updated.Status)
+		})
+	}
+}
+
+func TestClusterManagerProcessDrainingClusters(t *testing.T) {
+	readyCentral := func(id string) *dbapi.CentralRequest {
+		return &dbapi.CentralRequest{
+			Meta:      api.Meta{ID: id},
+			ClusterID: testClusterID,
+			Status:    dinosaurConstants.CentralRequestStatusReady.String(),
+		}
+	}
+	migratingCentral := func(id string) *dbapi.CentralRequest {
+		central := readyCentral(id)
+		central.MigrationStatus = dinosaurConstants.CentralMigrationStatusProvisioning.String()
+		central.MigrationSourceClusterID = testClusterID
+		return central
+	}
+
+	tt := []struct {
+		description           string
+		centrals              []*dbapi.CentralRequest
+		nonEmpty              bool
+		expectedMigrations    []string
+		expectedErrors        int
+		expectedDeprovisioned bool
+	}{
+		{
+			description:        "should migrate at most the configured number of centrals at a time",
+			centrals:           []*dbapi.CentralRequest{readyCentral("a"), readyCentral("b"), readyCentral("c")},
+			nonEmpty:           true,
+			expectedMigrations: []string{"a", "b"},
+		},
+		{
+			description:        "should count centrals which are being migrated already",
+			centrals:           []*dbapi.CentralRequest{migratingCentral("a"), readyCentral("b"), readyCentral("c")},
+			nonEmpty:           true,
+			expectedMigrations: []string{"b"},
+		},
+		{
+			description: "should not migrate more centrals while the limit is reached",
+			centrals:    []*dbapi.CentralRequest{migratingCentral("a"), migratingCentral("b"), readyCentral("c")},
+			nonEmpty:    true,
+		},
+		{
+			description: "should report centrals which can not be migrated and keep the cluster",
+			centrals: []*dbapi.CentralRequest{
+				{Meta: api.Meta{ID: "a"}, ClusterID: testClusterID, Status: dinosaurConstants.CentralRequestStatusSuspended.String()},
+				{Meta: api.Meta{ID: "b"}, ClusterID: testClusterID, Status: dinosaurConstants.CentralRequestStatusReady.String(),
+					MigrationStatus: dinosaurConstants.CentralMigrationStatusFailed.String()},
+			},
+			nonEmpty:       true,
+			expectedErrors: 2,
+		},
+		{
+			description: "should keep a cluster which is not empty",
+			nonEmpty:    true,
+		},
+		{
+			description:           "should deprovision an empty cluster",
+			expectedDeprovisioned: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			clusterService := &services.ClusterServiceMock{
+				ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *serviceErrors.ServiceError) {
+					return []api.Cluster{{ClusterID: testClusterID, Status: api.ClusterDraining}}, nil
+				},
+				FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *serviceErrors.ServiceError) {
+					if tc.nonEmpty {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					}
+					return nil, nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					return nil
+				},
+			}
+			dinosaurService := &services.DinosaurServiceMock{
+				ListByClusterIDFunc: func(clusterID string) ([]*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+					return tc.centrals, nil
+				},
+			}
+			migrationService := &services.CentralMigrationServiceMock{
+				MigrateCentralFunc: func(central *dbapi.CentralRequest) *serviceErrors.ServiceError {
+					return nil
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterDrainMaxConcurrentMigrations = 2
+			manager := NewClusterManager(ClusterManagerOptions{
+				DataplaneClusterConfig:  dataplaneClusterConfig,
+				ClusterService:          clusterService,
+				DinosaurService:         dinosaurService,
+				CentralMigrationService: migrationService,
+			})
+
+			errs := manager.processDrainingClusters()
+
+			assert.Len(t, errs, tc.expectedErrors)
+			var migrated []string
+			for _, call := range migrationService.MigrateCentralCalls() {
+				migrated = append(migrated, call.Central.ID)
+			}
+			assert.Equal(t, tc.expectedMigrations, migrated)
+			if len(tc.expectedMigrations) > 0 {
+				assert.Empty(t, clusterService.FindNonEmptyClusterByIDCalls())
+			}
+			if !tc.expectedDeprovisioned {
+				assert.Empty(t, clusterService.UpdateStatusCalls())
+				return
+			}
+			require.Len(t, clusterService.UpdateStatusCalls(), 1)
+			assert.Equal(t, api.ClusterDeprovisioning, clusterService.UpdateStatusCalls()[0].Status)
+		})
+	}
+}
@@ -112,6 +112,7 @@ func (k *AcceptedCentralManager) reconcileAcceptedCentral(centralRequest *dbapi.
 			glog.V(10).Infof("No available central operator version found for Central '%s' in Cluster ID '%s'", centralRequest.ID, centralRequest.ClusterID)
 			return nil
 		}
+		previousStatus := constants2.CentralStatus(centralRequest.Status)
 		centralRequest.Status = constants2.CentralRequestStatusFailed.String()
 		if err != nil {
 			err = errors.Wrapf(err, "failed to get desired central operator version %s", centralRequest.ID)
@@ -119,7 +120,9 @@ func (k *AcceptedCentralManager) reconcileAcceptedCentral(centralRequest *dbapi.
 			err = errors.Errorf("failed to get desired central operator version %s", centralRequest.ID)
 		}
 		centralRequest.FailedReason = err.Error()
-		if err2 := k.centralService.Update(centralRequest); err2 != nil {
+		event := dbapi.NewCentralStatusEvent(centralRequest, previousStatus,
+			constants2.CentralRequestStatusFailed, constants2.CentralEventActorFleetManager, centralRequest.FailedReason)
+		if err2 := k.centralService.Update(centralRequest, event); err2 != nil {
 			return errors.Wrapf(err2, "failed to update failed central %s", centralRequest.ID)
 		}
 		return err
@@ -0,0 +1,159 @@
+package dinosaurmgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	constants2 "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+// CentralMigrationManager switches the DNS records of migrating centrals to the migration target cluster once the
+// target cluster reported the central as ready, and then assigns the central to the target cluster.
+type CentralMigrationManager struct {
+	workers.BaseWorker
+	dinosaurService  services.DinosaurService
+	migrationService services.CentralMigrationService
+	eventService     services.CentralEventService
+	centralConfig    *config.CentralConfig
+}
+
+var _ workers.Worker = &CentralMigrationManager{}
+
+// NewCentralMigrationManager creates a new central migration manager
+func NewCentralMigrationManager(dinosaurService services.DinosaurService, migrationService services.CentralMigrationService, eventService services.CentralEventService, centralConfig *config.CentralConfig) *CentralMigrationManager {
+	return &CentralMigrationManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: "central_migration",
+			Reconciler: workers.Reconciler{},
+		},
+		dinosaurService:  dinosaurService,
+		migrationService: migrationService,
+		eventService:     eventService,
+		centralConfig:    centralConfig,
+	}
+}
+
+// Start initializes the central migration manager to reconcile migrating centrals
+func (k *CentralMigrationManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for reconciling migrating centrals to stop.
+func (k *CentralMigrationManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralMigrationManager) Reconcile() []error {
+	glog.Infoln("reconciling migrating centrals")
+	var encounteredErrors []error
+
+	var provisioningCentrals, switchingDNSCentrals []*dbapi.CentralRequest
+	for _, status := range constants2.GetActiveMigrationStatuses() {
+		migrationStatus := constants2.CentralMigrationStatus(status)
+		centrals, err := k.migrationService.ListByMigrationStatus(migrationStatus)
+		if err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to list centrals in migration status %s", status))
+			continue
+		}
+		glog.Infof("centrals in migration status %s count = %d", status, len(centrals))
+		metrics.UpdateCentralMigrationStatusCountMetric(migrationStatus, len(centrals))
+		switch migrationStatus {
+		case constants2.CentralMigrationStatusScalingDownSource, constants2.CentralMigrationStatusProvisioning:
+			provisioningCentrals = append(provisioningCentrals, centrals...)
+		case constants2.CentralMigrationStatusSwitchingDNS:
+			switchingDNSCentrals = centrals
+		}
+	}
+
+	for _, central := range provisioningCentrals {
+		if err := k.reconcileProvisioningTimeout(central); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to time out migration of central %s", central.ID))
+		}
+	}
+
+	for _, central := range switchingDNSCentrals {
+		if err := k.reconcileSwitchingDNS(central); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to switch DNS for central %s", central.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+// reconcileProvisioningTimeout removes a central from the migration target cluster if the target cluster did not report
+// it as ready within the migration timeout. The central stays on the source cluster.
+func (k *CentralMigrationManager) reconcileProvisioningTimeout(central *dbapi.CentralRequest) error {
+	if central.MigrationStartedAt == nil || time.Since(*central.MigrationStartedAt) < k.centralConfig.MigrationTimeout {
+		return nil
+	}
+	status := constants2.CentralMigrationStatusDeprovisioningTarget
+	if central.MigrationStatus == constants2.CentralMigrationStatusScalingDownSource.String() {
+		// The target cluster only deploys the central once it has been scaled down on the source cluster.
+		glog.Errorf("central %s was not scaled down on migration source cluster %s within %s", central.ID, central.MigrationSourceClusterID, k.centralConfig.MigrationTimeout)
+		status = constants2.CentralMigrationStatusFailed
+	} else {
+		glog.Errorf("central %s was not provisioned on migration target cluster %s within %s", central.ID, central.MigrationTargetClusterID, k.centralConfig.MigrationTimeout)
+	}
+	if err := k.migrationService.UpdateMigrationStatus(central, status, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k *CentralMigrationManager) reconcileSwitchingDNS(central *dbapi.CentralRequest) error {
+	if k.centralConfig.EnableCentralExternalCertificate {
+		if central.RoutesCreationID == "" {
+			glog.Infof("switching CNAME records of central %s to cluster %s", central.ID, central.MigrationTargetClusterID)
+			changeOutput, err := k.dinosaurService.ChangeDinosaurCNAMErecords(central, services.DinosaurRoutesActionUpsert)
+			if err != nil {
+				return err
+			}
+			if changeOutput == nil || changeOutput.ChangeInfo == nil || changeOutput.ChangeInfo.Id == nil || changeOutput.ChangeInfo.Status == nil {
+				return errors.New("switching CNAME records failed with nil result")
+			}
+
+			central.RoutesCreationID = *changeOutput.ChangeInfo.Id
+			if err := k.dinosaurService.Updates(central, map[string]interface{}{"routes_creation_id": central.RoutesCreationID}); err != nil {
+				return err
+			}
+			if *changeOutput.ChangeInfo.Status != "INSYNC" {
+				return nil
+			}
+		} else {
+			recordStatus, err := k.dinosaurService.GetCNAMERecordStatus(central)
+			if err != nil {
+				return errors.Wrap(err, "failed to get CNAME record status")
+			}
+			if recordStatus.Status == nil || *recordStatus.Status != "INSYNC" {
+				glog.V(10).Infof("CNAME records of central %s are not in sync yet", central.ID)
+				return nil
+			}
+		}
+	} else {
+		glog.Infof("external certificate is disabled, skip CNAME switch for Central %s", central.ID)
+	}
+
+	glog.Infof("assigning central %s to migration target cluster %s", central.ID, central.MigrationTargetClusterID)
+	// The placement ID changes as the central is assigned to another cluster.
+	if err := k.migrationService.UpdateMigrationStatus(central, constants2.CentralMigrationStatusDeprovisioningSource, map[string]interface{}{
+		"cluster_id":   central.MigrationTargetClusterID,
+		"placement_id": api.NewID(),
+	}); err != nil {
+		return err
+	}
+	central.ClusterID = central.MigrationTargetClusterID
+	services.RecordCentralEvent(k.eventService, dbapi.NewCentralPlacementEvent(central, constants2.CentralEventActorFleetManager,
+		fmt.Sprintf("migrated from cluster %s", central.MigrationSourceClusterID)))
+	return nil
+}
@@ -0,0 +1,108 @@
+package dinosaurmgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/dbapi"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralMigrationManagerReconcile(t *testing.T) {
+	const migrationTimeout = time.Hour
+	startedInTime := time.Now().Add(-time.Minute)
+	startedTimedOut := time.Now().Add(-2 * migrationTimeout)
+
+	tt := []struct {
+		description     string
+		migrationStatus constants.CentralMigrationStatus
+		startedAt       *time.Time
+		expectedStatus  constants.CentralMigrationStatus
+		expectedCluster string
+	}{
+		{
+			description:     "should fail the migration if the central is not scaled down on the source in time",
+			migrationStatus: constants.CentralMigrationStatusScalingDownSource,
+			startedAt:       &startedTimedOut,
+			expectedStatus:  constants.CentralMigrationStatusFailed,
+		},
+		{
+			description:     "should wait for the central to be scaled down on the source",
+			migrationStatus: constants.CentralMigrationStatusScalingDownSource,
+			startedAt:       &startedInTime,
+		},
+		{
+			description:     "should remove the central from the target if it is not provisioned in time",
+			migrationStatus: constants.CentralMigrationStatusProvisioning,
+			startedAt:       &startedTimedOut,
+			expectedStatus:  constants.CentralMigrationStatusDeprovisioningTarget,
+		},
+		{
+			description:     "should wait for the central to be provisioned on the target",
+			migrationStatus: constants.CentralMigrationStatusProvisioning,
+			startedAt:       &startedInTime,
+		},
+		{
+			description:     "should assign the central to the target once the DNS is switched",
+			migrationStatus: constants.CentralMigrationStatusSwitchingDNS,
+			startedAt:       &startedTimedOut,
+			expectedStatus:  constants.CentralMigrationStatusDeprovisioningSource,
+			expectedCluster: "target",
+		},
+		{
+			description:     "should not time out a central which is removed from the source",
+			migrationStatus: constants.CentralMigrationStatusDeprovisioningSource,
+			startedAt:       &startedTimedOut,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			central := &dbapi.CentralRequest{
+				MigrationStatus:          tc.migrationStatus.String(),
+				MigrationStartedAt:       tc.startedAt,
+				MigrationSourceClusterID: "source",
+				MigrationTargetClusterID: "target",
+				ClusterID:                "source",
+			}
+			migrationService := &services.CentralMigrationServiceMock{
+				ListByMigrationStatusFunc: func(status ...constants.CentralMigrationStatus) ([]*dbapi.CentralRequest, *serviceErrors.ServiceError) {
+					if len(status) == 1 && status[0] == tc.migrationStatus {
+						return []*dbapi.CentralRequest{central}, nil
+					}
+					return nil, nil
+				},
+				UpdateMigrationStatusFunc: func(central *dbapi.CentralRequest, status constants.CentralMigrationStatus, fields map[string]interface{}) *serviceErrors.ServiceError {
+					return nil
+				},
+			}
+			eventService := &services.CentralEventServiceMock{
+				RecordFunc: func(event *dbapi.CentralEvent) *serviceErrors.ServiceError {
+					return nil
+				},
+			}
+			centralConfig := &config.CentralConfig{MigrationTimeout: migrationTimeout}
+			manager := NewCentralMigrationManager(&services.DinosaurServiceMock{}, migrationService, eventService, centralConfig)
+
+			require.Empty(t, manager.Reconcile())
+
+			calls := migrationService.UpdateMigrationStatusCalls()
+			if tc.expectedStatus == "" {
+				assert.Empty(t, calls)
+				return
+			}
+			require.Len(t, calls, 1)
+			assert.Equal(t, tc.expectedStatus, calls[0].Status)
+			if tc.expectedCluster != "" {
+				assert.Equal(t, tc.expectedCluster, calls[0].Fields["cluster_id"])
+				assert.Equal(t, tc.expectedCluster, central.ClusterID)
+				assert.Len(t, eventService.RecordCalls(), 1)
+			}
+		})
+	}
+}
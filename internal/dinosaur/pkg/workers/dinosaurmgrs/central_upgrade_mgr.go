@@ -0,0 +1,58 @@
+package dinosaurmgrs
+
+import (
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+// CentralUpgradeManager advances the active central upgrade rollout. It starts the next wave of the rollout once all
+// upgrades of the current wave are done, and pauses the rollout when too many upgrades failed.
+type CentralUpgradeManager struct {
+	workers.BaseWorker
+	upgradeService services.CentralUpgradeService
+}
+
+var _ workers.Worker = &CentralUpgradeManager{}
+
+// NewCentralUpgradeManager creates a new central upgrade manager
+func NewCentralUpgradeManager(upgradeService services.CentralUpgradeService) *CentralUpgradeManager {
+	return &CentralUpgradeManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: "central_upgrade",
+			Reconciler: workers.Reconciler{},
+		},
+		upgradeService: upgradeService,
+	}
+}
+
+// Start initializes the central upgrade manager to reconcile the active rollout
+func (k *CentralUpgradeManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for reconciling the active rollout to stop.
+func (k *CentralUpgradeManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralUpgradeManager) Reconcile() []error {
+	glog.Infoln("reconciling central upgrade rollout")
+
+	rollout, err := k.upgradeService.GetActiveRollout()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to get active central upgrade rollout")}
+	}
+	if rollout == nil {
+		return nil
+	}
+
+	if err := k.upgradeService.ReconcileRollout(rollout); err != nil {
+		return []error{errors.Wrapf(err, "failed to reconcile central upgrade rollout %s", rollout.ID)}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package dinosaurmgrs
+
+import (
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+// CentralWebhookManager delivers the lifecycle events of centrals from the outbox of webhook deliveries to the webhook
+// endpoints of organisations. Failed deliveries are retried with exponential backoff, and completed deliveries are
+// pruned after the retention period.
+type CentralWebhookManager struct {
+	workers.BaseWorker
+	webhookService services.CentralWebhookService
+}
+
+var _ workers.Worker = &CentralWebhookManager{}
+
+// NewCentralWebhookManager creates a new central webhook manager
+func NewCentralWebhookManager(webhookService services.CentralWebhookService) *CentralWebhookManager {
+	return &CentralWebhookManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: "central_webhook",
+			Reconciler: workers.Reconciler{},
+		},
+		webhookService: webhookService,
+	}
+}
+
+// Start initializes the central webhook manager to reconcile the due webhook deliveries
+func (k *CentralWebhookManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for reconciling the due webhook deliveries to stop.
+func (k *CentralWebhookManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralWebhookManager) Reconcile() []error {
+	glog.Infoln("reconciling central webhook deliveries")
+	var encounteredErrors []error
+
+	deliveries, err := k.webhookService.ListDueDeliveries()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list due central webhook deliveries")}
+	}
+
+	for _, delivery := range deliveries {
+		if err := k.webhookService.Deliver(delivery); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to deliver %s event of central %s to webhook %s", delivery.EventType, delivery.CentralID, delivery.WebhookID))
+		}
+	}
+
+	if err := k.webhookService.PruneDeliveries(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to prune central webhook deliveries"))
+	}
+	return encounteredErrors
+}
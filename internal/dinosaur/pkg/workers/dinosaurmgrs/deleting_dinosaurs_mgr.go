@@ -133,7 +133,7 @@ func (k *DeletingDinosaurManager) reconcileDeletingDinosaurs(dinosaur *dbapi.Cen
 			dinosaur.ClientOrigin, dinosaur.ID)
 	}
 
-	if err := k.dinosaurService.Delete(dinosaur, false); err != nil {
+	if err := k.dinosaurService.Delete(dinosaur, false, nil); err != nil {
 		return errors.Wrapf(err, "failed to delete central %s", dinosaur.ID)
 	}
 	return nil
@@ -1,6 +1,8 @@
 package dinosaurmgrs
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -19,6 +21,9 @@ var dinosaurMetricsStatuses = []constants2.CentralStatus{
 	constants2.CentralRequestStatusPreparing,
 	constants2.CentralRequestStatusProvisioning,
 	constants2.CentralRequestStatusReady,
+	constants2.CentralRequestStatusSuspending,
+	constants2.CentralRequestStatusSuspended,
+	constants2.CentralRequestStatusResuming,
 	constants2.CentralRequestStatusDeprovision,
 	constants2.CentralRequestStatusDeleting,
 	constants2.CentralRequestStatusFailed,
@@ -30,10 +35,11 @@ type DinosaurManager struct {
 	dinosaurService         services.DinosaurService
 	accessControlListConfig *acl.AccessControlListConfig
 	dinosaurConfig          *config.CentralConfig
+	idempotencyService      services.CentralIdempotencyService
 }
 
 // NewDinosaurManager creates a new dinosaur manager
-func NewDinosaurManager(dinosaurService services.DinosaurService, accessControlList *acl.AccessControlListConfig, dinosaur *config.CentralConfig) *DinosaurManager {
+func NewDinosaurManager(dinosaurService services.DinosaurService, accessControlList *acl.AccessControlListConfig, dinosaur *config.CentralConfig, idempotencyService services.CentralIdempotencyService) *DinosaurManager {
 	return &DinosaurManager{
 		BaseWorker: workers.BaseWorker{
 			ID:         uuid.New().String(),
@@ -43,6 +49,7 @@ func NewDinosaurManager(dinosaurService services.DinosaurService, accessControlL
 		dinosaurService:         dinosaurService,
 		accessControlListConfig: accessControlList,
 		dinosaurConfig:          dinosaur,
+		idempotencyService:      idempotencyService,
 	}
 }
 
@@ -87,14 +94,30 @@ func (k *DinosaurManager) Reconcile() []error {
 	// cleaning up expired dinosaurs
 	dinosaurConfig := k.dinosaurConfig
 	if dinosaurConfig.CentralLifespan.EnableDeletionOfExpiredCentral {
+		glog.Infoln("setting missing expirations of centrals")
+		if err := k.dinosaurService.SetMissingExpirations(); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to set missing expirations of Central instances"))
+		}
+
+		glog.Infoln("warning about expiring centrals")
+		warningPeriod := time.Duration(dinosaurConfig.CentralLifespan.CentralExpiryWarningInHours) * time.Hour
+		if err := k.dinosaurService.WarnExpiringCentrals(warningPeriod); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to warn about expiring Central instances"))
+		}
+
 		glog.Infoln("deprovisioning expired centrals")
-		expiredDinosaursError := k.dinosaurService.DeprovisionExpiredDinosaurs(dinosaurConfig.CentralLifespan.CentralLifespanInHours)
+		expiredDinosaursError := k.dinosaurService.DeprovisionExpiredDinosaurs()
 		if expiredDinosaursError != nil {
 			wrappedError := errors.Wrap(expiredDinosaursError, "failed to deprovision expired Central instances")
 			encounteredErrors = append(encounteredErrors, wrappedError)
 		}
 	}
 
+	glog.Infoln("deleting expired central idempotency keys")
+	if err := k.idempotencyService.DeleteExpiredKeys(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to delete expired central idempotency keys"))
+	}
+
 	return encounteredErrors
 }
 
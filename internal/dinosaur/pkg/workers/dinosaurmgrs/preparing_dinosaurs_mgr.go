@@ -89,6 +89,7 @@ func (k *PreparingDinosaurManager) reconcilePreparingDinosaur(dinosaur *dbapi.Ce
 }
 
 func (k *PreparingDinosaurManager) handleDinosaurRequestCreationError(dinosaurRequest *dbapi.CentralRequest, err *serviceErr.ServiceError) error {
+	previousStatus := constants2.CentralStatus(dinosaurRequest.Status)
 	if err.IsServerErrorClass() {
 		// retry the dinosaur creation request only if the failure is caused by server errors
 		// and the time elapsed since its db record was created is still within the threshold.
@@ -97,7 +98,8 @@ func (k *PreparingDinosaurManager) handleDinosaurRequestCreationError(dinosaurRe
 			metrics.IncreaseCentralTotalOperationsCountMetric(constants2.CentralOperationCreate)
 			dinosaurRequest.Status = string(constants2.CentralRequestStatusFailed)
 			dinosaurRequest.FailedReason = err.Reason
-			updateErr := k.dinosaurService.Update(dinosaurRequest)
+			updateErr := k.dinosaurService.Update(dinosaurRequest, dbapi.NewCentralStatusEvent(dinosaurRequest, previousStatus,
+				constants2.CentralRequestStatusFailed, constants2.CentralEventActorFleetManager, dinosaurRequest.FailedReason))
 			if updateErr != nil {
 				return errors.Wrapf(updateErr, "Failed to update central %s in failed state. Central failed reason %s", dinosaurRequest.ID, dinosaurRequest.FailedReason)
 			}
@@ -108,7 +110,8 @@ func (k *PreparingDinosaurManager) handleDinosaurRequestCreationError(dinosaurRe
 		metrics.IncreaseCentralTotalOperationsCountMetric(constants2.CentralOperationCreate)
 		dinosaurRequest.Status = string(constants2.CentralRequestStatusFailed)
 		dinosaurRequest.FailedReason = err.Reason
-		updateErr := k.dinosaurService.Update(dinosaurRequest)
+		updateErr := k.dinosaurService.Update(dinosaurRequest, dbapi.NewCentralStatusEvent(dinosaurRequest, previousStatus,
+			constants2.CentralRequestStatusFailed, constants2.CentralEventActorFleetManager, dinosaurRequest.FailedReason))
 		if updateErr != nil {
 			return errors.Wrapf(err, "Failed to update central %s in failed state", dinosaurRequest.ID)
 		}
@@ -14,10 +14,13 @@ import (
 // Returns true if timeout is exceeded, otherwise false.
 func FailIfTimeoutExceeded(centralService services.DinosaurService, timeout time.Duration, centralRequest *dbapi.CentralRequest) error {
 	if centralRequest.CreatedAt.Before(time.Now().Add(-timeout)) {
+		previousStatus := constants2.CentralStatus(centralRequest.Status)
 		centralRequest.Status = constants2.CentralRequestStatusFailed.String()
 		centralRequest.FailedReason = "Creation time went over the timeout. Interrupting central initialization."
 
-		if err := centralService.Update(centralRequest); err != nil {
+		event := dbapi.NewCentralStatusEvent(centralRequest, previousStatus,
+			constants2.CentralRequestStatusFailed, constants2.CentralEventActorFleetManager, centralRequest.FailedReason)
+		if err := centralService.Update(centralRequest, event); err != nil {
 			return errors.Wrapf(err, "failed to update timed out central %s", centralRequest.ID)
 		}
 		metrics.UpdateCentralRequestsStatusSinceCreatedMetric(constants2.CentralRequestStatusFailed, centralRequest.ID, centralRequest.ClusterID, time.Since(centralRequest.CreatedAt))
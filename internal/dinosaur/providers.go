@@ -46,6 +46,7 @@ func ConfigProviders() di.Option {
 		// Configuration for the Dinosaur service...
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewCentralPlansConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewCentralConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule))),
@@ -75,6 +76,16 @@ func ServiceProviders() di.Option {
 		di.Provide(services.NewClusterPlacementStrategy),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneCentralService, di.As(new(services.DataPlaneCentralService))),
+		di.Provide(services.NewCentralMigrationService),
+		di.Provide(services.NewCentralBackupService),
+		di.Provide(services.NewCentralHibernationService),
+		di.Provide(services.NewCentralLifespanService),
+		di.Provide(services.NewCentralUpgradeService),
+		di.Provide(services.NewCentralChangeNotifier, di.As(new(services.CentralChangeNotifier)), di.As(new(environments2.BootService))),
+		di.Provide(services.NewCentralWatchService),
+		di.Provide(services.NewCentralWebhookService),
+		di.Provide(services.NewCentralEventService),
+		di.Provide(services.NewCentralIdempotencyService),
 		di.Provide(handlers.NewAuthenticationBuilder),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
@@ -88,6 +99,9 @@ func ServiceProviders() di.Option {
 		di.Provide(dinosaurmgrs.NewReadyDinosaurManager, di.As(new(workers.Worker))),
 		di.Provide(dinosaurmgrs.NewDinosaurCNAMEManager, di.As(new(workers.Worker))),
 		di.Provide(dinosaurmgrs.NewCentralAuthConfigManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralMigrationManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralUpgradeManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralWebhookManager, di.As(new(workers.Worker))),
 		di.Provide(presenters.NewManagedCentralPresenter),
 	)
 }
@@ -59,7 +59,7 @@ func WaitForDinosaurCreateToBeAccepted(ctx context.Context, db *db.ConnectionFac
 			return fmt.Sprintf("Waiting for central creation to be accepted (current status %s)", currentStatus)
 		}).
 		OnRetry(func(attempt int, maxRetries int) (done bool, err error) {
-			dinosaur, resp, err = client.DefaultApi.CreateCentral(ctx, true, k)
+			dinosaur, resp, err = client.DefaultApi.CreateCentral(ctx, true, k, nil)
 			if err != nil {
 				return true, fmt.Errorf("waiting for central creation to be accepted: %w", err)
 			}
@@ -141,7 +141,7 @@ func TestDinosaurCreate_TooManyDinosaurs(t *testing.T) {
 		MultiAz:       testMultiAZ,
 	}
 
-	_, resp, err := client.DefaultApi.CreateCentral(ctx, true, k)
+	_, resp, err := client.DefaultApi.CreateCentral(ctx, true, k, nil)
 
 	Expect(err).To(HaveOccurred(), "Error posting object:  %v", err)
 	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
@@ -231,7 +231,7 @@ func TestDinosaurPost_Validations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			RegisterTestingT(t)
-			_, resp, _ := client.DefaultApi.CreateCentral(ctx, true, tt.body)
+			_, resp, _ := client.DefaultApi.CreateCentral(ctx, true, tt.body, nil)
 			Expect(resp.StatusCode).To(Equal(tt.wantCode))
 		})
 	}
@@ -273,13 +273,13 @@ func TestDinosaurPost_NameUniquenessValidations(t *testing.T) {
 	}
 
 	// create the first dinosaur
-	_, resp1, _ := client.DefaultApi.CreateCentral(ctx1, true, k)
+	_, resp1, _ := client.DefaultApi.CreateCentral(ctx1, true, k, nil)
 
 	// attempt to create the second dinosaur with same name
-	_, resp2, _ := client.DefaultApi.CreateCentral(ctx2, true, k)
+	_, resp2, _ := client.DefaultApi.CreateCentral(ctx2, true, k, nil)
 
 	// create another dinosaur with same name for different org
-	_, resp3, _ := client.DefaultApi.CreateCentral(ctx3, true, k)
+	_, resp3, _ := client.DefaultApi.CreateCentral(ctx3, true, k, nil)
 
 	// verify that the first and third requests were accepted
 	Expect(resp1.StatusCode).To(Equal(http.StatusAccepted))
@@ -313,7 +313,7 @@ func TestDinosaurGet(t *testing.T) {
 		MultiAz:       testMultiAZ,
 	}
 
-	seedDinosaur, _, err := client.DefaultApi.CreateCentral(ctx, true, k)
+	seedDinosaur, _, err := client.DefaultApi.CreateCentral(ctx, true, k, nil)
 	if err != nil {
 		t.Fatalf("failed to create seeded dinosaur request: %s", err.Error())
 	}
@@ -483,7 +483,7 @@ func TestDinosaur_Delete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			client := test.NewAPIClient(h)
-			resp, err := client.DefaultApi.DeleteCentralById(tt.args.ctx, tt.args.dinosaurID, tt.args.async)
+			resp, err := client.DefaultApi.DeleteCentralById(tt.args.ctx, tt.args.dinosaurID, tt.args.async, nil)
 			tt.verifyResponse(resp, err)
 		})
 	}
@@ -531,7 +531,7 @@ func TestDinosaurList_Success(t *testing.T) {
 	}
 
 	// POST dinosaur request to populate the list
-	seedDinosaur, _, err := client.DefaultApi.CreateCentral(initCtx, true, k)
+	seedDinosaur, _, err := client.DefaultApi.CreateCentral(initCtx, true, k, nil)
 	if err != nil {
 		t.Fatalf("failed to create seeded DinosaurRequest: %s", err.Error())
 	}
@@ -71,7 +71,7 @@ func TestTermsRequired_CreateDinosaurTermsRequired(t *testing.T) {
 		MultiAz:       testMultiAZ,
 	}
 
-	_, resp, err := env.client.DefaultApi.CreateCentral(ctx, true, k)
+	_, resp, err := env.client.DefaultApi.CreateCentral(ctx, true, k, nil)
 
 	Expect(err).To(HaveOccurred())
 	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
@@ -98,7 +98,7 @@ func TestTermsRequired_CreateDinosaur_TermsNotRequired(t *testing.T) {
 		MultiAz:       testMultiAZ,
 	}
 
-	_, resp, err := env.client.DefaultApi.CreateCentral(ctx, true, k)
+	_, resp, err := env.client.DefaultApi.CreateCentral(ctx, true, k, nil)
 
 	Expect(err).NotTo(HaveOccurred())
 	Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
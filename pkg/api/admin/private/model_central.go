@@ -19,7 +19,7 @@ type Central struct {
 	Id   string `json:"id,omitempty"`
 	Kind string `json:"kind,omitempty"`
 	Href string `json:"href,omitempty"`
-	// Values: [accepted, preparing, provisioning, ready, failed, deprovision, deleting]
+	// Values: [accepted, preparing, provisioning, ready, suspending, suspended, resuming, failed, deprovision, deleting]
 	Status string `json:"status,omitempty"`
 	// Name of Cloud used to deploy. For example AWS
 	CloudProvider string `json:"cloud_provider,omitempty"`
@@ -48,6 +48,25 @@ type Central struct {
 	RoutesCreated                 bool                 `json:"routes_created,omitempty"`
 	ClusterId                     string               `json:"cluster_id,omitempty"`
 	Namespace                     string               `json:"namespace,omitempty"`
-	Central                       CentralSpec          `json:"central,omitempty"`
-	Scanner                       ScannerSpec          `json:"scanner,omitempty"`
+	// Values: [provisioning, switching_dns, deprovisioning_source, deprovisioning_target, completed, failed]
+	MigrationStatus          string    `json:"migration_status,omitempty"`
+	MigrationSourceClusterId string    `json:"migration_source_cluster_id,omitempty"`
+	MigrationTargetClusterId string    `json:"migration_target_cluster_id,omitempty"`
+	MigrationStartedAt       time.Time `json:"migration_started_at,omitempty"`
+	DbBackupId               string    `json:"db_backup_id,omitempty"`
+	// Values: [pending, in_progress, completed, failed]
+	DbBackupStatus      string `json:"db_backup_status,omitempty"`
+	DbRestoreId         string `json:"db_restore_id,omitempty"`
+	DbRestoreSnapshotId string `json:"db_restore_snapshot_id,omitempty"`
+	// Values: [pending, in_progress, completed, failed]
+	DbRestoreStatus    string                    `json:"db_restore_status,omitempty"`
+	DbFailedReason     string                    `json:"db_failed_reason,omitempty"`
+	DbSnapshots        []CentralAllOfDbSnapshots `json:"db_snapshots,omitempty"`
+	Central            CentralSpec               `json:"central,omitempty"`
+	Scanner            ScannerSpec               `json:"scanner,omitempty"`
+	Plan               string                    `json:"plan,omitempty"`
+	ExpiresAt          *time.Time                `json:"expires_at,omitempty"`
+	LifespanExtensions int32                     `json:"lifespan_extensions,omitempty"`
+	EgressAllowlist    CentralEgressAllowlist    `json:"egress_allowlist,omitempty"`
+	HealthConditions   []CentralHealthCondition  `json:"health_conditions,omitempty"`
 }
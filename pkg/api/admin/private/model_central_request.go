@@ -19,7 +19,7 @@ type CentralRequest struct {
 	Id   string `json:"id,omitempty"`
 	Kind string `json:"kind,omitempty"`
 	Href string `json:"href,omitempty"`
-	// Values: [accepted, preparing, provisioning, ready, failed, deprovision, deleting]
+	// Values: [accepted, preparing, provisioning, ready, suspending, suspended, resuming, failed, deprovision, deleting]
 	Status string `json:"status,omitempty"`
 	// Name of Cloud used to deploy. For example AWS
 	CloudProvider string `json:"cloud_provider,omitempty"`
@@ -102,6 +102,8 @@ const (
 	ClusterFailed ClusterStatus = "failed"
 	// ClusterReady the cluster is terraformed and ready for central instances
 	ClusterReady ClusterStatus = "ready"
+	// ClusterDraining the centrals on the cluster are migrated to other clusters before the cluster is deprovisioned
+	ClusterDraining ClusterStatus = "draining"
 	// ClusterDeprovisioning the cluster is empty and can be deprovisioned
 	ClusterDeprovisioning ClusterStatus = "deprovisioning"
 	// ClusterCleanup the cluster external resources are being removed
@@ -130,6 +132,7 @@ var ordinals = map[string]int{
 	ClusterWaitingForFleetShardOperator.String(): 30,
 	ClusterReady.String():                        40,
 	ClusterComputeNodeScalingUp.String():         50,
+	ClusterDraining.String():                     55,
 	ClusterDeprovisioning.String():               60,
 	ClusterCleanup.String():                      70,
 	ClusterFailed.String():                       80,
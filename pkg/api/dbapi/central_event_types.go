@@ -0,0 +1,128 @@
+package dbapi
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CentralEvent is an entry of the append-only event history of a central. It records a change of the status of the
+// central, its placement on a data plane cluster, a failure, an upcoming expiration, an extension of its lifespan or its
+// final deletion.
+type CentralEvent struct {
+	api.Meta
+	CentralID string `json:"central_id" gorm:"index"`
+	Type      string `json:"type"`
+	// FromStatus and ToStatus are the status of the central before and after the event.
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	// ClusterID is the data plane cluster the central was assigned to at the time of the event.
+	ClusterID string `json:"cluster_id"`
+	// Actor is the user or the component which caused the event.
+	Actor  string `json:"actor"`
+	Reason string `json:"reason"`
+}
+
+// CentralEventList ...
+type CentralEventList []*CentralEvent
+
+// BeforeCreate ...
+func (e *CentralEvent) BeforeCreate(scope *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = api.NewID()
+	}
+	return nil
+}
+
+// PublicActor returns the actor of the event as presented to the owner of the central. Users other than the owner, e.g.
+// administrators, are not disclosed.
+func (e *CentralEvent) PublicActor(owner string) string {
+	if e.Actor == owner || e.Actor == constants.CentralEventActorFleetManager || e.Actor == constants.CentralEventActorDataPlane {
+		return e.Actor
+	}
+	return ""
+}
+
+// PublicReason returns the reason of the event as presented to the owner of the central. The reasons of failures and
+// placements may contain internal details, e.g. errors of the data plane cluster or cluster IDs, and the reasons given
+// by undisclosed actors may be internal notes. Neither is presented.
+func (e *CentralEvent) PublicReason(owner string) string {
+	if e.PublicActor(owner) == "" {
+		return ""
+	}
+	switch constants.CentralEventType(e.Type) {
+	case constants.CentralEventTypeFailure, constants.CentralEventTypePlacement:
+		return ""
+	default:
+		return e.Reason
+	}
+}
+
+// NewCentralStatusEvent returns an event recording the change of the status of a central. A change to the failed status
+// is recorded as a failure.
+func NewCentralStatusEvent(central *CentralRequest, from, to constants.CentralStatus, actor, reason string) *CentralEvent {
+	eventType := constants.CentralEventTypeStatusChange
+	if to == constants.CentralRequestStatusFailed {
+		eventType = constants.CentralEventTypeFailure
+	}
+	return &CentralEvent{
+		CentralID:  central.ID,
+		Type:       eventType.String(),
+		FromStatus: from.String(),
+		ToStatus:   to.String(),
+		ClusterID:  central.ClusterID,
+		Actor:      actor,
+		Reason:     reason,
+	}
+}
+
+// NewCentralPlacementEvent returns an event recording the placement of a central on the cluster it is assigned to.
+func NewCentralPlacementEvent(central *CentralRequest, actor, reason string) *CentralEvent {
+	return &CentralEvent{
+		CentralID:  central.ID,
+		Type:       constants.CentralEventTypePlacement.String(),
+		FromStatus: central.Status,
+		ToStatus:   central.Status,
+		ClusterID:  central.ClusterID,
+		Actor:      actor,
+		Reason:     reason,
+	}
+}
+
+// NewCentralDeletionEvent returns an event recording the final deletion of a central.
+func NewCentralDeletionEvent(central *CentralRequest, actor, reason string) *CentralEvent {
+	return &CentralEvent{
+		CentralID:  central.ID,
+		Type:       constants.CentralEventTypeDeletion.String(),
+		FromStatus: central.Status,
+		ClusterID:  central.ClusterID,
+		Actor:      actor,
+		Reason:     reason,
+	}
+}
+
+// NewCentralExpiryWarningEvent returns an event warning that a central is about to expire.
+func NewCentralExpiryWarningEvent(central *CentralRequest, reason string) *CentralEvent {
+	return &CentralEvent{
+		CentralID:  central.ID,
+		Type:       constants.CentralEventTypeExpiryWarning.String(),
+		FromStatus: central.Status,
+		ToStatus:   central.Status,
+		ClusterID:  central.ClusterID,
+		Actor:      constants.CentralEventActorFleetManager,
+		Reason:     reason,
+	}
+}
+
+// NewCentralLifespanExtensionEvent returns an event recording the extension of the lifespan of a central.
+func NewCentralLifespanExtensionEvent(central *CentralRequest, actor, reason string) *CentralEvent {
+	return &CentralEvent{
+		CentralID:  central.ID,
+		Type:       constants.CentralEventTypeLifespanExtension.String(),
+		FromStatus: central.Status,
+		ToStatus:   central.Status,
+		ClusterID:  central.ClusterID,
+		Actor:      actor,
+		Reason:     reason,
+	}
+}
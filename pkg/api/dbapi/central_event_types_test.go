@@ -0,0 +1,85 @@
+package dbapi
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCentralStatusEvent(t *testing.T) {
+	central := &CentralRequest{ClusterID: "cluster-1"}
+	central.ID = "central-1"
+
+	tests := []struct {
+		name     string
+		from     constants.CentralStatus
+		to       constants.CentralStatus
+		wantType string
+	}{
+		{
+			name:     "change of the status is recorded as status change",
+			from:     constants.CentralRequestStatusProvisioning,
+			to:       constants.CentralRequestStatusReady,
+			wantType: "status_change",
+		},
+		{
+			name:     "change to failed is recorded as failure",
+			from:     constants.CentralRequestStatusProvisioning,
+			to:       constants.CentralRequestStatusFailed,
+			wantType: "failure",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := NewCentralStatusEvent(central, tt.from, tt.to, "fleet-manager", "reason")
+			assert.Equal(t, tt.wantType, event.Type)
+			assert.Equal(t, "central-1", event.CentralID)
+			assert.Equal(t, "cluster-1", event.ClusterID)
+			assert.Equal(t, tt.from.String(), event.FromStatus)
+			assert.Equal(t, tt.to.String(), event.ToStatus)
+		})
+	}
+}
+
+func TestCentralEventPublicActorAndReason(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      CentralEvent
+		wantActor  string
+		wantReason string
+	}{
+		{
+			name:       "events caused by the owner are presented",
+			event:      CentralEvent{Type: "status_change", Actor: "owner", Reason: "deletion requested"},
+			wantActor:  "owner",
+			wantReason: "deletion requested",
+		},
+		{
+			name:       "events caused by fleet-manager are presented",
+			event:      CentralEvent{Type: "expiry_warning", Actor: constants.CentralEventActorFleetManager, Reason: "central expires soon"},
+			wantActor:  constants.CentralEventActorFleetManager,
+			wantReason: "central expires soon",
+		},
+		{
+			name:  "events caused by administrators are presented without actor and reason",
+			event: CentralEvent{Type: "status_change", Actor: "sre-admin", Reason: "noisy neighbour"},
+		},
+		{
+			name:      "failures are presented without reason",
+			event:     CentralEvent{Type: "failure", Actor: constants.CentralEventActorDataPlane, Reason: "internal error"},
+			wantActor: constants.CentralEventActorDataPlane,
+		},
+		{
+			name:      "placements are presented without reason",
+			event:     CentralEvent{Type: "placement", Actor: constants.CentralEventActorFleetManager, Reason: "migrated from cluster 1"},
+			wantActor: constants.CentralEventActorFleetManager,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantActor, tt.event.PublicActor("owner"))
+			assert.Equal(t, tt.wantReason, tt.event.PublicReason("owner"))
+		})
+	}
+}
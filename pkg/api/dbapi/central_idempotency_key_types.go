@@ -0,0 +1,31 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CentralIdempotencyKey is the Idempotency-Key of a request creating a central. A retry of the request with the same
+// key and body returns the central created by the first request instead of creating another one.
+type CentralIdempotencyKey struct {
+	api.Meta
+	OrganisationID string `json:"organisation_id" gorm:"uniqueIndex:idx_central_idempotency_keys_scope_key"`
+	// Owner scopes the keys of users which are not part of an organisation. It is empty for keys of organisations.
+	Owner string `json:"owner" gorm:"uniqueIndex:idx_central_idempotency_keys_scope_key"`
+	Key   string `json:"key" gorm:"uniqueIndex:idx_central_idempotency_keys_scope_key"`
+	// RequestHash is the hash of the body of the request the key was first used for.
+	RequestHash string `json:"request_hash"`
+	// CentralID is the central created by the request. It is empty while the request is in progress.
+	CentralID string    `json:"central_id"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"index"`
+}
+
+// BeforeCreate ...
+func (k *CentralIdempotencyKey) BeforeCreate(scope *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = api.NewID()
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"gorm.io/gorm"
 )
@@ -40,6 +41,9 @@ type CentralRequest struct {
 	PlacementID string   `json:"placement_id"`
 	Central     api.JSON `json:"central"` // Schema is defined by dbapi.CentralSpec
 	Scanner     api.JSON `json:"scanner"` // Schema is defined by dbapi.ScannerSpec
+	// Plan is the name of the size plan the Central and Scanner specs were initialised from. Overrides of individual
+	// values by the admin API are kept until the plan is changed.
+	Plan string `json:"plan"`
 
 	DesiredCentralVersion         string `json:"desired_central_version"`
 	ActualCentralVersion          string `json:"actual_central_version"`
@@ -61,11 +65,78 @@ type CentralRequest struct {
 	RoutesCreationID string `json:"routes_creation_id"`
 	// DeletionTimestamp stores the timestamp of the DELETE api call for the resource
 	DeletionTimestamp *time.Time `json:"deletionTimestamp"`
+	// MigrationStatus is the status of the last migration of the central to another data plane cluster.
+	MigrationStatus string `json:"migration_status" gorm:"index"`
+	// MigrationSourceClusterID is the data plane cluster the central is migrated from.
+	MigrationSourceClusterID string `json:"migration_source_cluster_id"`
+	// MigrationTargetClusterID is the data plane cluster the central is migrated to.
+	MigrationTargetClusterID string `json:"migration_target_cluster_id"`
+	// MigrationStartedAt stores the timestamp when the last migration of the central was started.
+	MigrationStartedAt *time.Time `json:"migration_started_at"`
+	// DBBackupID is the ID of the last requested on-demand snapshot of the managed database of the central.
+	DBBackupID string `json:"db_backup_id"`
+	// DBBackupStatus is the status of the last requested on-demand snapshot.
+	DBBackupStatus string `json:"db_backup_status"`
+	// DBRestoreID is the ID of the last requested restore of the managed database of the central.
+	DBRestoreID string `json:"db_restore_id"`
+	// DBRestoreSnapshotID is the snapshot the managed database is restored from by the last requested restore.
+	DBRestoreSnapshotID string `json:"db_restore_snapshot_id"`
+	// DBRestoreStatus is the status of the last requested restore.
+	DBRestoreStatus string `json:"db_restore_status"`
+	// DBFailedReason is the reason the last backup or restore of the managed database failed.
+	DBFailedReason string `json:"db_failed_reason"`
+	// DBSnapshots are the snapshots of the managed database last reported by the data plane cluster.
+	DBSnapshots api.JSON `json:"db_snapshots"`
+	// UpgradeRolloutID is the rollout which last set the desired versions of the central.
+	UpgradeRolloutID string `json:"upgrade_rollout_id" gorm:"index"`
+	// UpgradeStatus is the status of the upgrade of the central by the rollout.
+	UpgradeStatus string `json:"upgrade_status"`
+	// UpgradeStartedAt stores the timestamp when the rollout set the desired versions of the central.
+	UpgradeStartedAt *time.Time `json:"upgrade_started_at"`
+	// ResourceVersion is incremented on every update of the central. Updates only succeed if the central has not been
+	// updated since it was read, so that concurrent updates do not overwrite each other.
+	ResourceVersion int64 `json:"resource_version" gorm:"not null;default:1"`
+	// ExpiresAt is the time after which the central is deprovisioned. Centrals of instance types without a lifespan
+	// do not expire.
+	ExpiresAt *time.Time `json:"expires_at" gorm:"index"`
+	// LifespanExtensions is the number of self-service extensions of the lifespan of the central.
+	LifespanExtensions int `json:"lifespan_extensions"`
+	// ExpiryWarningSent is set once the expiry warning event of the central has been recorded. It is reset when the
+	// lifespan of the central is extended.
+	ExpiryWarningSent bool `json:"expiry_warning_sent"`
+	// EgressAllowlist are the destinations in private networks the egress proxy of the central allows connections to.
+	EgressAllowlist api.JSON `json:"egress_allowlist"` // Schema is defined by dbapi.CentralEgressAllowlist
+	// HealthConditions describe the health of the components of the central as last reported by its data plane cluster.
+	HealthConditions api.JSON `json:"health_conditions"` // Schema is defined by []dbapi.CentralHealthCondition
 
 	// All we need to integrate Central with an IdP.
 	AuthConfig
 }
 
+// CentralDBSnapshot is a snapshot of the managed database of a central.
+type CentralDBSnapshot struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// CentralEgressAllowlist contains the domains and CIDRs in private networks the egress proxy of a central allows
+// connections to. Connections to public destinations are always allowed.
+type CentralEgressAllowlist struct {
+	Domains []string `json:"domains,omitempty"`
+	CIDRs   []string `json:"cidrs,omitempty"`
+}
+
+// CentralHealthCondition is a condition of the health of a component of a central. The LastTransitionTime is the time
+// at which fleet-manager first received the current status of the condition.
+type CentralHealthCondition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"last_transition_time"`
+}
+
 // CentralList ...
 type CentralList []*CentralRequest
 
@@ -137,9 +208,97 @@ func (k *CentralRequest) GetDataHost() string {
 	return fmt.Sprintf("acs-data-%s.%s", k.ID, k.Host)
 }
 
+// IsMigrating returns true if the central is deployed on more than one data plane cluster because of a migration.
+func (k *CentralRequest) IsMigrating() bool {
+	for _, s := range constants.GetActiveMigrationStatuses() {
+		if k.MigrationStatus == s {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrationTeardownClusterID returns the data plane cluster from which a migrating central is being removed, if any.
+func (k *CentralRequest) MigrationTeardownClusterID() string {
+	switch constants.CentralMigrationStatus(k.MigrationStatus) {
+	case constants.CentralMigrationStatusDeprovisioningSource:
+		return k.MigrationSourceClusterID
+	case constants.CentralMigrationStatusDeprovisioningTarget:
+		return k.MigrationTargetClusterID
+	default:
+		return ""
+	}
+}
+
+// IsSuspended returns true if the central is scaled down to zero or is being scaled down by its data plane cluster.
+func (k *CentralRequest) IsSuspended() bool {
+	return k.Status == constants.CentralRequestStatusSuspending.String() || k.Status == constants.CentralRequestStatusSuspended.String()
+}
+
+// HasActiveDBBackup returns true if an on-demand snapshot of the managed database was requested and has not completed or
+// failed yet.
+func (k *CentralRequest) HasActiveDBBackup() bool {
+	return k.DBBackupID != "" && constants.CentralDBOperationStatus(k.DBBackupStatus).IsActive()
+}
+
+// HasActiveDBRestore returns true if a restore of the managed database was requested and has not completed or failed
+// yet.
+func (k *CentralRequest) HasActiveDBRestore() bool {
+	return k.DBRestoreID != "" && constants.CentralDBOperationStatus(k.DBRestoreStatus).IsActive()
+}
+
+// GetDBSnapshots retrieves the snapshots of the managed database last reported by the data plane cluster.
+func (k *CentralRequest) GetDBSnapshots() ([]CentralDBSnapshot, error) {
+	var snapshots []CentralDBSnapshot
+	if len(k.DBSnapshots) == 0 {
+		return snapshots, nil
+	}
+	if err := json.Unmarshal(k.DBSnapshots, &snapshots); err != nil {
+		return nil, fmt.Errorf("unmarshalling DB snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// GetEgressAllowlist retrieves the egress allowlist of the central.
+func (k *CentralRequest) GetEgressAllowlist() (*CentralEgressAllowlist, error) {
+	allowlist := &CentralEgressAllowlist{}
+	if len(k.EgressAllowlist) == 0 {
+		return allowlist, nil
+	}
+	if err := json.Unmarshal(k.EgressAllowlist, allowlist); err != nil {
+		return nil, fmt.Errorf("unmarshalling egress allowlist: %w", err)
+	}
+	return allowlist, nil
+}
+
+// SetEgressAllowlist updates the egress allowlist within the CentralRequest.
+func (k *CentralRequest) SetEgressAllowlist(allowlist *CentralEgressAllowlist) error {
+	allowlistBytes, err := json.Marshal(allowlist)
+	if err != nil {
+		return fmt.Errorf("marshalling egress allowlist into JSON: %w", err)
+	}
+	if err := k.EgressAllowlist.UnmarshalJSON(allowlistBytes); err != nil {
+		return fmt.Errorf("updating egress allowlist within CentralRequest: %w", err)
+	}
+	return nil
+}
+
+// GetHealthConditions retrieves the health conditions of the central.
+func (k *CentralRequest) GetHealthConditions() ([]CentralHealthCondition, error) {
+	var conditions []CentralHealthCondition
+	if len(k.HealthConditions) == 0 {
+		return conditions, nil
+	}
+	if err := json.Unmarshal(k.HealthConditions, &conditions); err != nil {
+		return nil, fmt.Errorf("unmarshalling health conditions: %w", err)
+	}
+	return conditions, nil
+}
+
 // GetCentralSpec retrieves the CentralSpec from the CentralRequest in unmarshalled form.
 func (k *CentralRequest) GetCentralSpec() (*CentralSpec, error) {
-	var centralSpec = DefaultCentralSpec
+	// The defaults are copied, since unmarshalling adds to their resource lists otherwise.
+	var centralSpec = DefaultCentralSpec.DeepCopy()
 	if len(k.Central) > 0 {
 		err := json.Unmarshal(k.Central, &centralSpec)
 		if err != nil {
@@ -151,7 +310,7 @@ func (k *CentralRequest) GetCentralSpec() (*CentralSpec, error) {
 
 // GetScannerSpec retrieves the ScannerSpec from the CentralRequest in unmarshalled form.
 func (k *CentralRequest) GetScannerSpec() (*ScannerSpec, error) {
-	var scannerSpec = DefaultScannerSpec
+	var scannerSpec = DefaultScannerSpec.DeepCopy()
 	if len(k.Scanner) > 0 {
 		err := json.Unmarshal(k.Scanner, &scannerSpec)
 		if err != nil {
@@ -9,12 +9,25 @@ import (
 // CentralSpec ...
 type CentralSpec struct {
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	DB        CentralDBSpec               `json:"db,omitempty"`
+}
+
+// CentralDBSpec is the sizing of the managed database of a Central.
+type CentralDBSpec struct {
+	// MinCapacity is the minimum capacity in provider specific units, e.g. Aurora Capacity Units on AWS.
+	MinCapacity float64 `json:"minCapacity,omitempty"`
+	// MaxCapacity is the maximum capacity in provider specific units, e.g. Aurora Capacity Units on AWS.
+	MaxCapacity float64 `json:"maxCapacity,omitempty"`
 }
 
 var (
 	// DefaultCentralSpec ...
 	DefaultCentralSpec = CentralSpec{
 		Resources: defaults.CentralResources,
+		DB: CentralDBSpec{
+			MinCapacity: defaults.CentralDB.MinCapacity,
+			MaxCapacity: defaults.CentralDB.MaxCapacity,
+		},
 	}
 	// DefaultScannerSpec ...
 	DefaultScannerSpec = ScannerSpec{
@@ -35,6 +48,12 @@ var (
 	}
 )
 
+// DeepCopy returns a copy of the CentralSpec which does not share the resource lists.
+func (s CentralSpec) DeepCopy() CentralSpec {
+	s.Resources = *s.Resources.DeepCopy()
+	return s
+}
+
 // ScannerAnalyzerScaling ...
 type ScannerAnalyzerScaling struct {
 	AutoScaling string `json:"autoScaling,omitempty"`
@@ -59,3 +78,10 @@ type ScannerSpec struct {
 	Analyzer ScannerAnalyzerSpec `json:"analyzer,omitempty"`
 	Db       ScannerDbSpec       `json:"db,omitempty"`
 }
+
+// DeepCopy returns a copy of the ScannerSpec which does not share the resource lists.
+func (s ScannerSpec) DeepCopy() ScannerSpec {
+	s.Analyzer.Resources = *s.Analyzer.Resources.DeepCopy()
+	s.Db.Resources = *s.Db.Resources.DeepCopy()
+	return s
+}
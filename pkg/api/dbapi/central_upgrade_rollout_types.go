@@ -0,0 +1,65 @@
+package dbapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CentralUpgradeRollout is a rollout of new desired versions across all centrals of the fleet. The centrals of
+// internal organisations are upgraded first as a canary wave, followed by waves upgrading growing percentages of the
+// fleet.
+type CentralUpgradeRollout struct {
+	api.Meta
+	CentralVersion         string `json:"central_version"`
+	CentralOperatorVersion string `json:"central_operator_version"`
+	Status                 string `json:"status" gorm:"index"`
+	// Waves are the cumulative percentages of the fleet upgraded after each wave following the canary wave.
+	Waves api.JSON `json:"waves"`
+	// CurrentWave is the wave being rolled out. Wave 0 is the canary wave, wave i > 0 upgrades Waves[i-1] percent.
+	CurrentWave int `json:"current_wave"`
+	// WaveStartedAt stores the timestamp when the current wave was started.
+	WaveStartedAt *time.Time `json:"wave_started_at"`
+	// FailureThreshold is the ratio of failed upgrades of the rollout above which the rollout is paused.
+	FailureThreshold *float64 `json:"failure_threshold"`
+	// PausedReason is the reason the rollout was paused last.
+	PausedReason string `json:"paused_reason"`
+	// CompletedAt stores the timestamp when the rollout completed or was aborted.
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// CentralUpgradeRolloutList ...
+type CentralUpgradeRolloutList []*CentralUpgradeRollout
+
+// BeforeCreate ...
+func (r *CentralUpgradeRollout) BeforeCreate(scope *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = api.NewID()
+	}
+	return nil
+}
+
+// GetWaves retrieves the percentages of the waves of the rollout.
+func (r *CentralUpgradeRollout) GetWaves() ([]int, error) {
+	var waves []int
+	if len(r.Waves) == 0 {
+		return waves, nil
+	}
+	if err := json.Unmarshal(r.Waves, &waves); err != nil {
+		return nil, fmt.Errorf("unmarshalling waves: %w", err)
+	}
+	return waves, nil
+}
+
+// SetWaves updates the percentages of the waves of the rollout.
+func (r *CentralUpgradeRollout) SetWaves(waves []int) error {
+	wavesJSON, err := json.Marshal(waves)
+	if err != nil {
+		return fmt.Errorf("marshalling waves: %w", err)
+	}
+	r.Waves = wavesJSON
+	return nil
+}
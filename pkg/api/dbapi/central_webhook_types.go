@@ -0,0 +1,61 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CentralWebhook is an endpoint of an organisation the lifecycle events of the centrals of the organisation are
+// delivered to.
+type CentralWebhook struct {
+	api.Meta
+	OrganisationID string `json:"organisation_id" gorm:"index"`
+	Owner          string `json:"owner"`
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	// Secret is used to sign the deliveries to the endpoint. It is never presented.
+	Secret string `json:"-"`
+}
+
+// CentralWebhookList ...
+type CentralWebhookList []*CentralWebhook
+
+// BeforeCreate ...
+func (w *CentralWebhook) BeforeCreate(scope *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = api.NewID()
+	}
+	return nil
+}
+
+// CentralWebhookDelivery is an entry of the outbox of central events to be delivered to a webhook. Deliveries are
+// retried with exponential backoff until the endpoint accepts the event or the maximum number of attempts is reached.
+type CentralWebhookDelivery struct {
+	api.Meta
+	WebhookID string `json:"webhook_id" gorm:"index"`
+	CentralID string `json:"central_id"`
+	// EventID is the ID of the central event the delivery was created for.
+	EventID string `json:"event_id"`
+	// EventType is the type of the CloudEvent delivered.
+	EventType string `json:"event_type"`
+	// Payload is the CloudEvent in structured JSON mode delivered as is on every attempt.
+	Payload       api.JSON   `json:"payload"`
+	Status        string     `json:"status" gorm:"index"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at" gorm:"index"`
+	LastError     string     `json:"last_error"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+}
+
+// CentralWebhookDeliveryList ...
+type CentralWebhookDeliveryList []*CentralWebhookDelivery
+
+// BeforeCreate ...
+func (d *CentralWebhookDelivery) BeforeCreate(scope *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = api.NewID()
+	}
+	return nil
+}
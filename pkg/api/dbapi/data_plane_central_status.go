@@ -2,6 +2,8 @@ package dbapi
 
 import (
 	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 )
 
 // DataPlaneCentralStatus ...
@@ -12,6 +14,23 @@ type DataPlaneCentralStatus struct {
 	Routes                 []DataPlaneCentralRoute
 	CentralVersion         string
 	CentralOperatorVersion string
+	// DB is the status of the backup and restore operations of the managed database. It is nil if no operation was
+	// reported.
+	DB *DataPlaneCentralDBStatus
+}
+
+// DataPlaneCentralDBStatus ...
+type DataPlaneCentralDBStatus struct {
+	Backup    DataPlaneCentralDBOperation
+	Restore   DataPlaneCentralDBOperation
+	Snapshots []CentralDBSnapshot
+}
+
+// DataPlaneCentralDBOperation ...
+type DataPlaneCentralDBOperation struct {
+	ID      string
+	Status  string
+	Message string
 }
 
 // DataPlaneCentralStatusCondition ...
@@ -37,3 +56,15 @@ func (d *DataPlaneCentralStatus) GetReadyCondition() (DataPlaneCentralStatusCond
 	}
 	return DataPlaneCentralStatusCondition{}, false
 }
+
+// GetHealthConditions returns the conditions describing the health of the components of the Central. It returns nil if
+// the data plane cluster did not report the health of the Central.
+func (d *DataPlaneCentralStatus) GetHealthConditions() []DataPlaneCentralStatusCondition {
+	var conditions []DataPlaneCentralStatusCondition
+	for _, c := range d.Conditions {
+		if constants.IsCentralHealthConditionType(c.Type) {
+			conditions = append(conditions, c)
+		}
+	}
+	return conditions
+}
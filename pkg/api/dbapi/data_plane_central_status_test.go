@@ -44,3 +44,41 @@ func TestDataPlaneCentralstatus_GetReadyCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestDataPlaneCentralstatus_GetHealthConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		wantCondTypes []string
+		statusConds   []DataPlaneCentralStatusCondition
+	}{
+		{
+			name: "When no health condition exists nil is returned",
+			statusConds: []DataPlaneCentralStatusCondition{
+				{Type: "Ready"},
+			},
+		},
+		{
+			name:          "When health conditions exist only they are returned",
+			wantCondTypes: []string{"CentralReady", "PodsHealthy", "Drifted"},
+			statusConds: []DataPlaneCentralStatusCondition{
+				{Type: "Ready"},
+				{Type: "CentralReady"},
+				{Type: "PodsHealthy"},
+				{Type: "Drifted"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := DataPlaneCentralStatus{Conditions: tt.statusConds}
+			var types []string
+			for _, c := range input.GetHealthConditions() {
+				types = append(types, c.Type)
+			}
+			if !reflect.DeepEqual(types, tt.wantCondTypes) {
+				t.Errorf("want: %v got: %v", tt.wantCondTypes, types)
+			}
+		})
+	}
+}
@@ -61,4 +61,6 @@ type PagingMeta struct {
 	Page  int
 	Size  int
 	Total int
+	// NextPageToken is the token with which the page following this one can be requested. It is empty on the last page.
+	NextPageToken string
 }
@@ -17,4 +17,5 @@ type DataPlaneCentralStatus struct {
 	Versions   DataPlaneCentralStatusVersions                  `json:"versions,omitempty"`
 	// Routes created for a Central
 	Routes []DataPlaneCentralStatusRoutes `json:"routes,omitempty"`
+	Db     DataPlaneCentralStatusDb       `json:"db,omitempty"`
 }
@@ -14,4 +14,6 @@ package private
 type ManagedCentralAllOfMetadataAnnotations struct {
 	MasId          string `json:"mas/id"`
 	MasPlacementId string `json:"mas/placementId"`
+	// Set on the Central presented to the migration source or target cluster while the Central is migrated. The managed database is kept when such a Central is deleted.
+	MasMigration string `json:"mas/migration,omitempty"`
 }
@@ -12,5 +12,6 @@ package private
 
 // ManagedCentralAllOfSpecCentral struct for ManagedCentralAllOfSpecCentral
 type ManagedCentralAllOfSpecCentral struct {
-	Resources ResourceRequirements `json:"resources,omitempty"`
+	Resources ResourceRequirements             `json:"resources,omitempty"`
+	Db        ManagedCentralAllOfSpecCentralDb `json:"db,omitempty"`
 }
@@ -19,7 +19,7 @@ type CentralRequest struct {
 	Id   string `json:"id,omitempty"`
 	Kind string `json:"kind,omitempty"`
 	Href string `json:"href,omitempty"`
-	// Values: [accepted, preparing, provisioning, ready, failed, deprovision, deleting]
+	// Values: [accepted, preparing, provisioning, ready, suspending, suspended, resuming, failed, deprovision, deleting]
 	Status string `json:"status,omitempty"`
 	// Name of Cloud used to deploy. For example AWS
 	CloudProvider string `json:"cloud_provider,omitempty"`
@@ -37,4 +37,12 @@ type CentralRequest struct {
 	FailedReason   string    `json:"failed_reason,omitempty"`
 	Version        string    `json:"version,omitempty"`
 	InstanceType   string    `json:"instance_type,omitempty"`
+	// The name of the size plan of the Central
+	Plan string `json:"plan,omitempty"`
+	// Time after which the Central is deleted. Only set for Centrals which expire, e.g. eval Centrals.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Number of times the lifespan of the Central has been extended by its users
+	LifespanExtensions int32 `json:"lifespan_extensions,omitempty"`
+	// Detailed health of the components of the Central, as reported by its data plane cluster
+	HealthConditions []CentralHealthCondition `json:"health_conditions,omitempty"`
 }
@@ -17,4 +17,6 @@ type CentralRequestList struct {
 	Size  int32            `json:"size"`
 	Total int32            `json:"total"`
 	Items []CentralRequest `json:"items"`
+	// Token with which the next page can be requested in the `page_token` parameter. Empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
@@ -24,4 +24,6 @@ type CentralRequestPayload struct {
 	Region  string      `json:"region,omitempty"`
 	Central CentralSpec `json:"central,omitempty"`
 	Scanner ScannerSpec `json:"scanner,omitempty"`
+	// The name of the size plan of the Central. The default plan is used if not set.
+	Plan string `json:"plan,omitempty"`
 }
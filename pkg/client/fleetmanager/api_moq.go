@@ -22,10 +22,10 @@ var _ PublicAPI = &PublicAPIMock{}
 //
 //		// make and configure a mocked PublicAPI
 //		mockedPublicAPI := &PublicAPIMock{
-//			CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+//			CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error) {
 //				panic("mock out the CreateCentral method")
 //			},
-//			DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+//			DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 //				panic("mock out the DeleteCentralById method")
 //			},
 //			GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {
@@ -42,10 +42,10 @@ var _ PublicAPI = &PublicAPIMock{}
 //	}
 type PublicAPIMock struct {
 	// CreateCentralFunc mocks the CreateCentral method.
-	CreateCentralFunc func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error)
+	CreateCentralFunc func(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error)
 
 	// DeleteCentralByIdFunc mocks the DeleteCentralById method.
-	DeleteCentralByIdFunc func(ctx context.Context, id string, async bool) (*http.Response, error)
+	DeleteCentralByIdFunc func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error)
 
 	// GetCentralByIdFunc mocks the GetCentralById method.
 	GetCentralByIdFunc func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error)
@@ -63,6 +63,8 @@ type PublicAPIMock struct {
 			Async bool
 			// Request is the request argument value.
 			Request public.CentralRequestPayload
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *public.CreateCentralOpts
 		}
 		// DeleteCentralById holds details about calls to the DeleteCentralById method.
 		DeleteCentralById []struct {
@@ -72,6 +74,8 @@ type PublicAPIMock struct {
 			ID string
 			// Async is the async argument value.
 			Async bool
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *public.DeleteCentralByIdOpts
 		}
 		// GetCentralById holds details about calls to the GetCentralById method.
 		GetCentralById []struct {
@@ -95,23 +99,25 @@ type PublicAPIMock struct {
 }
 
 // CreateCentral calls CreateCentralFunc.
-func (mock *PublicAPIMock) CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+func (mock *PublicAPIMock) CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error) {
 	if mock.CreateCentralFunc == nil {
 		panic("PublicAPIMock.CreateCentralFunc: method is nil but PublicAPI.CreateCentral was just called")
 	}
 	callInfo := struct {
-		Ctx     context.Context
-		Async   bool
-		Request public.CentralRequestPayload
+		Ctx               context.Context
+		Async             bool
+		Request           public.CentralRequestPayload
+		LocalVarOptionals *public.CreateCentralOpts
 	}{
-		Ctx:     ctx,
-		Async:   async,
-		Request: request,
+		Ctx:               ctx,
+		Async:             async,
+		Request:           request,
+		LocalVarOptionals: localVarOptionals,
 	}
 	mock.lockCreateCentral.Lock()
 	mock.calls.CreateCentral = append(mock.calls.CreateCentral, callInfo)
 	mock.lockCreateCentral.Unlock()
-	return mock.CreateCentralFunc(ctx, async, request)
+	return mock.CreateCentralFunc(ctx, async, request, localVarOptionals)
 }
 
 // CreateCentralCalls gets all the calls that were made to CreateCentral.
@@ -119,14 +125,16 @@ func (mock *PublicAPIMock) CreateCentral(ctx context.Context, async bool, reques
 //
 //	len(mockedPublicAPI.CreateCentralCalls())
 func (mock *PublicAPIMock) CreateCentralCalls() []struct {
-	Ctx     context.Context
-	Async   bool
-	Request public.CentralRequestPayload
+	Ctx               context.Context
+	Async             bool
+	Request           public.CentralRequestPayload
+	LocalVarOptionals *public.CreateCentralOpts
 } {
 	var calls []struct {
-		Ctx     context.Context
-		Async   bool
-		Request public.CentralRequestPayload
+		Ctx               context.Context
+		Async             bool
+		Request           public.CentralRequestPayload
+		LocalVarOptionals *public.CreateCentralOpts
 	}
 	mock.lockCreateCentral.RLock()
 	calls = mock.calls.CreateCentral
@@ -135,23 +143,25 @@ func (mock *PublicAPIMock) CreateCentralCalls() []struct {
 }
 
 // DeleteCentralById calls DeleteCentralByIdFunc.
-func (mock *PublicAPIMock) DeleteCentralById(ctx context.Context, id string, async bool) (*http.Response, error) {
+func (mock *PublicAPIMock) DeleteCentralById(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 	if mock.DeleteCentralByIdFunc == nil {
 		panic("PublicAPIMock.DeleteCentralByIdFunc: method is nil but PublicAPI.DeleteCentralById was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		ID    string
-		Async bool
+		Ctx               context.Context
+		ID                string
+		Async             bool
+		LocalVarOptionals *public.DeleteCentralByIdOpts
 	}{
-		Ctx:   ctx,
-		ID:    id,
-		Async: async,
+		Ctx:               ctx,
+		ID:                id,
+		Async:             async,
+		LocalVarOptionals: localVarOptionals,
 	}
 	mock.lockDeleteCentralById.Lock()
 	mock.calls.DeleteCentralById = append(mock.calls.DeleteCentralById, callInfo)
 	mock.lockDeleteCentralById.Unlock()
-	return mock.DeleteCentralByIdFunc(ctx, id, async)
+	return mock.DeleteCentralByIdFunc(ctx, id, async, localVarOptionals)
 }
 
 // DeleteCentralByIdCalls gets all the calls that were made to DeleteCentralById.
@@ -159,14 +169,16 @@ func (mock *PublicAPIMock) DeleteCentralById(ctx context.Context, id string, asy
 //
 //	len(mockedPublicAPI.DeleteCentralByIdCalls())
 func (mock *PublicAPIMock) DeleteCentralByIdCalls() []struct {
-	Ctx   context.Context
-	ID    string
-	Async bool
+	Ctx               context.Context
+	ID                string
+	Async             bool
+	LocalVarOptionals *public.DeleteCentralByIdOpts
 } {
 	var calls []struct {
-		Ctx   context.Context
-		ID    string
-		Async bool
+		Ctx               context.Context
+		ID                string
+		Async             bool
+		LocalVarOptionals *public.DeleteCentralByIdOpts
 	}
 	mock.lockDeleteCentralById.RLock()
 	calls = mock.calls.DeleteCentralById
@@ -256,6 +268,9 @@ var _ PrivateAPI = &PrivateAPIMock{}
 //
 //		// make and configure a mocked PrivateAPI
 //		mockedPrivateAPI := &PrivateAPIMock{
+//			CheckCentralExistsFunc: func(ctx context.Context, id string, centralID string) (*http.Response, error) {
+//				panic("mock out the CheckCentralExists method")
+//			},
 //			GetCentralsFunc: func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error) {
 //				panic("mock out the GetCentrals method")
 //			},
@@ -265,6 +280,9 @@ var _ PrivateAPI = &PrivateAPIMock{}
 //			UpdateCentralClusterStatusFunc: func(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error) {
 //				panic("mock out the UpdateCentralClusterStatus method")
 //			},
+//			WatchCentralsFunc: func(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchList, *http.Response, error) {
+//				panic("mock out the WatchCentrals method")
+//			},
 //		}
 //
 //		// use mockedPrivateAPI in code that requires PrivateAPI
@@ -272,6 +290,9 @@ var _ PrivateAPI = &PrivateAPIMock{}
 //
 //	}
 type PrivateAPIMock struct {
+	// CheckCentralExistsFunc mocks the CheckCentralExists method.
+	CheckCentralExistsFunc func(ctx context.Context, id string, centralID string) (*http.Response, error)
+
 	// GetCentralsFunc mocks the GetCentrals method.
 	GetCentralsFunc func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error)
 
@@ -281,8 +302,20 @@ type PrivateAPIMock struct {
 	// UpdateCentralClusterStatusFunc mocks the UpdateCentralClusterStatus method.
 	UpdateCentralClusterStatusFunc func(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error)
 
+	// WatchCentralsFunc mocks the WatchCentrals method.
+	WatchCentralsFunc func(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchList, *http.Response, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// CheckCentralExists holds details about calls to the CheckCentralExists method.
+		CheckCentralExists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
 		// GetCentrals holds details about calls to the GetCentrals method.
 		GetCentrals []struct {
 			// Ctx is the ctx argument value.
@@ -306,10 +339,61 @@ type PrivateAPIMock struct {
 			// RequestBody is the requestBody argument value.
 			RequestBody map[string]private.DataPlaneCentralStatus
 		}
+		// WatchCentrals holds details about calls to the WatchCentrals method.
+		WatchCentrals []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *private.WatchCentralsOpts
+		}
 	}
+	lockCheckCentralExists             sync.RWMutex
 	lockGetCentrals                    sync.RWMutex
 	lockGetDataPlaneClusterAgentConfig sync.RWMutex
 	lockUpdateCentralClusterStatus     sync.RWMutex
+	lockWatchCentrals                  sync.RWMutex
+}
+
+// CheckCentralExists calls CheckCentralExistsFunc.
+func (mock *PrivateAPIMock) CheckCentralExists(ctx context.Context, id string, centralID string) (*http.Response, error) {
+	if mock.CheckCentralExistsFunc == nil {
+		panic("PrivateAPIMock.CheckCentralExistsFunc: method is nil but PrivateAPI.CheckCentralExists was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ID        string
+		CentralID string
+	}{
+		Ctx:       ctx,
+		ID:        id,
+		CentralID: centralID,
+	}
+	mock.lockCheckCentralExists.Lock()
+	mock.calls.CheckCentralExists = append(mock.calls.CheckCentralExists, callInfo)
+	mock.lockCheckCentralExists.Unlock()
+	return mock.CheckCentralExistsFunc(ctx, id, centralID)
+}
+
+// CheckCentralExistsCalls gets all the calls that were made to CheckCentralExists.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.CheckCentralExistsCalls())
+func (mock *PrivateAPIMock) CheckCentralExistsCalls() []struct {
+	Ctx       context.Context
+	ID        string
+	CentralID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ID        string
+		CentralID string
+	}
+	mock.lockCheckCentralExists.RLock()
+	calls = mock.calls.CheckCentralExists
+	mock.lockCheckCentralExists.RUnlock()
+	return calls
 }
 
 // GetCentrals calls GetCentralsFunc.
@@ -424,6 +508,46 @@ func (mock *PrivateAPIMock) UpdateCentralClusterStatusCalls() []struct {
 	return calls
 }
 
+// WatchCentrals calls WatchCentralsFunc.
+func (mock *PrivateAPIMock) WatchCentrals(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchList, *http.Response, error) {
+	if mock.WatchCentralsFunc == nil {
+		panic("PrivateAPIMock.WatchCentralsFunc: method is nil but PrivateAPI.WatchCentrals was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *private.WatchCentralsOpts
+	}{
+		Ctx:               ctx,
+		ID:                id,
+		LocalVarOptionals: localVarOptionals,
+	}
+	mock.lockWatchCentrals.Lock()
+	mock.calls.WatchCentrals = append(mock.calls.WatchCentrals, callInfo)
+	mock.lockWatchCentrals.Unlock()
+	return mock.WatchCentralsFunc(ctx, id, localVarOptionals)
+}
+
+// WatchCentralsCalls gets all the calls that were made to WatchCentrals.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.WatchCentralsCalls())
+func (mock *PrivateAPIMock) WatchCentralsCalls() []struct {
+	Ctx               context.Context
+	ID                string
+	LocalVarOptionals *private.WatchCentralsOpts
+} {
+	var calls []struct {
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *private.WatchCentralsOpts
+	}
+	mock.lockWatchCentrals.RLock()
+	calls = mock.calls.WatchCentrals
+	mock.lockWatchCentrals.RUnlock()
+	return calls
+}
+
 // Ensure, that AdminAPIMock does implement AdminAPI.
 // If this is not the case, regenerate this file with moq.
 var _ AdminAPI = &AdminAPIMock{}
@@ -434,16 +558,16 @@ var _ AdminAPI = &AdminAPIMock{}
 //
 //		// make and configure a mocked AdminAPI
 //		mockedAdminAPI := &AdminAPIMock{
-//			CreateCentralFunc: func(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error) {
+//			CreateCentralFunc: func(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload, localVarOptionals *admin.CreateCentralOpts) (admin.CentralRequest, *http.Response, error) {
 //				panic("mock out the CreateCentral method")
 //			},
-//			DeleteDbCentralByIdFunc: func(ctx context.Context, id string) (*http.Response, error) {
+//			DeleteDbCentralByIdFunc: func(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error) {
 //				panic("mock out the DeleteDbCentralById method")
 //			},
 //			GetCentralsFunc: func(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error) {
 //				panic("mock out the GetCentrals method")
 //			},
-//			UpdateCentralByIdFunc: func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error) {
+//			UpdateCentralByIdFunc: func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error) {
 //				panic("mock out the UpdateCentralById method")
 //			},
 //		}
@@ -454,16 +578,16 @@ var _ AdminAPI = &AdminAPIMock{}
 //	}
 type AdminAPIMock struct {
 	// CreateCentralFunc mocks the CreateCentral method.
-	CreateCentralFunc func(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error)
+	CreateCentralFunc func(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload, localVarOptionals *admin.CreateCentralOpts) (admin.CentralRequest, *http.Response, error)
 
 	// DeleteDbCentralByIdFunc mocks the DeleteDbCentralById method.
-	DeleteDbCentralByIdFunc func(ctx context.Context, id string) (*http.Response, error)
+	DeleteDbCentralByIdFunc func(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error)
 
 	// GetCentralsFunc mocks the GetCentrals method.
 	GetCentralsFunc func(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error)
 
 	// UpdateCentralByIdFunc mocks the UpdateCentralById method.
-	UpdateCentralByIdFunc func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error)
+	UpdateCentralByIdFunc func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -475,6 +599,8 @@ type AdminAPIMock struct {
 			Async bool
 			// CentralRequestPayload is the centralRequestPayload argument value.
 			CentralRequestPayload admin.CentralRequestPayload
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *admin.CreateCentralOpts
 		}
 		// DeleteDbCentralById holds details about calls to the DeleteDbCentralById method.
 		DeleteDbCentralById []struct {
@@ -482,6 +608,8 @@ type AdminAPIMock struct {
 			Ctx context.Context
 			// ID is the id argument value.
 			ID string
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 		}
 		// GetCentrals holds details about calls to the GetCentrals method.
 		GetCentrals []struct {
@@ -498,6 +626,8 @@ type AdminAPIMock struct {
 			ID string
 			// CentralUpdateRequest is the centralUpdateRequest argument value.
 			CentralUpdateRequest admin.CentralUpdateRequest
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *admin.UpdateCentralByIdOpts
 		}
 	}
 	lockCreateCentral       sync.RWMutex
@@ -507,7 +637,7 @@ type AdminAPIMock struct {
 }
 
 // CreateCentral calls CreateCentralFunc.
-func (mock *AdminAPIMock) CreateCentral(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error) {
+func (mock *AdminAPIMock) CreateCentral(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload, localVarOptionals *admin.CreateCentralOpts) (admin.CentralRequest, *http.Response, error) {
 	if mock.CreateCentralFunc == nil {
 		panic("AdminAPIMock.CreateCentralFunc: method is nil but AdminAPI.CreateCentral was just called")
 	}
@@ -515,15 +645,17 @@ func (mock *AdminAPIMock) CreateCentral(ctx context.Context, async bool, central
 		Ctx                   context.Context
 		Async                 bool
 		CentralRequestPayload admin.CentralRequestPayload
+		LocalVarOptionals     *admin.CreateCentralOpts
 	}{
 		Ctx:                   ctx,
 		Async:                 async,
 		CentralRequestPayload: centralRequestPayload,
+		LocalVarOptionals:     localVarOptionals,
 	}
 	mock.lockCreateCentral.Lock()
 	mock.calls.CreateCentral = append(mock.calls.CreateCentral, callInfo)
 	mock.lockCreateCentral.Unlock()
-	return mock.CreateCentralFunc(ctx, async, centralRequestPayload)
+	return mock.CreateCentralFunc(ctx, async, centralRequestPayload, localVarOptionals)
 }
 
 // CreateCentralCalls gets all the calls that were made to CreateCentral.
@@ -534,11 +666,13 @@ func (mock *AdminAPIMock) CreateCentralCalls() []struct {
 	Ctx                   context.Context
 	Async                 bool
 	CentralRequestPayload admin.CentralRequestPayload
+	LocalVarOptionals     *admin.CreateCentralOpts
 } {
 	var calls []struct {
 		Ctx                   context.Context
 		Async                 bool
 		CentralRequestPayload admin.CentralRequestPayload
+		LocalVarOptionals     *admin.CreateCentralOpts
 	}
 	mock.lockCreateCentral.RLock()
 	calls = mock.calls.CreateCentral
@@ -547,21 +681,23 @@ func (mock *AdminAPIMock) CreateCentralCalls() []struct {
 }
 
 // DeleteDbCentralById calls DeleteDbCentralByIdFunc.
-func (mock *AdminAPIMock) DeleteDbCentralById(ctx context.Context, id string) (*http.Response, error) {
+func (mock *AdminAPIMock) DeleteDbCentralById(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error) {
 	if mock.DeleteDbCentralByIdFunc == nil {
 		panic("AdminAPIMock.DeleteDbCentralByIdFunc: method is nil but AdminAPI.DeleteDbCentralById was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		ID  string
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:               ctx,
+		ID:                id,
+		LocalVarOptionals: localVarOptionals,
 	}
 	mock.lockDeleteDbCentralById.Lock()
 	mock.calls.DeleteDbCentralById = append(mock.calls.DeleteDbCentralById, callInfo)
 	mock.lockDeleteDbCentralById.Unlock()
-	return mock.DeleteDbCentralByIdFunc(ctx, id)
+	return mock.DeleteDbCentralByIdFunc(ctx, id, localVarOptionals)
 }
 
 // DeleteDbCentralByIdCalls gets all the calls that were made to DeleteDbCentralById.
@@ -569,12 +705,14 @@ func (mock *AdminAPIMock) DeleteDbCentralById(ctx context.Context, id string) (*
 //
 //	len(mockedAdminAPI.DeleteDbCentralByIdCalls())
 func (mock *AdminAPIMock) DeleteDbCentralByIdCalls() []struct {
-	Ctx context.Context
-	ID  string
+	Ctx               context.Context
+	ID                string
+	LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 } {
 	var calls []struct {
-		Ctx context.Context
-		ID  string
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 	}
 	mock.lockDeleteDbCentralById.RLock()
 	calls = mock.calls.DeleteDbCentralById
@@ -619,7 +757,7 @@ func (mock *AdminAPIMock) GetCentralsCalls() []struct {
 }
 
 // UpdateCentralById calls UpdateCentralByIdFunc.
-func (mock *AdminAPIMock) UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error) {
+func (mock *AdminAPIMock) UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error) {
 	if mock.UpdateCentralByIdFunc == nil {
 		panic("AdminAPIMock.UpdateCentralByIdFunc: method is nil but AdminAPI.UpdateCentralById was just called")
 	}
@@ -627,15 +765,17 @@ func (mock *AdminAPIMock) UpdateCentralById(ctx context.Context, id string, cent
 		Ctx                  context.Context
 		ID                   string
 		CentralUpdateRequest admin.CentralUpdateRequest
+		LocalVarOptionals    *admin.UpdateCentralByIdOpts
 	}{
 		Ctx:                  ctx,
 		ID:                   id,
 		CentralUpdateRequest: centralUpdateRequest,
+		LocalVarOptionals:    localVarOptionals,
 	}
 	mock.lockUpdateCentralById.Lock()
 	mock.calls.UpdateCentralById = append(mock.calls.UpdateCentralById, callInfo)
 	mock.lockUpdateCentralById.Unlock()
-	return mock.UpdateCentralByIdFunc(ctx, id, centralUpdateRequest)
+	return mock.UpdateCentralByIdFunc(ctx, id, centralUpdateRequest, localVarOptionals)
 }
 
 // UpdateCentralByIdCalls gets all the calls that were made to UpdateCentralById.
@@ -646,11 +786,13 @@ func (mock *AdminAPIMock) UpdateCentralByIdCalls() []struct {
 	Ctx                  context.Context
 	ID                   string
 	CentralUpdateRequest admin.CentralUpdateRequest
+	LocalVarOptionals    *admin.UpdateCentralByIdOpts
 } {
 	var calls []struct {
 		Ctx                  context.Context
 		ID                   string
 		CentralUpdateRequest admin.CentralUpdateRequest
+		LocalVarOptionals    *admin.UpdateCentralByIdOpts
 	}
 	mock.lockUpdateCentralById.RLock()
 	calls = mock.calls.UpdateCentralById
@@ -15,25 +15,27 @@ import (
 
 // PublicAPI is a wrapper interface for the fleetmanager client public API.
 type PublicAPI interface {
-	CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error)
-	DeleteCentralById(ctx context.Context, id string, async bool) (*http.Response, error)
+	CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error)
+	DeleteCentralById(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error)
 	GetCentralById(ctx context.Context, id string) (public.CentralRequest, *http.Response, error)
 	GetCentrals(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error)
 }
 
 // PrivateAPI is a wrapper interface for the fleetmanager client private API.
 type PrivateAPI interface {
+	CheckCentralExists(ctx context.Context, id string, centralID string) (*http.Response, error)
 	GetDataPlaneClusterAgentConfig(ctx context.Context, id string) (private.DataplaneClusterAgentConfig, *http.Response, error)
 	GetCentrals(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error)
 	UpdateCentralClusterStatus(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error)
+	WatchCentrals(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchList, *http.Response, error)
 }
 
 // AdminAPI is a wrapper interface for the fleetmanager client admin API.
 type AdminAPI interface {
 	GetCentrals(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error)
-	CreateCentral(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error)
-	UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error)
-	DeleteDbCentralById(ctx context.Context, id string) (*http.Response, error)
+	CreateCentral(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload, localVarOptionals *admin.CreateCentralOpts) (admin.CentralRequest, *http.Response, error)
+	UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error)
+	DeleteDbCentralById(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error)
 }
 
 var (
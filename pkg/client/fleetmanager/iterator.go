@@ -0,0 +1,143 @@
+package fleetmanager
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	admin "github.com/stackrox/acs-fleet-manager/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+)
+
+// pageIterator keeps track of the position of an iterator within the pages of a list requested with page tokens.
+type pageIterator struct {
+	index         int
+	count         int
+	nextPageToken string
+	started       bool
+	err           error
+}
+
+// fetchPage requests the page with the given token, or the first page if the token is empty. It returns the number of
+// items on the page and the token of the next page.
+type fetchPage func(ctx context.Context, pageToken string) (int, string, error)
+
+func (p *pageIterator) next(ctx context.Context, fetch fetchPage) bool {
+	p.index++
+	for p.index >= p.count {
+		if p.err != nil || (p.started && p.nextPageToken == "") {
+			return false
+		}
+		count, nextPageToken, err := fetch(ctx, p.nextPageToken)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.started = true
+		p.index = 0
+		p.count = count
+		p.nextPageToken = nextPageToken
+	}
+	return true
+}
+
+// CentralIterator iterates over the centrals returned by the public API. The pages are requested with page tokens, so
+// that no centrals are skipped or returned twice when centrals are created or deleted during the iteration.
+//
+//	it := fleetmanager.NewCentralIterator(client.PublicAPI(), nil)
+//	for it.Next(ctx) {
+//		central := it.Central()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type CentralIterator struct {
+	pageIterator
+	api  PublicAPI
+	opts public.GetCentralsOpts
+	page []public.CentralRequest
+}
+
+// NewCentralIterator returns an iterator over the centrals matching the given options. The page and page token of
+// the options are ignored.
+func NewCentralIterator(api PublicAPI, opts *public.GetCentralsOpts) *CentralIterator {
+	it := &CentralIterator{api: api, pageIterator: pageIterator{index: -1}}
+	if opts != nil {
+		it.opts = *opts
+	}
+	it.opts.Page = optional.EmptyString()
+	return it
+}
+
+// Next advances the iterator to the next central and requests the next page when needed. It returns false when all
+// centrals have been returned or a request failed.
+func (it *CentralIterator) Next(ctx context.Context) bool {
+	return it.next(ctx, func(ctx context.Context, pageToken string) (int, string, error) {
+		it.opts.PageToken = optional.EmptyString()
+		if pageToken != "" {
+			it.opts.PageToken = optional.NewString(pageToken)
+		}
+		list, _, err := it.api.GetCentrals(ctx, &it.opts)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = list.Items
+		return len(list.Items), list.NextPageToken, nil
+	})
+}
+
+// Central returns the central the iterator is positioned at.
+func (it *CentralIterator) Central() public.CentralRequest {
+	return it.page[it.index]
+}
+
+// Err returns the error of the request which ended the iteration, if any.
+func (it *CentralIterator) Err() error {
+	return it.err
+}
+
+// AdminCentralIterator iterates over the centrals returned by the admin API in the same way as CentralIterator.
+type AdminCentralIterator struct {
+	pageIterator
+	api  AdminAPI
+	opts admin.GetCentralsOpts
+	page []admin.Central
+}
+
+// NewAdminCentralIterator returns an iterator over the centrals matching the given options. The page and page token
+// of the options are ignored.
+func NewAdminCentralIterator(api AdminAPI, opts *admin.GetCentralsOpts) *AdminCentralIterator {
+	it := &AdminCentralIterator{api: api, pageIterator: pageIterator{index: -1}}
+	if opts != nil {
+		it.opts = *opts
+	}
+	it.opts.Page = optional.EmptyString()
+	return it
+}
+
+// Next advances the iterator to the next central and requests the next page when needed. It returns false when all
+// centrals have been returned or a request failed.
+func (it *AdminCentralIterator) Next(ctx context.Context) bool {
+	return it.next(ctx, func(ctx context.Context, pageToken string) (int, string, error) {
+		it.opts.PageToken = optional.EmptyString()
+		if pageToken != "" {
+			it.opts.PageToken = optional.NewString(pageToken)
+		}
+		list, _, err := it.api.GetCentrals(ctx, &it.opts)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = list.Items
+		return len(list.Items), list.NextPageToken, nil
+	})
+}
+
+// Central returns the central the iterator is positioned at.
+func (it *AdminCentralIterator) Central() admin.Central {
+	return it.page[it.index]
+}
+
+// Err returns the error of the request which ended the iteration, if any.
+func (it *AdminCentralIterator) Err() error {
+	return it.err
+}
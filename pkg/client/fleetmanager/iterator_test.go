@@ -0,0 +1,84 @@
+package fleetmanager
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/antihax/optional"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralIterator(t *testing.T) {
+	pages := map[string]public.CentralRequestList{
+		"": {
+			Items:         []public.CentralRequest{{Id: "a"}, {Id: "b"}},
+			NextPageToken: "token-1",
+		},
+		"token-1": {
+			NextPageToken: "token-2",
+		},
+		"token-2": {
+			Items: []public.CentralRequest{{Id: "c"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		failAt   string
+		wantIDs  []string
+		wantErr  bool
+		wantCall int
+	}{
+		{
+			name:     "iterates over all pages",
+			wantIDs:  []string{"a", "b", "c"},
+			wantCall: 3,
+		},
+		{
+			name:     "stops when a request fails",
+			failAt:   "token-2",
+			wantIDs:  []string{"a", "b"},
+			wantErr:  true,
+			wantCall: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &PublicAPIMock{
+				GetCentralsFunc: func(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+					assert.False(t, localVarOptionals.Page.IsSet())
+					assert.Equal(t, "name = test", localVarOptionals.Search.Value())
+					token := localVarOptionals.PageToken.Value()
+					if tt.failAt != "" && token == tt.failAt {
+						return public.CentralRequestList{}, nil, errors.New("request failed")
+					}
+					return pages[token], nil, nil
+				},
+			}
+
+			it := NewCentralIterator(api, &public.GetCentralsOpts{
+				Page:   optional.NewString("2"),
+				Search: optional.NewString("name = test"),
+			})
+			var ids []string
+			for it.Next(context.Background()) {
+				ids = append(ids, it.Central().Id)
+			}
+
+			assert.Equal(t, tt.wantIDs, ids)
+			if tt.wantErr {
+				require.Error(t, it.Err())
+			} else {
+				require.NoError(t, it.Err())
+			}
+			assert.Len(t, api.GetCentralsCalls(), tt.wantCall)
+			// the iterator stays exhausted
+			assert.False(t, it.Next(context.Background()))
+			assert.Len(t, api.GetCentralsCalls(), tt.wantCall)
+		})
+	}
+}
@@ -193,6 +193,14 @@ const (
 	ErrorInstancePlanNotSupported       ServiceErrorCode = 42
 	ErrorInstancePlanNotSupportedReason string           = "Instance plan not supported"
 
+	// Idempotency key reused with a different request
+	ErrorIdempotencyKeyReused       ServiceErrorCode = 43
+	ErrorIdempotencyKeyReusedReason string           = "Idempotency key has already been used for a different request"
+
+	// Resource has been modified since the version the request is based on
+	ErrorPreconditionFailed       ServiceErrorCode = 44
+	ErrorPreconditionFailedReason string           = "Resource has been modified"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too Many requests"
@@ -273,6 +281,8 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorMalformedServiceAccountID, ErrorMalformedServiceAccountIDReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorMaxLimitForServiceAccountsReached, ErrorMaxLimitForServiceAccountsReachedReason, http.StatusForbidden, nil},
 		ServiceError{ErrorInstancePlanNotSupported, ErrorInstancePlanNotSupportedReason, http.StatusBadRequest, nil},
+		ServiceError{ErrorIdempotencyKeyReused, ErrorIdempotencyKeyReusedReason, http.StatusUnprocessableEntity, nil},
+		ServiceError{ErrorPreconditionFailed, ErrorPreconditionFailedReason, http.StatusPreconditionFailed, nil},
 		ServiceError{ErrorInvalidCloudAccountID, ErrorInvalidCloudAccountIDReason, http.StatusBadRequest, nil},
 	}
 }
@@ -669,6 +679,16 @@ func InstancePlanNotSupported(reason string, values ...interface{}) *ServiceErro
 	return New(ErrorInstancePlanNotSupported, reason, values...)
 }
 
+// IdempotencyKeyReused ...
+func IdempotencyKeyReused(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorIdempotencyKeyReused, reason, values...)
+}
+
+// PreconditionFailed ...
+func PreconditionFailed(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorPreconditionFailed, reason, values...)
+}
+
 // MalformedServiceAccountName ...
 func MalformedServiceAccountName(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorMalformedServiceAccountName, reason, values...)
@@ -37,6 +37,13 @@ const (
 	CentralRequestsStatusSinceCreated = "central_requests_status_since_created_in_seconds"
 	CentralRequestsStatusCount        = "central_requests_status_count"
 
+	// CentralMigrationStatusCount - name of the metric for the number of centrals in each migration status
+	CentralMigrationStatusCount = "central_migration_status_count"
+	// CentralMigrationTransitionsCount - name of the metric for the number of central migration status transitions
+	CentralMigrationTransitionsCount = "central_migration_transitions_count"
+	// CentralMigrationDuration - name of the metric for the duration of completed central migrations
+	CentralMigrationDuration = "central_migration_duration"
+
 	// ClusterOperationsSuccessCount - name of the metric for cluster-related successful operations
 	ClusterOperationsSuccessCount = "cluster_operations_success_count"
 	// ClusterOperationsTotalCount - name of the metric for all cluster-related operations
@@ -110,6 +117,11 @@ var centralStatusCountMetricLabels = []string{
 	LabelStatus,
 }
 
+// centralMigrationMetricsLabels is the slice of labels to add to central migration metrics
+var centralMigrationMetricsLabels = []string{
+	LabelStatus,
+}
+
 // CentralOperationsCountMetricsLabels - is the slice of labels to add to Central operations count metrics
 var CentralOperationsCountMetricsLabels = []string{
 	labelOperation,
@@ -465,6 +477,61 @@ func IncreaseCentralTotalOperationsCountMetric(operation constants2.CentralOpera
 	centralOperationsTotalCountMetric.With(labels).Inc()
 }
 
+// create a new GaugeVec for central migration status counts
+var centralMigrationStatusCountMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      CentralMigrationStatusCount,
+		Help:      "number of Central instances in each active migration status",
+	},
+	centralMigrationMetricsLabels,
+)
+
+// UpdateCentralMigrationStatusCountMetric ...
+func UpdateCentralMigrationStatusCountMetric(status constants2.CentralMigrationStatus, count int) {
+	labels := prometheus.Labels{
+		LabelStatus: status.String(),
+	}
+	centralMigrationStatusCountMetric.With(labels).Set(float64(count))
+}
+
+// create a new counterVec for central migration status transitions
+var centralMigrationTransitionsCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      CentralMigrationTransitionsCount,
+		Help:      "number of Central migration status transitions by the status entered",
+	},
+	centralMigrationMetricsLabels,
+)
+
+// IncreaseCentralMigrationTransitionsCountMetric - increase counter for the centralMigrationTransitionsCountMetric
+func IncreaseCentralMigrationTransitionsCountMetric(status constants2.CentralMigrationStatus) {
+	labels := prometheus.Labels{
+		LabelStatus: status.String(),
+	}
+	centralMigrationTransitionsCountMetric.With(labels).Inc()
+}
+
+// create a new histogramVec for the duration of finished central migrations
+var centralMigrationDurationMetric = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Subsystem: FleetManager,
+		Name:      CentralMigrationDuration,
+		Help:      "Central migration duration in seconds by the final migration status.",
+		Buckets:   []float64{60.0, 300.0, 600.0, 900.0, 1200.0, 1800.0, 2400.0, 3600.0, 7200.0, 14400.0},
+	},
+	centralMigrationMetricsLabels,
+)
+
+// UpdateCentralMigrationDurationMetric records the duration of a finished central migration
+func UpdateCentralMigrationDurationMetric(status constants2.CentralMigrationStatus, elapsed time.Duration) {
+	labels := prometheus.Labels{
+		LabelStatus: status.String(),
+	}
+	centralMigrationDurationMetric.With(labels).Observe(elapsed.Seconds())
+}
+
 // #### Metrics for Centrals - End ####
 
 // #### Metrics for Reconcilers - Start ####
@@ -708,6 +775,9 @@ func init() {
 	prometheus.MustRegister(centralOperationsTotalCountMetric)
 	prometheus.MustRegister(centralStatusSinceCreatedMetric)
 	prometheus.MustRegister(CentralStatusCountMetric)
+	prometheus.MustRegister(centralMigrationStatusCountMetric)
+	prometheus.MustRegister(centralMigrationTransitionsCountMetric)
+	prometheus.MustRegister(centralMigrationDurationMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -731,6 +801,7 @@ func init() {
 func ResetMetricsForCentralManagers() {
 	centralStatusSinceCreatedMetric.Reset()
 	CentralStatusCountMetric.Reset()
+	centralMigrationStatusCountMetric.Reset()
 }
 
 // ResetMetricsForClusterManagers will reset the metrics for the ClusterManager background reconciler
@@ -775,6 +846,9 @@ func Reset() {
 	centralOperationsTotalCountMetric.Reset()
 	centralStatusSinceCreatedMetric.Reset()
 	CentralStatusCountMetric.Reset()
+	centralMigrationStatusCountMetric.Reset()
+	centralMigrationTransitionsCountMetric.Reset()
+	centralMigrationDurationMetric.Reset()
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var orderBySpaces = regexp.MustCompile(`\s+`)
+
+// OrderByField is a column of the order by clause of a list, together with its direction.
+type OrderByField struct {
+	Column string
+	Desc   bool
+}
+
+// String returns the field as an order by clause.
+func (f OrderByField) String() string {
+	if f.Desc {
+		return f.Column + " desc"
+	}
+	return f.Column + " asc"
+}
+
+// OrderByFields returns the fields of the order by clause of the list arguments. Clauses with a direction other than
+// asc or desc are rejected. The columns are not validated.
+func (la *ListArguments) OrderByFields() ([]OrderByField, error) {
+	fields := make([]OrderByField, 0, len(la.OrderBy))
+	for _, orderByClause := range la.OrderBy {
+		keywords := strings.Split(orderBySpaces.ReplaceAllString(strings.ToLower(strings.TrimSpace(orderByClause)), " "), " ")
+		if len(keywords) > 2 {
+			return nil, errors.Errorf("invalid order by clause '%s'", orderByClause)
+		}
+		field := OrderByField{Column: keywords[0]}
+		if len(keywords) == 2 {
+			switch keywords[1] {
+			case "asc":
+			case "desc":
+				field.Desc = true
+			default:
+				return nil, errors.Errorf("invalid order by direction '%s'", keywords[1])
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// pageToken is the decoded form of the opaque tokens with which the next page of a list is requested.
+type pageToken struct {
+	// Fingerprint identifies the search and order of the list the token has been created for.
+	Fingerprint string `json:"f"`
+	// Values are the values of the order by fields of the last item of the previous page.
+	Values []json.RawMessage `json:"v"`
+}
+
+// EncodePageToken returns the token of the page following the item with the given values of the order by fields. The
+// token can only be used to list items with the same search and order.
+func EncodePageToken(la *ListArguments, orderBy []OrderByField, values []interface{}) (string, error) {
+	if len(values) != len(orderBy) {
+		return "", errors.Errorf("expected %d values for the page token, got %d", len(orderBy), len(values))
+	}
+	token := pageToken{Fingerprint: pageTokenFingerprint(la, orderBy)}
+	for _, value := range values {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", errors.Wrap(err, "encoding page token")
+		}
+		token.Values = append(token.Values, raw)
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", errors.Wrap(err, "encoding page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodePageToken returns the values of the order by fields stored in the page token of the list arguments. The values
+// are decoded into the types of the given samples, one per order by field.
+func DecodePageToken(la *ListArguments, orderBy []OrderByField, samples []interface{}) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(la.PageToken)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, errors.New("invalid page token")
+	}
+	if token.Fingerprint != pageTokenFingerprint(la, orderBy) {
+		return nil, errors.New("page token has been created for a different search or order")
+	}
+	if len(token.Values) != len(samples) {
+		return nil, errors.New("invalid page token")
+	}
+
+	values := make([]interface{}, 0, len(samples))
+	for i, sample := range samples {
+		value := reflect.New(reflect.TypeOf(sample))
+		if err := json.Unmarshal(token.Values[i], value.Interface()); err != nil {
+			return nil, errors.New("invalid page token")
+		}
+		values = append(values, value.Elem().Interface())
+	}
+	return values, nil
+}
+
+// KeysetCondition returns the condition selecting the items which are ordered after the item with the given values of
+// the order by fields. The columns of the fields must have been validated, as they are added to the condition as is.
+func KeysetCondition(orderBy []OrderByField, values []interface{}) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	for i, field := range orderBy {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, orderBy[j].Column+" = ?")
+			args = append(args, values[j])
+		}
+		op := " > ?"
+		if field.Desc {
+			op = " < ?"
+		}
+		terms = append(terms, field.Column+op)
+		args = append(args, values[i])
+		conditions = append(conditions, "("+strings.Join(terms, " AND ")+")")
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+func pageTokenFingerprint(la *ListArguments, orderBy []OrderByField) string {
+	fields := make([]string, 0, len(orderBy))
+	for _, field := range orderBy {
+		fields = append(fields, field.String())
+	}
+	hash := sha256.Sum256([]byte(la.Search + "\n" + strings.Join(fields, ",")))
+	return hex.EncodeToString(hash[:8])
+}
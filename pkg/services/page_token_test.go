@@ -0,0 +1,100 @@
+package services
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_OrderByFields(t *testing.T) {
+	RegisterTestingT(t)
+	la := NewListArguments(url.Values{"orderBy": []string{"region   DESC, name,created_at asc"}})
+	Expect(la.Validate()).To(Succeed())
+	fields, err := la.OrderByFields()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(fields).To(Equal([]OrderByField{
+		{Column: "region", Desc: true},
+		{Column: "name"},
+		{Column: "created_at"},
+	}))
+
+	for _, orderBy := range []string{"name descending", "name; drop table", "name asc desc"} {
+		_, err := (&ListArguments{OrderBy: []string{orderBy}}).OrderByFields()
+		Expect(err).To(HaveOccurred(), orderBy)
+	}
+}
+
+func Test_PageToken(t *testing.T) {
+	orderBy := []OrderByField{{Column: "created_at", Desc: true}, {Column: "multi_az"}, {Column: "id"}}
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC)
+	samples := []interface{}{time.Time{}, false, ""}
+
+	tests := []struct {
+		name       string
+		decodeArgs func(token string) *ListArguments
+		orderBy    []OrderByField
+		wantErr    bool
+	}{
+		{
+			name: "decodes token of same search and order",
+			decodeArgs: func(token string) *ListArguments {
+				return &ListArguments{Search: "name = test", PageToken: token}
+			},
+			orderBy: orderBy,
+		},
+		{
+			name: "rejects token of different search",
+			decodeArgs: func(token string) *ListArguments {
+				return &ListArguments{Search: "name = other", PageToken: token}
+			},
+			orderBy: orderBy,
+			wantErr: true,
+		},
+		{
+			name: "rejects token of different order",
+			decodeArgs: func(token string) *ListArguments {
+				return &ListArguments{Search: "name = test", PageToken: token}
+			},
+			orderBy: []OrderByField{{Column: "created_at"}, {Column: "multi_az"}, {Column: "id"}},
+			wantErr: true,
+		},
+		{
+			name: "rejects malformed token",
+			decodeArgs: func(token string) *ListArguments {
+				return &ListArguments{Search: "name = test", PageToken: token + "!"}
+			},
+			orderBy: orderBy,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			token, err := EncodePageToken(&ListArguments{Search: "name = test"}, orderBy, []interface{}{createdAt, true, "id-1"})
+			Expect(err).ToNot(HaveOccurred())
+
+			values, err := DecodePageToken(tt.decodeArgs(token), tt.orderBy, samples)
+			if tt.wantErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(HaveLen(3))
+			Expect(values[0].(time.Time).Equal(createdAt)).To(BeTrue())
+			Expect(values[1]).To(Equal(true))
+			Expect(values[2]).To(Equal("id-1"))
+		})
+	}
+}
+
+func Test_KeysetCondition(t *testing.T) {
+	RegisterTestingT(t)
+	condition, args := KeysetCondition(
+		[]OrderByField{{Column: "region", Desc: true}, {Column: "name"}, {Column: "id"}},
+		[]interface{}{"us-east-1", "test", "id-1"},
+	)
+	Expect(condition).To(Equal("(region < ?) OR (region = ? AND name > ?) OR (region = ? AND name = ? AND id > ?)"))
+	Expect(args).To(Equal([]interface{}{"us-east-1", "us-east-1", "test", "us-east-1", "test", "id-1"}))
+}
@@ -4,36 +4,63 @@ package services
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
 var validColumns = []string{"region", "name", "cloud_provider", "status", "owner"}
 
+// timeColumns are the columns holding timestamps. Only these columns can be compared with <, <=, > and >=, and the
+// values they are compared with must be timestamps.
+var timeColumns = []string{"created_at", "updated_at"}
+
+// timeFormats are the accepted formats of the values compared with time columns.
+var timeFormats = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
 // BraceTokenFamily ...
 const (
 	BraceTokenFamily       = "BRACE"
 	OpTokenFamily          = "OP"
 	LogicalOpTokenFamily   = "LOGICAL"
+	NegationTokenFamily    = "NEGATION"
 	ColumnTokenFamily      = "COLUMN"
 	ValueTokenFamily       = "VALUE"
 	QuotedValueTokenFamily = "QUOTED"
+	ListBraceTokenFamily   = "LIST_BRACE"
+	SeparatorTokenFamily   = "SEPARATOR"
 
-	OpenBrace   = "OPEN_BRACE"
-	ClosedBrace = "CLOSED_BRACE"
-	Column      = "COLUMN"
-	Value       = "VALUE"
-	QuotedValue = "QUOTED_VALUE"
-	Eq          = "EQ"
-	NotEq       = "NOT_EQ"
-	LikeState   = "LIKE"
-	AndState    = "AND"
-	OrState     = "OR"
+	OpenBrace       = "OPEN_BRACE"
+	ClosedBrace     = "CLOSED_BRACE"
+	Column          = "COLUMN"
+	Value           = "VALUE"
+	QuotedValue     = "QUOTED_VALUE"
+	Eq              = "EQ"
+	NotEq           = "NOT_EQ"
+	Lt              = "LT"
+	LtEq            = "LT_EQ"
+	Gt              = "GT"
+	GtEq            = "GT_EQ"
+	LikeState       = "LIKE"
+	ILikeState      = "ILIKE"
+	InState         = "IN"
+	NotOpState      = "NOT_OP"
+	NotState        = "NOT"
+	OpenList        = "OPEN_LIST"
+	ClosedList      = "CLOSED_LIST"
+	ListValue       = "LIST_VALUE"
+	QuotedListValue = "QUOTED_LIST_VALUE"
+	Comma           = "COMMA"
+	AndState        = "AND"
+	OrState         = "OR"
 )
 
 // MaximumComplexity ...
 const MaximumComplexity = 10
 
+// MaximumListLength is the maximum number of values in the list of an IN comparison.
+const MaximumListLength = 50
+
 type checkUnbalancedBraces func() error
 
 // DBQuery ...
@@ -57,33 +84,65 @@ var _ QueryParser = &queryParser{}
 // initStateMachine
 // This will be our grammar (each Token will eat the spaces after the Token itself):
 // Tokens:
-// OPEN_BRACE       = (
-// CLOSED_BRACE     = )
-// COLUMN -         = [A-Za-z][A-Za-z0-9_]*
-// VALUE            = [^ ^(^)]+
-// QUOTED_VALUE     = `'([^']|\\')*'`
-// EQ               = =
-// NOT_EQ           = <>
-// LIKE             = [Ll][Ii][Kk][Ee]
-// AND              = [Aa][Nn][Dd]
-// OR               = [Oo][Rr]
+// OPEN_BRACE        = (
+// CLOSED_BRACE      = )
+// COLUMN -          = [A-Za-z][A-Za-z0-9_]*
+// VALUE             = [^ ^(^)]+
+// QUOTED_VALUE      = `'([^']|\\')*'`
+// EQ                = =
+// NOT_EQ            = <>
+// LT                = <
+// LT_EQ             = <=
+// GT                = >
+// GT_EQ             = >=
+// LIKE              = [Ll][Ii][Kk][Ee]
+// ILIKE             = [Ii][Ll][Ii][Kk][Ee]
+// IN                = [Ii][Nn]
+// NOT_OP            = [Nn][Oo][Tt]
+// NOT               = [Nn][Oo][Tt]
+// OPEN_LIST         = (
+// CLOSED_LIST       = )
+// LIST_VALUE        = [^ ^(^)^,]+
+// QUOTED_LIST_VALUE = `'([^']|\\')*'`
+// COMMA             = ,
+// AND               = [Aa][Nn][Dd]
+// OR                = [Oo][Rr]
 //
 // VALID TRANSITIONS:
-// START        -> COLUMN | OPEN_BRACE
-// OPEN_BRACE   -> OPEN_BRACE | COLUMN
-// COLUMN       -> EQ | NOT_EQ | LIKE
-// EQ           -> VALUE | QUOTED_VALUE
-// NOT_EQ       -> VALUE | QUOTED_VALUE
-// LIKE         -> VALUE | QUOTED_VALUE
-// VALUE        -> OR | AND | CLOSED_BRACE | [END]
-// QUOTED_VALUE -> OR | AND | CLOSED_BRACE | [END]
-// CLOSED_BRACE -> OR | AND | CLOSED_BRACE | [END]
-// AND          -> COLUMN | OPEN_BRACE
-// OR           -> COLUMN | OPEN_BRACE
+// START             -> NOT | COLUMN | OPEN_BRACE
+// OPEN_BRACE        -> NOT | OPEN_BRACE | COLUMN
+// NOT               -> OPEN_BRACE | COLUMN
+// COLUMN            -> EQ | NOT_EQ | LT | LT_EQ | GT | GT_EQ | LIKE | ILIKE | IN | NOT_OP
+// NOT_OP            -> LIKE | ILIKE | IN
+// EQ                -> VALUE | QUOTED_VALUE
+// NOT_EQ            -> VALUE | QUOTED_VALUE
+// LT                -> VALUE | QUOTED_VALUE
+// LT_EQ             -> VALUE | QUOTED_VALUE
+// GT                -> VALUE | QUOTED_VALUE
+// GT_EQ             -> VALUE | QUOTED_VALUE
+// LIKE              -> VALUE | QUOTED_VALUE
+// ILIKE             -> VALUE | QUOTED_VALUE
+// IN                -> OPEN_LIST
+// OPEN_LIST         -> LIST_VALUE | QUOTED_LIST_VALUE
+// LIST_VALUE        -> COMMA | CLOSED_LIST
+// QUOTED_LIST_VALUE -> COMMA | CLOSED_LIST
+// COMMA             -> LIST_VALUE | QUOTED_LIST_VALUE
+// VALUE             -> OR | AND | CLOSED_BRACE | [END]
+// QUOTED_VALUE      -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_LIST       -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_BRACE      -> OR | AND | CLOSED_BRACE | [END]
+// AND               -> NOT | COLUMN | OPEN_BRACE
+// OR                -> NOT | COLUMN | OPEN_BRACE
+//
+// LT, LT_EQ, GT and GT_EQ can only be used with time columns, LIKE and ILIKE only with the other columns.
 func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 
 	// counts the number of joins
 	complexity := 0
+	// counts the number of values in the current list
+	listLength := 0
+	// the column of the current comparison
+	column := ""
 
 	contains := func(s []string, value string) bool {
 		for _, item := range s {
@@ -109,6 +168,42 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 		return nil
 	}
 
+	// converts a value to the type of the column it is compared with
+	toColumnValue := func(value string) (interface{}, error) {
+		if !contains(timeColumns, column) {
+			return value, nil
+		}
+		for _, format := range timeFormats {
+			if t, err := time.Parse(format, value); err == nil {
+				return t, nil
+			}
+		}
+		return nil, errors.Errorf("invalid timestamp for column '%s': '%s'", column, value)
+	}
+
+	unquote := func(value string) string {
+		// unescape
+		tmp := strings.ReplaceAll(value, `\'`, "'")
+		// remove quotes:
+		if len(tmp) > 1 {
+			tmp = string([]rune(tmp)[1 : len(tmp)-1])
+		}
+		return tmp
+	}
+
+	appendValue := func(token *ParsedToken) error {
+		value := token.value
+		if token.tokenName == QuotedValue || token.tokenName == QuotedListValue {
+			value = unquote(value)
+		}
+		columnValue, err := toColumnValue(value)
+		if err != nil {
+			return err
+		}
+		p.dbqry.Values = append(p.dbqry.Values, columnValue)
+		return nil
+	}
+
 	onNewToken := func(token *ParsedToken) error {
 		switch token.family {
 		case BraceTokenFamily:
@@ -117,19 +212,43 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			}
 			p.dbqry.Query += token.value
 			return nil
-		case ValueTokenFamily:
-			p.dbqry.Query += " ?"
-			p.dbqry.Values = append(p.dbqry.Values, token.value)
+		case ValueTokenFamily, QuotedValueTokenFamily:
+			if token.tokenName == ListValue || token.tokenName == QuotedListValue {
+				listLength++
+				if listLength > MaximumListLength {
+					return errors.Errorf("maximum number of values in a list (%d) exceeded", MaximumListLength)
+				}
+				p.dbqry.Query += "?"
+			} else {
+				p.dbqry.Query += " ?"
+			}
+			return appendValue(token)
+		case ListBraceTokenFamily:
+			if token.tokenName == OpenList {
+				listLength = 0
+				p.dbqry.Query += " " + token.value
+			} else {
+				p.dbqry.Query += token.value
+			}
+			return nil
+		case SeparatorTokenFamily:
+			p.dbqry.Query += token.value + " "
 			return nil
-		case QuotedValueTokenFamily:
-			p.dbqry.Query += " ?"
-			// unescape
-			tmp := strings.ReplaceAll(token.value, `\'`, "'")
-			// remove quotes:
-			if len(tmp) > 1 {
-				tmp = string([]rune(tmp)[1 : len(tmp)-1])
+		case OpTokenFamily:
+			switch token.tokenName {
+			case Lt, LtEq, Gt, GtEq:
+				if !contains(timeColumns, column) {
+					return errors.Errorf("operator '%s' can only be used with time columns, not with '%s'", token.value, column)
+				}
+			case LikeState, ILikeState:
+				if contains(timeColumns, column) {
+					return errors.Errorf("operator '%s' can not be used with time column '%s'", token.value, column)
+				}
 			}
-			p.dbqry.Values = append(p.dbqry.Values, tmp)
+			p.dbqry.Query += " " + token.value
+			return nil
+		case NegationTokenFamily:
+			p.dbqry.Query += token.value + " "
 			return nil
 		case LogicalOpTokenFamily:
 			complexity++
@@ -144,6 +263,7 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			if !contains(p.dbqry.ValidColumns, columnName) {
 				return fmt.Errorf("invalid column name: '%s'", token.value)
 			}
+			column = columnName
 			p.dbqry.Query += columnName
 			return nil
 		default:
@@ -161,22 +281,49 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			{Name: QuotedValue, Family: QuotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
 			{Name: Eq, Family: OpTokenFamily, AcceptPattern: `=`},
 			{Name: NotEq, Family: OpTokenFamily, AcceptPattern: `<>`},
+			{Name: Lt, Family: OpTokenFamily, AcceptPattern: `<`},
+			{Name: LtEq, Family: OpTokenFamily, AcceptPattern: `<=`},
+			{Name: Gt, Family: OpTokenFamily, AcceptPattern: `>`},
+			{Name: GtEq, Family: OpTokenFamily, AcceptPattern: `>=`},
 			{Name: LikeState, Family: OpTokenFamily, AcceptPattern: `[Ll][Ii][Kk][Ee]`},
+			{Name: ILikeState, Family: OpTokenFamily, AcceptPattern: `[Ii][Ll][Ii][Kk][Ee]`},
+			{Name: InState, Family: OpTokenFamily, AcceptPattern: `[Ii][Nn]`},
+			{Name: NotOpState, Family: OpTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: NotState, Family: NegationTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: OpenList, Family: ListBraceTokenFamily, AcceptPattern: `\(`},
+			{Name: ClosedList, Family: ListBraceTokenFamily, AcceptPattern: `\)`},
+			{Name: ListValue, Family: ValueTokenFamily, AcceptPattern: `[^'(),][^ ^(^)^,]*`},
+			{Name: QuotedListValue, Family: QuotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
+			{Name: Comma, Family: SeparatorTokenFamily, AcceptPattern: `,`},
 			{Name: AndState, Family: LogicalOpTokenFamily, AcceptPattern: `[Aa][Nn][Dd]`},
 			{Name: OrState, Family: LogicalOpTokenFamily, AcceptPattern: `[Oo][Rr]`},
 		},
 		Transitions: []TransitionDefinition{
-			{TokenName: StartState, ValidTransitions: []string{Column, OpenBrace}},
-			{TokenName: OpenBrace, ValidTransitions: []string{Column, OpenBrace}},
-			{TokenName: Column, ValidTransitions: []string{Eq, NotEq, LikeState}},
+			// NOT must be tried before COLUMN, which accepts the same token
+			{TokenName: StartState, ValidTransitions: []string{NotState, Column, OpenBrace}},
+			{TokenName: OpenBrace, ValidTransitions: []string{NotState, Column, OpenBrace}},
+			{TokenName: NotState, ValidTransitions: []string{Column, OpenBrace}},
+			{TokenName: Column, ValidTransitions: []string{Eq, NotEq, LtEq, Lt, GtEq, Gt, LikeState, ILikeState, InState, NotOpState}},
+			{TokenName: NotOpState, ValidTransitions: []string{LikeState, ILikeState, InState}},
 			{TokenName: Eq, ValidTransitions: []string{QuotedValue, Value}},
 			{TokenName: NotEq, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: Lt, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: LtEq, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: Gt, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: GtEq, ValidTransitions: []string{QuotedValue, Value}},
 			{TokenName: LikeState, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: ILikeState, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: InState, ValidTransitions: []string{OpenList}},
+			{TokenName: OpenList, ValidTransitions: []string{QuotedListValue, ListValue}},
+			{TokenName: QuotedListValue, ValidTransitions: []string{Comma, ClosedList}},
+			{TokenName: ListValue, ValidTransitions: []string{Comma, ClosedList}},
+			{TokenName: Comma, ValidTransitions: []string{QuotedListValue, ListValue}},
 			{TokenName: QuotedValue, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
 			{TokenName: Value, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
+			{TokenName: ClosedList, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
 			{TokenName: ClosedBrace, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
-			{TokenName: AndState, ValidTransitions: []string{Column, OpenBrace}},
-			{TokenName: OrState, ValidTransitions: []string{Column, OpenBrace}},
+			{TokenName: AndState, ValidTransitions: []string{NotState, Column, OpenBrace}},
+			{TokenName: OrState, ValidTransitions: []string{NotState, Column, OpenBrace}},
 		},
 	}
 
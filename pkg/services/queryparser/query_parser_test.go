@@ -1,15 +1,20 @@
 package services
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
 
 func Test_QueryParser(t *testing.T) {
+	timeColumns := []string{"name", "created_at", "updated_at"}
+	tooLongList := "status in (" + strings.Repeat("ready, ", MaximumListLength) + "failed)"
 
 	tests := []struct {
 		name      string
+		columns   []string
 		qry       string
 		outQry    string
 		outValues []interface{}
@@ -116,12 +121,116 @@ func Test_QueryParser(t *testing.T) {
 			qry:     "((cloud_provider = Value and name = value1) and (owner = value2 or region=b  ) or badcolumn=c or name=e and region LIKE '%test%'",
 			wantErr: true,
 		},
+		{
+			name:      "Testing IN with quoted values",
+			qry:       "status IN ('ready','failed')",
+			outQry:    "status IN (?, ?)",
+			outValues: []interface{}{"ready", "failed"},
+		},
+		{
+			name:      "Testing NOT IN with values and spaces",
+			qry:       "status not in ( ready , 'fail,ed' ) and name = test",
+			outQry:    "status not in (?, ?) and name = ?",
+			outValues: []interface{}{"ready", "fail,ed", "test"},
+		},
+		{
+			name:      "Testing comma in unquoted value outside of IN list",
+			qry:       "name = a,b and (region = c,d)",
+			outQry:    "name = ? and (region = ?)",
+			outValues: []interface{}{"a,b", "c,d"},
+		},
+		{
+			name:    "Testing IN with empty list",
+			qry:     "status in ()",
+			wantErr: true,
+		},
+		{
+			name:    "Testing IN without list",
+			qry:     "status in ready",
+			wantErr: true,
+		},
+		{
+			name:    "Testing IN with unterminated list",
+			qry:     "status in ('ready',",
+			wantErr: true,
+		},
+		{
+			name:    "Testing IN with too many values",
+			qry:     tooLongList,
+			wantErr: true,
+		},
+		{
+			name:      "Testing NOT",
+			qry:       "not (name = test or region = b) and NOT owner = c",
+			outQry:    "not (name = ? or region = ?) and NOT owner = ?",
+			outValues: []interface{}{"test", "b", "c"},
+		},
+		{
+			name:    "Testing NOT without expression",
+			qry:     "name = test and not",
+			wantErr: true,
+		},
+		{
+			name:      "Testing ILIKE and NOT ILIKE",
+			qry:       "name ILIKE '%Test%' and owner not ilike 'Admin%'",
+			outQry:    "name ILIKE ? and owner not ilike ?",
+			outValues: []interface{}{"%Test%", "Admin%"},
+		},
+		{
+			name:      "Testing time comparisons",
+			columns:   timeColumns,
+			qry:       "created_at >= '2026-01-01' and (updated_at < 2026-02-01T10:30:00Z or created_at = '2026-03-01T08:00:00')",
+			outQry:    "created_at >= ? and (updated_at < ? or created_at = ?)",
+			outValues: []interface{}{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 10, 30, 0, 0, time.UTC), time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:      "Testing IN with time column",
+			columns:   timeColumns,
+			qry:       "created_at in (2026-01-01, 2026-01-02)",
+			outQry:    "created_at in (?, ?)",
+			outValues: []interface{}{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "Testing invalid timestamp",
+			columns: timeColumns,
+			qry:     "created_at > yesterday",
+			wantErr: true,
+		},
+		{
+			name:    "Testing time comparison with other column",
+			columns: timeColumns,
+			qry:     "name > b",
+			wantErr: true,
+		},
+		{
+			name:    "Testing LIKE with time column",
+			columns: timeColumns,
+			qry:     "created_at like '2026%'",
+			wantErr: true,
+		},
+		{
+			name:    "Testing column not allowed for endpoint",
+			columns: timeColumns,
+			qry:     "owner = test",
+			wantErr: true,
+		},
+		{
+			name:      "Testing values are bound as parameters",
+			qry:       "name = 'test\\'; drop table central_requests; --'",
+			outQry:    "name = ?",
+			outValues: []interface{}{"test'; drop table central_requests; --"},
+		},
+		{
+			name:    "Testing expression instead of column",
+			qry:     "name = test or 1=1",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			RegisterTestingT(t)
-			qry, err := NewQueryParser().Parse(tt.qry)
+			qry, err := NewQueryParser(tt.columns...).Parse(tt.qry)
 
 			if err != nil && !tt.wantErr {
 				t.Errorf("QueryParser() error = %v, wantErr = %v", err, tt.wantErr)
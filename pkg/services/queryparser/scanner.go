@@ -1,6 +1,10 @@
 package services
 
-import "github.com/pkg/errors"
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 // Op ...
 const (
@@ -8,6 +12,7 @@ const (
 	Brace
 	Literal
 	QuotedLiteral
+	Separator
 	NoToken
 )
 
@@ -44,6 +49,8 @@ func (s *scanner) Init(txt string) {
 
 	quoted := false
 	escaped := false
+	// commas only separate the values of an IN list, elsewhere they are part of a literal (e.g. `name = a,b`)
+	inList := false
 
 	sendCurrentTokens := func() {
 		res := ""
@@ -81,11 +88,42 @@ func (s *scanner) Init(txt string) {
 		case ')':
 			// found closebrace Token
 			sendCurrentTokens()
+			if currentChar == '(' {
+				inList = len(s.tokens) > 0 && s.tokens[len(s.tokens)-1].TokenType == Literal && strings.EqualFold(s.tokens[len(s.tokens)-1].Value, "in")
+			} else {
+				inList = false
+			}
 			s.tokens = append(s.tokens, Token{
 				TokenType: Brace,
 				Value:     string(currentChar),
 				Position:  i,
 			})
+		case ',':
+			if quoted {
+				tokens = append(tokens, Token{
+					TokenType: Literal,
+					Value:     ",",
+					Position:  i,
+				})
+			} else if !inList {
+				if currentTokenType != NoToken && currentTokenType != Literal && currentTokenType != QuotedLiteral {
+					sendCurrentTokens()
+				}
+				currentTokenType = Literal
+				tokens = append(tokens, Token{
+					TokenType: Literal,
+					Value:     ",",
+					Position:  i,
+				})
+			} else {
+				// found separator Token
+				sendCurrentTokens()
+				s.tokens = append(s.tokens, Token{
+					TokenType: Separator,
+					Value:     ",",
+					Position:  i,
+				})
+			}
 		case '=':
 			fallthrough
 		case '<':
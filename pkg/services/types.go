@@ -24,6 +24,8 @@ type ListArguments struct {
 	Preloads []string
 	Search   string
 	OrderBy  []string
+	// PageToken is the token of the page to return. If set, Page is ignored.
+	PageToken string
 }
 
 // NewListArguments - Create ListArguments from url query parameters with sane defaults
@@ -47,6 +49,9 @@ func NewListArguments(params url.Values) *ListArguments {
 	if v := params.Get("search"); v != "" {
 		listArgs.Search = v
 	}
+	if v := params.Get("page_token"); v != "" {
+		listArgs.PageToken = v
+	}
 	if v := params.Get("orderBy"); v != "" {
 		listArgs.OrderBy = strings.Split(v, ",")
 		// remove spaces
@@ -127,7 +127,7 @@ func (p *ProbeImpl) createCentral(ctx context.Context) (*public.CentralRequest,
 		CloudProvider: p.config.DataCloudProvider,
 		Region:        p.config.DataPlaneRegion,
 	}
-	central, _, err := p.fleetManagerPublicAPI.CreateCentral(ctx, true, request)
+	central, _, err := p.fleetManagerPublicAPI.CreateCentral(ctx, true, request, nil)
 	glog.Infof("creation of central instance requested")
 	if err != nil {
 		return nil, errors.Wrap(err, "creation of central instance failed")
@@ -155,7 +155,7 @@ func (p *ProbeImpl) verifyCentral(ctx context.Context, centralRequest *public.Ce
 
 // Delete the Central instance and verify that it transitioned to 'deprovision' state.
 func (p *ProbeImpl) deleteCentral(ctx context.Context, centralRequest *public.CentralRequest) error {
-	_, err := p.fleetManagerPublicAPI.DeleteCentralById(ctx, centralRequest.Id, true)
+	_, err := p.fleetManagerPublicAPI.DeleteCentralById(ctx, centralRequest.Id, true, nil)
 	glog.Infof("deletion of central instance %s requested", centralRequest.Id)
 	if err != nil {
 		return errors.Wrapf(err, "deletion of central instance %s failed", centralRequest.Id)
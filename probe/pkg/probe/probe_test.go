@@ -56,7 +56,7 @@ func TestCreateCentral(t *testing.T) {
 			testName: "create central happy path",
 			wantErr:  false,
 			mockFMAPI: &fleetmanager.PublicAPIMock{
-				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error) {
 					central := public.CentralRequest{
 						Status:       constants.CentralRequestStatusAccepted.String(),
 						InstanceType: types.STANDARD.String(),
@@ -76,7 +76,7 @@ func TestCreateCentral(t *testing.T) {
 			testName: "create central fails on internal server error",
 			wantErr:  true,
 			mockFMAPI: &fleetmanager.PublicAPIMock{
-				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error) {
 					central := public.CentralRequest{}
 					err := errors.Errorf("%d", http.StatusInternalServerError)
 					return central, nil, err
@@ -88,7 +88,7 @@ func TestCreateCentral(t *testing.T) {
 			wantErr:  true,
 			errType:  &context.DeadlineExceeded,
 			mockFMAPI: &fleetmanager.PublicAPIMock{
-				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error) {
 					central := public.CentralRequest{
 						Id:           "id-42",
 						Name:         "probe-42",
@@ -109,7 +109,7 @@ func TestCreateCentral(t *testing.T) {
 			wantErr:  true,
 			errType:  &context.DeadlineExceeded,
 			mockFMAPI: &fleetmanager.PublicAPIMock{
-				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload, localVarOptionals *public.CreateCentralOpts) (public.CentralRequest, *http.Response, error) {
 					concurrency.WaitWithTimeout(ctx, 2*testConfig.ProbeRunTimeout)
 					return public.CentralRequest{}, nil, ctx.Err()
 				},
@@ -214,7 +214,7 @@ func TestDeleteCentral(t *testing.T) {
 			testName: "delete central happy path",
 			wantErr:  false,
 			mockFMClient: &fleetmanager.PublicAPIMock{
-				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 					return nil, nil
 				},
 				GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {
@@ -239,7 +239,7 @@ func TestDeleteCentral(t *testing.T) {
 			testName: "delete central fails on internal server error",
 			wantErr:  true,
 			mockFMClient: &fleetmanager.PublicAPIMock{
-				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 					err := errors.Errorf("%d", http.StatusInternalServerError)
 					return nil, err
 				},
@@ -250,7 +250,7 @@ func TestDeleteCentral(t *testing.T) {
 			wantErr:  true,
 			errType:  &context.DeadlineExceeded,
 			mockFMClient: &fleetmanager.PublicAPIMock{
-				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 					return nil, nil
 				},
 				GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {
@@ -265,7 +265,7 @@ func TestDeleteCentral(t *testing.T) {
 			wantErr:  true,
 			errType:  &context.DeadlineExceeded,
 			mockFMClient: &fleetmanager.PublicAPIMock{
-				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 					return nil, nil
 				},
 				GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {
@@ -292,7 +292,7 @@ func TestDeleteCentral(t *testing.T) {
 			wantErr:  true,
 			errType:  &context.DeadlineExceeded,
 			mockFMClient: &fleetmanager.PublicAPIMock{
-				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 					concurrency.WaitWithTimeout(ctx, 2*testConfig.ProbeRunTimeout)
 					return nil, ctx.Err()
 				},
@@ -352,7 +352,7 @@ func TestCleanUp(t *testing.T) {
 					centralList := public.CentralRequestList{Items: centralItems}
 					return centralList, nil, nil
 				},
-				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool, localVarOptionals *public.DeleteCentralByIdOpts) (*http.Response, error) {
 					return nil, nil
 				},
 				GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {